@@ -0,0 +1,89 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+)
+
+// BuildPathLockFileName is the name of the file, in the build path, used to
+// prevent concurrent builds in the same build path
+const BuildPathLockFileName = ".build.lock"
+
+// buildPathLockPollInterval is how often a locked build path is checked
+// again while waiting for the lock
+var buildPathLockPollInterval = 200 * time.Millisecond
+
+// BuildPathLock is an exclusive lock on a build path
+type BuildPathLock struct {
+	file *os.File
+}
+
+// LockBuildPath acquires an exclusive lock on the given build path. If the
+// build path is already locked by another process the waiting callback (if
+// not nil) is called and LockBuildPath waits until the lock is released or
+// ctx is done, in this case the error of ctx is returned.
+func LockBuildPath(ctx context.Context, buildPath *paths.Path, waiting func()) (*BuildPathLock, error) {
+	f, err := os.OpenFile(buildPath.Join(BuildPathLockFileName).String(), os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return nil, err
+	}
+	for first := true; ; first = false {
+		if locked, err := tryLockFile(f); err != nil {
+			f.Close()
+			return nil, err
+		} else if locked {
+			return &BuildPathLock{file: f}, nil
+		}
+		if first && waiting != nil {
+			waiting()
+		}
+		select {
+		case <-ctx.Done():
+			f.Close()
+			return nil, ctx.Err()
+		case <-time.After(buildPathLockPollInterval):
+		}
+	}
+}
+
+// TryLockBuildPath acquires an exclusive lock on the given build path without
+// waiting: if the build path is already locked by another build a nil lock is
+// returned.
+func TryLockBuildPath(buildPath *paths.Path) (*BuildPathLock, error) {
+	f, err := os.OpenFile(buildPath.Join(BuildPathLockFileName).String(), os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return nil, err
+	}
+	if locked, err := tryLockFile(f); err != nil || !locked {
+		f.Close()
+		return nil, err
+	}
+	return &BuildPathLock{file: f}, nil
+}
+
+// Unlock releases the lock on the build path
+func (l *BuildPathLock) Unlock() error {
+	if err := unlockFile(l.file); err != nil {
+		l.file.Close()
+		return err
+	}
+	return l.file.Close()
+}
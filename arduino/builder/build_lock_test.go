@@ -0,0 +1,105 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockBuildPath(t *testing.T) {
+	buildPath, err := paths.MkTempDir("", "build_lock_test")
+	require.NoError(t, err)
+	defer buildPath.RemoveAll()
+
+	lock, err := LockBuildPath(context.Background(), buildPath, func() { t.Fatal("the build path is not locked") })
+	require.NoError(t, err)
+
+	waiting := make(chan bool, 1)
+	acquired := make(chan *BuildPathLock)
+	go func() {
+		lock2, err := LockBuildPath(context.Background(), buildPath, func() { waiting <- true })
+		require.NoError(t, err)
+		acquired <- lock2
+	}()
+
+	// The second lock waits until the first one is released
+	select {
+	case <-waiting:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the second lock is not waiting")
+	}
+	select {
+	case <-acquired:
+		t.Fatal("the second lock has been acquired while the first is held")
+	case <-time.After(100 * time.Millisecond):
+	}
+	require.NoError(t, lock.Unlock())
+	select {
+	case lock2 := <-acquired:
+		require.NoError(t, lock2.Unlock())
+	case <-time.After(5 * time.Second):
+		t.Fatal("the second lock has not been acquired")
+	}
+}
+
+func TestLockBuildPathCanceled(t *testing.T) {
+	buildPath, err := paths.MkTempDir("", "build_lock_test")
+	require.NoError(t, err)
+	defer buildPath.RemoveAll()
+
+	lock, err := LockBuildPath(context.Background(), buildPath, nil)
+	require.NoError(t, err)
+	defer lock.Unlock()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	res := make(chan error)
+	go func() {
+		_, err := LockBuildPath(ctx, buildPath, cancel)
+		res <- err
+	}()
+	select {
+	case err := <-res:
+		require.ErrorIs(t, err, context.Canceled)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the wait for the lock has not been canceled")
+	}
+}
+
+func TestTryLockBuildPath(t *testing.T) {
+	buildPath, err := paths.MkTempDir("", "build_lock_test")
+	require.NoError(t, err)
+	defer buildPath.RemoveAll()
+
+	lock, err := TryLockBuildPath(buildPath)
+	require.NoError(t, err)
+	require.NotNil(t, lock)
+
+	// The build path is busy until the lock is released
+	busy, err := TryLockBuildPath(buildPath)
+	require.NoError(t, err)
+	require.Nil(t, busy)
+
+	require.NoError(t, lock.Unlock())
+	lock, err = TryLockBuildPath(buildPath)
+	require.NoError(t, err)
+	require.NotNil(t, lock)
+	require.NoError(t, lock.Unlock())
+}
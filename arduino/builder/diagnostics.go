@@ -0,0 +1,109 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"sync"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// diagnosticRegexp matches the diagnostics printed by GCC and clang, for
+// example: "/path/sketch.ino:10:5: error: 'foo' was not declared in this scope"
+var diagnosticRegexp = regexp.MustCompile(`^(.+?):(\d+):(?:(\d+):)? (fatal error|error|warning|note): (.*)$`)
+
+// Diagnostic is a message of the compiler about a source file
+type Diagnostic struct {
+	File     string
+	Line     int
+	Column   int
+	Severity string
+	Message  string
+}
+
+// ToRPC converts the Diagnostic into a rpc.CompileDiagnostic
+func (d *Diagnostic) ToRPC() *rpc.CompileDiagnostic {
+	return &rpc.CompileDiagnostic{
+		File:     d.File,
+		Line:     int32(d.Line),
+		Column:   int32(d.Column),
+		Severity: d.Severity,
+		Message:  d.Message,
+	}
+}
+
+// ParseDiagnostic parses a line of the compiler output, it returns nil if the
+// line is not a diagnostic
+func ParseDiagnostic(line string) *Diagnostic {
+	match := diagnosticRegexp.FindStringSubmatch(line)
+	if match == nil {
+		return nil
+	}
+	lineNum, _ := strconv.Atoi(match[2])
+	colNum, _ := strconv.Atoi(match[3]) // column is optional
+	return &Diagnostic{
+		File:     match[1],
+		Line:     lineNum,
+		Column:   colNum,
+		Severity: match[4],
+		Message:  match[5],
+	}
+}
+
+// DiagnosticsCollector is an io.Writer that parses the compiler output
+// written into it and collects the diagnostics found
+type DiagnosticsCollector struct {
+	lock        sync.Mutex
+	partial     []byte
+	diagnostics []*Diagnostic
+}
+
+// Write parses the complete lines of data, the last partial line is kept
+// until the rest of the line is written
+func (c *DiagnosticsCollector) Write(data []byte) (int, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.partial = append(c.partial, data...)
+	for {
+		i := bytes.IndexByte(c.partial, '\n')
+		if i == -1 {
+			break
+		}
+		c.parseLine(c.partial[:i])
+		c.partial = c.partial[i+1:]
+	}
+	return len(data), nil
+}
+
+func (c *DiagnosticsCollector) parseLine(line []byte) {
+	if d := ParseDiagnostic(string(bytes.TrimRight(line, "\r"))); d != nil {
+		c.diagnostics = append(c.diagnostics, d)
+	}
+}
+
+// Diagnostics returns the diagnostics collected so far
+func (c *DiagnosticsCollector) Diagnostics() []*Diagnostic {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if len(c.partial) > 0 {
+		c.parseLine(c.partial)
+		c.partial = nil
+	}
+	return c.diagnostics
+}
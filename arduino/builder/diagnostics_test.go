@@ -0,0 +1,59 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDiagnostic(t *testing.T) {
+	d := ParseDiagnostic("/tmp/sketch/sketch.ino:10:5: error: 'foo' was not declared in this scope")
+	require.NotNil(t, d)
+	require.Equal(t, &Diagnostic{File: "/tmp/sketch/sketch.ino", Line: 10, Column: 5, Severity: "error", Message: "'foo' was not declared in this scope"}, d)
+
+	d = ParseDiagnostic(`C:\Users\me\sketch\sketch.ino:3:10: fatal error: Foo.h: No such file or directory`)
+	require.NotNil(t, d)
+	require.Equal(t, `C:\Users\me\sketch\sketch.ino`, d.File)
+	require.Equal(t, 3, d.Line)
+	require.Equal(t, 10, d.Column)
+	require.Equal(t, "fatal error", d.Severity)
+	require.Equal(t, "Foo.h: No such file or directory", d.Message)
+
+	// the column is optional
+	d = ParseDiagnostic("/tmp/sketch/sketch.ino:7: warning: unused variable")
+	require.NotNil(t, d)
+	require.Equal(t, 7, d.Line)
+	require.Equal(t, 0, d.Column)
+
+	require.Nil(t, ParseDiagnostic("compilation terminated."))
+	require.Nil(t, ParseDiagnostic("   foo();"))
+}
+
+func TestDiagnosticsCollector(t *testing.T) {
+	c := &DiagnosticsCollector{}
+	fmt.Fprint(c, "/tmp/a.cpp:1:2: warning: first\r\n   code\n/tmp/a.cpp:3:")
+	fmt.Fprint(c, "4: note: second\n/tmp/b.cpp:5:6: error: third")
+	diags := c.Diagnostics()
+	require.Len(t, diags, 3)
+	require.Equal(t, "first", diags[0].Message)
+	require.Equal(t, "note", diags[1].Severity)
+	require.Equal(t, 3, diags[1].Line)
+	require.Equal(t, "/tmp/b.cpp", diags[2].File)
+	require.Equal(t, "third", diags[2].ToRPC().GetMessage())
+}
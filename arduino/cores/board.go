@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/go-properties-orderedmap"
 )
 
@@ -106,7 +107,11 @@ func (b *Board) GetBuildProperties(userConfigs *properties.Map) (*properties.Map
 		if haveUserValue {
 			userConfigs.Remove(option)
 			if !optionMenu.ContainsKey(userValue) {
-				return nil, fmt.Errorf(tr("invalid value '%[1]s' for option '%[2]s'"), userValue, option)
+				return nil, &arduino.InvalidBoardOptionError{
+					Option: option,
+					Value:  userValue,
+					Valid:  b.GetConfigOptionValues(option).Keys(),
+				}
 			}
 		} else {
 			// apply default
@@ -123,7 +128,10 @@ func (b *Board) GetBuildProperties(userConfigs *properties.Map) (*properties.Map
 		if invalidOption == "" {
 			return nil, fmt.Errorf(tr("invalid empty option found"))
 		}
-		return nil, fmt.Errorf(tr("invalid option '%s'"), invalidOption)
+		return nil, &arduino.InvalidBoardOptionError{
+			Option: invalidOption,
+			Valid:  menu.FirstLevelKeys(),
+		}
 	}
 
 	return buildProperties, nil
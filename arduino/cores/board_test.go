@@ -18,6 +18,7 @@ package cores
 import (
 	"testing"
 
+	"github.com/arduino/arduino-cli/arduino"
 	properties "github.com/arduino/go-properties-orderedmap"
 	"github.com/stretchr/testify/require"
 )
@@ -297,9 +298,18 @@ func TestBoardOptions(t *testing.T) {
 
 	_, err = boardMega.GeneratePropertiesForConfiguration("cpu=atmegassss")
 	require.Error(t, err, "generating cpu=atmegassss configuration")
+	var optionErr *arduino.InvalidBoardOptionError
+	require.ErrorAs(t, err, &optionErr)
+	require.Equal(t, "cpu", optionErr.Option)
+	require.Equal(t, "atmegassss", optionErr.Value)
+	require.ElementsMatch(t, []string{"atmega2560", "atmega1280"}, optionErr.Valid)
 
 	_, err = boardUno.GeneratePropertiesForConfiguration("cpu=atmega1280")
 	require.Error(t, err, "generating cpu=atmega1280 configuration")
+	require.ErrorAs(t, err, &optionErr)
+	require.Equal(t, "cpu", optionErr.Option)
+	require.Equal(t, "", optionErr.Value)
+	require.Empty(t, optionErr.Valid)
 
 	expWatterott := properties.NewFromHashmap(map[string]string{
 		"bootloader.extended_fuses":           "0xFE",
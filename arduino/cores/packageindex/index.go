@@ -224,7 +224,11 @@ func (inPackage indexPackage) extractPackageIn(outPackages cores.Packages, trust
 	outPackage := outPackages.GetOrCreatePackage(inPackage.Name)
 	outPackage.Maintainer = inPackage.Maintainer
 	outPackage.WebsiteURL = inPackage.WebsiteURL
-	outPackage.URL = inPackage.URL
+	// The origin URL of a package is the URL of the index providing its platforms,
+	// other indexes may only add some tools to the package
+	if outPackage.URL == "" || len(inPackage.Platforms) > 0 {
+		outPackage.URL = inPackage.URL
+	}
 	outPackage.Email = inPackage.Email
 	outPackage.Help = cores.PackageHelp{Online: inPackage.Help.Online}
 
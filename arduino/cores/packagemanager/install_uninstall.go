@@ -25,6 +25,8 @@ import (
 	"github.com/arduino/arduino-cli/executils"
 	"github.com/arduino/go-paths-helper"
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	semver "go.bug.st/relaxed-semver"
 )
 
 // InstallPlatform installs a specific release of a platform.
@@ -64,11 +66,11 @@ func (pm *PackageManager) cacheInstalledJSON(platformRelease *cores.PlatformRele
 	return nil
 }
 
-// RunPostInstallScript runs the post_install.sh (or post_install.bat) script for the
-// specified platformRelease.
-func (pm *PackageManager) RunPostInstallScript(platformRelease *cores.PlatformRelease) error {
+// GetPostInstallScript returns the post_install.sh (or post_install.bat) script bundled
+// with the specified installed platformRelease, or nil if the platform doesn't have one.
+func GetPostInstallScript(platformRelease *cores.PlatformRelease) *paths.Path {
 	if !platformRelease.IsInstalled() {
-		return errors.New(tr("platform not installed"))
+		return nil
 	}
 	postInstallFilename := "post_install.sh"
 	if runtime.GOOS == "windows" {
@@ -76,6 +78,18 @@ func (pm *PackageManager) RunPostInstallScript(platformRelease *cores.PlatformRe
 	}
 	postInstall := platformRelease.InstallDir.Join(postInstallFilename)
 	if postInstall.Exist() && postInstall.IsNotDir() {
+		return postInstall
+	}
+	return nil
+}
+
+// RunPostInstallScript runs the post_install.sh (or post_install.bat) script for the
+// specified platformRelease.
+func (pm *PackageManager) RunPostInstallScript(platformRelease *cores.PlatformRelease) error {
+	if !platformRelease.IsInstalled() {
+		return errors.New(tr("platform not installed"))
+	}
+	if postInstall := GetPostInstallScript(platformRelease); postInstall != nil {
 		cmd, err := executils.NewProcessFromPath(pm.GetEnvVarsForSpawnedProcess(), postInstall)
 		if err != nil {
 			return err
@@ -126,6 +140,138 @@ func (pm *PackageManager) UninstallPlatform(platformRelease *cores.PlatformRelea
 	return nil
 }
 
+// platformRollbackDir returns the directory where the release of a platform
+// replaced by the last upgrade is kept. The directory is hidden so that the
+// loader doesn't consider it an installed platform.
+func (pm *PackageManager) platformRollbackDir(platform *cores.Platform) *paths.Path {
+	return pm.PackagesDir.Join(platform.Package.Name, ".rollback", platform.Architecture)
+}
+
+// KeepPlatformForRollback uninstalls a specific release of a platform, moving
+// its files aside so that it can be restored with RestorePlatformFromRollback.
+// A release previously kept for the same platform is removed, but only after the
+// files of the new one have been moved in its place.
+func (pm *PackageManager) KeepPlatformForRollback(platformRelease *cores.PlatformRelease) error {
+	if platformRelease.InstallDir == nil {
+		return fmt.Errorf(tr("platform not installed"))
+	}
+
+	// Safety measure
+	if !pm.IsManagedPlatformRelease(platformRelease) {
+		return fmt.Errorf(tr("%s is not managed by package manager"), platformRelease)
+	}
+
+	rollbackDir := pm.platformRollbackDir(platformRelease.Platform)
+	if err := rollbackDir.Parent().MkdirAll(); err != nil {
+		return fmt.Errorf(tr("creating directory %[1]s: %[2]s"), rollbackDir.Parent(), err)
+	}
+	// The files are first moved into a temporary directory (hidden, so that it
+	// can't be mistaken for the rollback directory of another platform)
+	tmpDir, err := paths.MkTempDir(rollbackDir.Parent().String(), "."+platformRelease.Platform.Architecture+"-")
+	if err != nil {
+		return fmt.Errorf(tr("creating temp dir for rollback: %s"), err)
+	}
+	newDir := tmpDir.Join("new")
+	if err := newDir.MkdirAll(); err != nil {
+		tmpDir.RemoveAll()
+		return fmt.Errorf(tr("creating directory %[1]s: %[2]s"), newDir, err)
+	}
+	installDir := platformRelease.InstallDir
+	keptDir := newDir.Join(platformRelease.Version.String())
+	if err := installDir.Rename(keptDir); err != nil {
+		tmpDir.RemoveAll()
+		return fmt.Errorf(tr("moving platform files: %s"), err)
+	}
+
+	// In case of failure the files are moved back in the install directory, the
+	// temporary directory is left in place if something can't be moved back
+	undo := func(err error) error {
+		if restoreErr := keptDir.Rename(installDir); restoreErr != nil {
+			logrus.WithError(restoreErr).Errorf("Restoring platform files into %s, the files are kept in %s", installDir, keptDir)
+		} else if tmpDir.Join("old").NotExist() {
+			tmpDir.RemoveAll()
+		}
+		return err
+	}
+
+	// Put the previously kept release aside and replace it with the new one
+	oldDir := tmpDir.Join("old")
+	if rollbackDir.Exist() {
+		if err := rollbackDir.Rename(oldDir); err != nil {
+			return undo(fmt.Errorf(tr("moving platform files: %s"), err))
+		}
+	}
+	if err := newDir.Rename(rollbackDir); err != nil {
+		if oldDir.Exist() {
+			if err := oldDir.Rename(rollbackDir); err != nil {
+				logrus.WithError(err).Errorf("Restoring the files kept for rollback in %s", rollbackDir)
+			}
+		}
+		return undo(fmt.Errorf(tr("moving platform files: %s"), err))
+	}
+	platformRelease.InstallDir = nil
+
+	// Only now the previously kept release can be removed
+	if err := tmpDir.RemoveAll(); err != nil {
+		logrus.WithError(err).Warnf("Removing the previous files kept for rollback in %s", tmpDir)
+	}
+	return nil
+}
+
+// GetRollbackPlatformVersion returns the version of the release of a platform
+// kept by KeepPlatformForRollback, or nil if there is none.
+func (pm *PackageManager) GetRollbackPlatformVersion(platform *cores.Platform) *semver.Version {
+	dirs, err := pm.platformRollbackDir(platform).ReadDir()
+	if err != nil {
+		return nil
+	}
+	dirs.FilterDirs()
+	for _, dir := range dirs {
+		if version, err := semver.Parse(dir.Base()); err == nil {
+			return version
+		}
+	}
+	return nil
+}
+
+// RestorePlatformFromRollback installs a specific release of a platform by
+// moving back the files kept by KeepPlatformForRollback.
+func (pm *PackageManager) RestorePlatformFromRollback(platformRelease *cores.PlatformRelease) error {
+	keptDir := pm.platformRollbackDir(platformRelease.Platform).Join(platformRelease.Version.String())
+	if keptDir.NotExist() {
+		return fmt.Errorf(tr("no files kept for platform %s"), platformRelease)
+	}
+	destDir := pm.PackagesDir.Join(
+		platformRelease.Platform.Package.Name,
+		"hardware",
+		platformRelease.Platform.Architecture,
+		platformRelease.Version.String())
+	if destDir.Exist() {
+		return fmt.Errorf(tr("platform %s already installed"), platformRelease)
+	}
+	if err := destDir.Parent().MkdirAll(); err != nil {
+		return fmt.Errorf(tr("creating directory %[1]s: %[2]s"), destDir.Parent(), err)
+	}
+	if err := keptDir.Rename(destDir); err != nil {
+		return fmt.Errorf(tr("moving platform files: %s"), err)
+	}
+	if d, err := destDir.Abs(); err == nil {
+		platformRelease.InstallDir = d
+	} else {
+		return err
+	}
+	return nil
+}
+
+// DiscardPlatformRollback removes the release of a platform kept by
+// KeepPlatformForRollback, if any.
+func (pm *PackageManager) DiscardPlatformRollback(platform *cores.Platform) error {
+	if err := pm.platformRollbackDir(platform).RemoveAll(); err != nil {
+		return fmt.Errorf(tr("removing platform files: %s"), err)
+	}
+	return nil
+}
+
 // InstallTool installs a specific release of a tool.
 func (pm *PackageManager) InstallTool(toolRelease *cores.ToolRelease) error {
 	toolResource := toolRelease.GetCompatibleFlavour()
@@ -205,7 +205,7 @@ func (pm *PackageManager) ResolveFQBN(fqbn *cores.FQBN) (
 	buildProperties, err := board.GetBuildProperties(fqbn.Configs)
 	if err != nil {
 		return targetPackage, platformRelease, board, nil, nil,
-			fmt.Errorf(tr("getting build properties for board %[1]s: %[2]s"), board, err)
+			fmt.Errorf(tr("getting build properties for board %[1]s: %[2]w"), board, err)
 	}
 
 	// Determine the platform used for the build (in case the board refers
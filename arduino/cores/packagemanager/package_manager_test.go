@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"runtime"
 	"testing"
 
 	"github.com/arduino/arduino-cli/arduino/cores"
@@ -519,3 +520,73 @@ func TestLegacyPackageConversionToPluggableDiscovery(t *testing.T) {
 		require.Equal(t, `"{network_cmd}" -address {upload.port.address} -port {upload.port.properties.port} -sketch "{build.path}/{build.project_name}.hex" -upload {upload.port.properties.endpoint_upload} -sync {upload.port.properties.endpoint_sync} -reset {upload.port.properties.endpoint_reset} -sync_exp {upload.port.properties.sync_return}`, platformProps.Get("tools.avrdude__pluggable_network.upload.pattern"))
 	}
 }
+
+func TestGetPostInstallScript(t *testing.T) {
+	installDir, err := paths.MkTempDir("", "post_install")
+	require.NoError(t, err)
+	defer installDir.RemoveAll()
+
+	release := &cores.PlatformRelease{}
+	require.Nil(t, packagemanager.GetPostInstallScript(release))
+
+	release.InstallDir = installDir
+	require.Nil(t, packagemanager.GetPostInstallScript(release))
+
+	scriptName := "post_install.sh"
+	if runtime.GOOS == "windows" {
+		scriptName = "post_install.bat"
+	}
+	require.NoError(t, installDir.Join(scriptName).WriteFile([]byte{}))
+	script := packagemanager.GetPostInstallScript(release)
+	require.NotNil(t, script)
+	require.True(t, script.EquivalentTo(installDir.Join(scriptName)))
+}
+
+func TestPlatformRollback(t *testing.T) {
+	packagesDir, err := paths.MkTempDir("", "test_platform_rollback")
+	require.NoError(t, err)
+	defer packagesDir.RemoveAll()
+
+	installDir := packagesDir.Join("test", "hardware", "avr", "1.0.0")
+	require.NoError(t, installDir.MkdirAll())
+	require.NoError(t, installDir.Join("boards.txt").WriteFile([]byte("test.name=Test board\n")))
+
+	installedReleases := func() []*cores.PlatformRelease {
+		pm := packagemanager.NewPackageManager(packagesDir, packagesDir, packagesDir, packagesDir, "test")
+		require.Empty(t, pm.LoadHardwareFromDirectory(packagesDir))
+		return pm.InstalledPlatformReleases()
+	}
+	pm := packagemanager.NewPackageManager(packagesDir, packagesDir, packagesDir, packagesDir, "test")
+	require.Empty(t, pm.LoadHardwareFromDirectory(packagesDir))
+	platform := pm.FindPlatform(&packagemanager.PlatformReference{Package: "test", PlatformArchitecture: "avr"})
+	require.NotNil(t, platform)
+	release := pm.GetInstalledPlatformRelease(platform)
+	require.NotNil(t, release)
+	require.Nil(t, pm.GetRollbackPlatformVersion(platform))
+
+	// The kept release is not loaded as an installed platform
+	require.NoError(t, pm.KeepPlatformForRollback(release))
+	require.Nil(t, release.InstallDir)
+	require.True(t, installDir.NotExist())
+	require.Equal(t, "1.0.0", pm.GetRollbackPlatformVersion(platform).String())
+	require.Empty(t, installedReleases())
+
+	require.NoError(t, pm.RestorePlatformFromRollback(release))
+	require.True(t, installDir.Join("boards.txt").Exist())
+	require.Nil(t, pm.GetRollbackPlatformVersion(platform))
+	require.Len(t, installedReleases(), 1)
+	require.Error(t, pm.RestorePlatformFromRollback(release))
+
+	// A release previously kept is replaced, no temporary files are left around
+	oldKeptDir := packagesDir.Join("test", ".rollback", "avr", "0.9.0")
+	require.NoError(t, oldKeptDir.MkdirAll())
+	require.NoError(t, pm.KeepPlatformForRollback(release))
+	require.Equal(t, "1.0.0", pm.GetRollbackPlatformVersion(platform).String())
+	require.True(t, oldKeptDir.NotExist())
+	rollbackDirs, err := packagesDir.Join("test", ".rollback").ReadDir()
+	require.NoError(t, err)
+	require.Len(t, rollbackDirs, 1)
+
+	require.NoError(t, pm.DiscardPlatformRollback(platform))
+	require.Nil(t, pm.GetRollbackPlatformVersion(platform))
+}
@@ -109,9 +109,28 @@ func (p *Port) ToRPC() *rpc.Port {
 		Protocol:      p.Protocol,
 		ProtocolLabel: p.ProtocolLabel,
 		Properties:    props.AsMap(),
+		StableId:      p.StableID(),
+		AuthRequired:  props.Get("auth_upload") == "yes",
 	}
 }
 
+// StableID returns an identifier of the port that doesn't change when the
+// board is reconnected: the USB serial number for serial ports, the hostname
+// for network ports or the address if none of them is available.
+func (p *Port) StableID() string {
+	props := p.Properties
+	if props == nil {
+		props = properties.NewMap()
+	}
+	if serial := props.Get("serialNumber"); serial != "" {
+		return p.Protocol + "://" + props.Get("vid") + ":" + props.Get("pid") + ":" + serial
+	}
+	if hostname := props.Get("hostname"); hostname != "" {
+		return p.Protocol + "://" + hostname
+	}
+	return p.Protocol + "://" + p.Address
+}
+
 func (p *Port) String() string {
 	if p == nil {
 		return "none"
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/arduino/arduino-cli/executils"
+	"github.com/arduino/go-properties-orderedmap"
 	"github.com/stretchr/testify/require"
 )
 
@@ -71,3 +72,27 @@ func TestDiscoveryStdioHandling(t *testing.T) {
 
 	require.Equal(t, disc.State(), Dead)
 }
+
+func TestPortToRPC(t *testing.T) {
+	serialProps := properties.NewMap()
+	serialProps.Set("vid", "0x2341")
+	serialProps.Set("pid", "0x0043")
+	serialProps.Set("serialNumber", "85430353531351B09121")
+	serialPort := &Port{Address: "/dev/ttyACM0", Protocol: "serial", Properties: serialProps}
+	rpcPort := serialPort.ToRPC()
+	require.Equal(t, "serial://0x2341:0x0043:85430353531351B09121", rpcPort.GetStableId())
+	require.False(t, rpcPort.GetAuthRequired())
+
+	networkProps := properties.NewMap()
+	networkProps.Set("board", "mkrwifi1010")
+	networkProps.Set("hostname", "my-board.local")
+	networkProps.Set("auth_upload", "yes")
+	networkPort := &Port{Address: "192.168.1.10", Protocol: "network", Properties: networkProps}
+	rpcPort = networkPort.ToRPC()
+	require.Equal(t, "network://my-board.local", rpcPort.GetStableId())
+	require.True(t, rpcPort.GetAuthRequired())
+	require.Equal(t, "mkrwifi1010", rpcPort.GetProperties()["board"])
+
+	otherPort := &Port{Address: "192.168.1.11", Protocol: "network"}
+	require.Equal(t, "network://192.168.1.11", otherPort.ToRPC().GetStableId())
+}
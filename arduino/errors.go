@@ -71,6 +71,32 @@ func (e *InvalidFQBNError) Unwrap() error {
 	return e.Cause
 }
 
+// InvalidBoardOptionError is returned when a custom board option of the FQBN
+// (e.g. `cpu=atmega328`) doesn't match the menus declared by the board
+type InvalidBoardOptionError struct {
+	Option string
+	// Value is set only if the option exists but the value is not valid
+	Value string
+	// Valid contains the valid values of the option, or the valid options if
+	// the option doesn't exist
+	Valid []string
+}
+
+func (e *InvalidBoardOptionError) Error() string {
+	if e.Value == "" && len(e.Valid) == 0 {
+		return tr("Invalid board option '%s', the board has no custom options", e.Option)
+	}
+	if e.Value == "" {
+		return tr("Invalid board option '%[1]s', valid options are: %[2]s", e.Option, strings.Join(e.Valid, ", "))
+	}
+	return tr("Invalid value '%[1]s' for board option '%[2]s', valid values are: %[3]s", e.Value, e.Option, strings.Join(e.Valid, ", "))
+}
+
+// ToRPCStatus converts the error into a *status.Status
+func (e *InvalidBoardOptionError) ToRPCStatus() *status.Status {
+	return status.New(codes.InvalidArgument, e.Error())
+}
+
 // InvalidURLError is returned when the URL has syntax errors
 type InvalidURLError struct {
 	Cause error
@@ -411,6 +437,22 @@ func (e *PlatformAlreadyAtTheLatestVersionError) ToRPCStatus() *status.Status {
 	return st
 }
 
+// PlatformPinnedError is returned when the upgrade of a platform is skipped
+// because the platform is pinned to a version in the configuration
+type PlatformPinnedError struct {
+	Platform string
+	Version  string
+}
+
+func (e *PlatformPinnedError) Error() string {
+	return tr("Platform '%[1]s' is pinned to version %[2]s in %[3]s", e.Platform, e.Version, "board_manager.pinned_platforms")
+}
+
+// ToRPCStatus converts the error into a *status.Status
+func (e *PlatformPinnedError) ToRPCStatus() *status.Status {
+	return status.New(codes.FailedPrecondition, e.Error())
+}
+
 // MissingSketchPathError is returned when the sketch path is mandatory and not specified
 type MissingSketchPathError struct{}
 
@@ -548,6 +590,24 @@ func (e *FailedUploadError) ToRPCStatus() *status.Status {
 	return status.New(codes.Internal, e.Error())
 }
 
+// FailedVerifyError is returned when the verification of the uploaded binary fails
+type FailedVerifyError struct {
+	Cause error
+}
+
+func (e *FailedVerifyError) Error() string {
+	return composeErrorMsg(tr("Verification of the uploaded binary failed"), e.Cause)
+}
+
+func (e *FailedVerifyError) Unwrap() error {
+	return e.Cause
+}
+
+// ToRPCStatus converts the error into a *status.Status
+func (e *FailedVerifyError) ToRPCStatus() *status.Status {
+	return status.New(codes.DataLoss, e.Error())
+}
+
 // FailedDebugError is returned when the debug fails
 type FailedDebugError struct {
 	Message string
@@ -604,6 +664,26 @@ func (e *CompileFailedError) ToRPCStatus() *status.Status {
 	return status.New(codes.Internal, e.Error())
 }
 
+// BuildHashMismatchError is returned when the SHA-256 of a build artifact is
+// not the expected one
+type BuildHashMismatchError struct {
+	Artifact string
+	Expected string
+	Actual   string
+}
+
+func (e *BuildHashMismatchError) Error() string {
+	if e.Actual == "" {
+		return tr("Build artifact %s not found", e.Artifact)
+	}
+	return tr("SHA-256 of build artifact %[1]s is %[2]s, expected %[3]s", e.Artifact, e.Actual, e.Expected)
+}
+
+// ToRPCStatus converts the error into a *status.Status
+func (e *BuildHashMismatchError) ToRPCStatus() *status.Status {
+	return status.New(codes.FailedPrecondition, e.Error())
+}
+
 // InvalidArgumentError is returned when an invalid argument is passed to the command
 type InvalidArgumentError struct {
 	Message string
@@ -680,6 +760,38 @@ func (e *UnavailableError) ToRPCStatus() *status.Status {
 	return status.New(codes.Unavailable, e.Error())
 }
 
+// CanceledError is returned when an operation is canceled by the caller
+type CanceledError struct {
+	Message string
+	Cause   error
+}
+
+func (e *CanceledError) Error() string {
+	return composeErrorMsg(e.Message, e.Cause)
+}
+
+func (e *CanceledError) Unwrap() error {
+	return e.Cause
+}
+
+// ToRPCStatus converts the error into a *status.Status
+func (e *CanceledError) ToRPCStatus() *status.Status {
+	return status.New(codes.Canceled, e.Error())
+}
+
+// OfflineModeError is returned when an operation requires a network connection
+// but the offline mode is enabled
+type OfflineModeError struct{}
+
+func (e *OfflineModeError) Error() string {
+	return tr("Network access is disabled in offline mode (network.offline setting or --offline flag)")
+}
+
+// ToRPCStatus converts the error into a *status.Status
+func (e *OfflineModeError) ToRPCStatus() *status.Status {
+	return status.New(codes.FailedPrecondition, e.Error())
+}
+
 // TempDirCreationFailedError is returned if a temp dir could not be created
 type TempDirCreationFailedError struct {
 	Cause error
@@ -16,8 +16,12 @@
 package httpclient
 
 import (
+	"context"
+	"errors"
+	"net"
 	"net/http"
 	"net/url"
+	"os"
 	"time"
 
 	"github.com/arduino/arduino-cli/arduino"
@@ -25,6 +29,7 @@ import (
 	"github.com/arduino/arduino-cli/i18n"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
 	"go.bug.st/downloader/v2"
 )
 
@@ -32,7 +37,18 @@ var tr = i18n.Tr
 
 // DownloadFile downloads a file from a URL into the specified path. An optional config and options may be passed (or nil to use the defaults).
 // A DownloadProgressCB callback function must be passed to monitor download progress.
+// Downloads failed because of a network error or a server error are retried as specified
+// by the network.retries and network.retry_backoff settings.
 func DownloadFile(path *paths.Path, URL string, label string, downloadCB rpc.DownloadProgressCB, config *downloader.Config, options ...downloader.DownloadOptions) error {
+	return DownloadFileWithContext(context.Background(), path, URL, label, downloadCB, config, options...)
+}
+
+// DownloadFileWithContext is like DownloadFile, the given context allows to cancel
+// the wait between the retries of a failed download.
+func DownloadFileWithContext(ctx context.Context, path *paths.Path, URL string, label string, downloadCB rpc.DownloadProgressCB, config *downloader.Config, options ...downloader.DownloadOptions) error {
+	if configuration.Settings != nil && configuration.NetworkOffline(configuration.Settings) {
+		return &arduino.OfflineModeError{}
+	}
 	if config == nil {
 		c, err := GetDownloaderConfig()
 		if err != nil {
@@ -41,10 +57,61 @@ func DownloadFile(path *paths.Path, URL string, label string, downloadCB rpc.Dow
 		config = c
 	}
 
+	retries, backoff := 0, time.Duration(0)
+	if configuration.Settings != nil {
+		retries = configuration.NetworkRetries(configuration.Settings)
+		backoff = configuration.NetworkRetryBackoff(configuration.Settings)
+	}
+	return downloadFileWithRetries(ctx, path, URL, label, downloadCB, config, retries, backoff, options...)
+}
+
+// maxBackoffShift limits the exponential growth of the wait between the retries
+// (and prevents the overflow of the shifted duration).
+const maxBackoffShift = 6
+
+func downloadFileWithRetries(ctx context.Context, path *paths.Path, URL string, label string, downloadCB rpc.DownloadProgressCB, config *downloader.Config, retries int, backoff time.Duration, options ...downloader.DownloadOptions) error {
+	// All the downloads are performed with (idempotent) GET requests, so they
+	// can be safely retried. A retried download is resumed where possible.
+	for attempt := 1; ; attempt++ {
+		retryable, err := downloadFile(path, URL, label, downloadCB, config, options...)
+		if err == nil {
+			return nil
+		}
+		if !retryable || attempt > retries {
+			return err
+		}
+		logrus.WithError(err).Warnf("Download of %s failed, retrying %d/%d", URL, attempt, retries)
+		downloadCB(&rpc.DownloadProgress{
+			Url:          URL,
+			RetryAttempt: int32(attempt),
+			RetryMax:     int32(retries),
+		})
+		shift := attempt - 1
+		if shift > maxBackoffShift {
+			shift = maxBackoffShift
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff << shift):
+		}
+	}
+}
+
+// downloadFile performs a single download attempt, in case of failure it returns
+// also whether the download may succeed if retried.
+func downloadFile(path *paths.Path, URL string, label string, downloadCB rpc.DownloadProgressCB, config *downloader.Config, options ...downloader.DownloadOptions) (bool, error) {
 	d, err := downloader.DownloadWithConfig(path.String(), URL, *config, options...)
 	if err != nil {
-		return err
+		return isRetryableError(err), err
 	}
+
+	// The URL is not reachable for some reason
+	if d.Resp.StatusCode >= 400 && d.Resp.StatusCode <= 599 {
+		d.Close()
+		return isRetryableStatus(d.Resp.StatusCode), &arduino.FailedDownloadError{Message: tr("Server responded with: %s", d.Resp.Status)}
+	}
+
 	downloadCB(&rpc.DownloadProgress{
 		File:      label,
 		Url:       d.URL,
@@ -55,16 +122,33 @@ func DownloadFile(path *paths.Path, URL string, label string, downloadCB rpc.Dow
 		downloadCB(&rpc.DownloadProgress{Downloaded: downloaded})
 	}, 250*time.Millisecond)
 	if err != nil {
-		return err
+		return true, &arduino.FailedDownloadError{Message: tr("Download interrupted (last HTTP status: %s)", d.Resp.Status), Cause: err}
 	}
 
-	// The URL is not reachable for some reason
-	if d.Resp.StatusCode >= 400 && d.Resp.StatusCode <= 599 {
-		return &arduino.FailedDownloadError{Message: tr("Server responded with: %s", d.Resp.Status)}
+	downloadCB(&rpc.DownloadProgress{Completed: true})
+	return false, nil
+}
+
+// isRetryableError returns true if the request failed because of a timeout or of
+// a temporary network error. Other errors (invalid URL, destination file not
+// writable, unknown host, connection refused...) are not going to be solved by
+// retrying the request.
+func isRetryableError(err error) bool {
+	var netErr net.Error
+	if !errors.As(err, &netErr) {
+		return false
 	}
+	return netErr.Timeout() || netErr.Temporary()
+}
 
-	downloadCB(&rpc.DownloadProgress{Completed: true})
-	return nil
+// isRetryableStatus returns true if the HTTP status code reports a (possibly)
+// temporary server side error.
+func isRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests:
+		return true
+	}
+	return statusCode >= 500
 }
 
 // Config is the configuration of the http client
@@ -75,30 +159,61 @@ type Config struct {
 
 // New returns a default http client for use in the arduino-cli
 func New() (*http.Client, error) {
+	if configuration.NetworkOffline(configuration.Settings) {
+		return nil, &arduino.OfflineModeError{}
+	}
 	userAgent := configuration.UserAgent(configuration.Settings)
 	proxy, err := configuration.NetworkProxy(configuration.Settings)
 	if err != nil {
 		return nil, err
 	}
+	if proxy != nil {
+		logrus.Debugf("Using proxy %s (from network.proxy setting)", proxy.Redacted())
+	} else {
+		logrus.Debugf("Using proxy from environment: HTTP_PROXY=%q HTTPS_PROXY=%q NO_PROXY=%q",
+			getEnvAny("HTTP_PROXY", "http_proxy"), getEnvAny("HTTPS_PROXY", "https_proxy"), getEnvAny("NO_PROXY", "no_proxy"))
+	}
 	return NewWithConfig(&Config{UserAgent: userAgent, Proxy: proxy}), nil
 }
 
+func getEnvAny(names ...string) string {
+	for _, name := range names {
+		if val := os.Getenv(name); val != "" {
+			return val
+		}
+	}
+	return ""
+}
+
 // NewWithConfig creates a http client for use in the arduino-cli, with a given configuration
 func NewWithConfig(config *Config) *http.Client {
 	return &http.Client{
 		Transport: &httpClientRoundTripper{
 			transport: &http.Transport{
-				Proxy: http.ProxyURL(config.Proxy),
+				Proxy: proxyFunc(config.Proxy),
 			},
 			userAgent: config.UserAgent,
 		},
 	}
 }
 
+// proxyFunc returns the proxy selection function of the http transport: the given
+// proxy is always used if set, otherwise the standard HTTP_PROXY, HTTPS_PROXY and
+// NO_PROXY environment variables are honored.
+func proxyFunc(proxy *url.URL) func(*http.Request) (*url.URL, error) {
+	if proxy != nil {
+		return http.ProxyURL(proxy)
+	}
+	return http.ProxyFromEnvironment
+}
+
 // GetDownloaderConfig returns the downloader configuration based on current settings.
 func GetDownloaderConfig() (*downloader.Config, error) {
 	httpClient, err := New()
-	if err != nil {
+	var offlineErr *arduino.OfflineModeError
+	if errors.As(err, &offlineErr) {
+		return nil, err
+	} else if err != nil {
 		return nil, &arduino.InvalidArgumentError{Message: tr("Could not connect via HTTP"), Cause: err}
 	}
 	return &downloader.Config{
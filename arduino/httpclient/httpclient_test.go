@@ -16,14 +16,23 @@
 package httpclient
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
+	"go.bug.st/downloader/v2"
 )
 
 func TestUserAgentHeader(t *testing.T) {
@@ -68,3 +77,141 @@ func TestProxy(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, http.StatusNoContent, response.StatusCode)
 }
+
+func TestDownloadFileWithRetries(t *testing.T) {
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch {
+		case r.URL.Path == "/missing":
+			w.WriteHeader(http.StatusNotFound)
+		case r.URL.Path == "/broken" || requests <= 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			fmt.Fprint(w, "content")
+		}
+	}))
+	defer ts.Close()
+
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	config := &downloader.Config{HttpClient: *NewWithConfig(&Config{})}
+
+	// Temporary failures are retried
+	retries := []int32{}
+	downloadCB := func(curr *rpc.DownloadProgress) {
+		if curr.GetRetryAttempt() != 0 {
+			require.Equal(t, int32(3), curr.GetRetryMax())
+			retries = append(retries, curr.GetRetryAttempt())
+		}
+	}
+	file := tmp.Join("file")
+	err = downloadFileWithRetries(context.Background(), file, ts.URL+"/file", "file", downloadCB, config, 3, time.Millisecond)
+	require.NoError(t, err)
+	require.Equal(t, []int32{1, 2}, retries)
+	require.Equal(t, 3, requests)
+	data, err := file.ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "content", string(data))
+
+	// The last HTTP status is reported when all the retries fail
+	requests, retries = 0, []int32{}
+	err = downloadFileWithRetries(context.Background(), tmp.Join("broken"), ts.URL+"/broken", "broken", downloadCB, config, 3, time.Millisecond)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "503 Service Unavailable")
+	require.Equal(t, []int32{1, 2, 3}, retries)
+	require.Equal(t, 4, requests)
+
+	// Permanent failures are not retried
+	requests, retries = 0, []int32{}
+	err = downloadFileWithRetries(context.Background(), tmp.Join("missing"), ts.URL+"/missing", "missing", downloadCB, config, 3, time.Millisecond)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "404 Not Found")
+	require.Empty(t, retries)
+	require.Equal(t, 1, requests)
+
+	// The wait between the retries is interrupted by the cancellation of the context
+	ctx, cancel := context.WithCancel(context.Background())
+	requests, retries = 0, []int32{}
+	cancelCB := func(curr *rpc.DownloadProgress) {
+		if curr.GetRetryAttempt() != 0 {
+			cancel()
+		}
+	}
+	err = downloadFileWithRetries(ctx, tmp.Join("broken"), ts.URL+"/broken", "broken", cancelCB, config, 3, time.Hour)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 1, requests)
+}
+
+func TestDownloadFileConnectionRefused(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	URL := ts.URL
+	ts.Close()
+
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	config := &downloader.Config{HttpClient: *NewWithConfig(&Config{})}
+
+	retries := 0
+	downloadCB := func(curr *rpc.DownloadProgress) {
+		if curr.GetRetryAttempt() != 0 {
+			retries++
+		}
+	}
+	err = downloadFileWithRetries(context.Background(), tmp.Join("file"), URL+"/file", "file", downloadCB, config, 3, time.Millisecond)
+	require.Error(t, err)
+	require.Zero(t, retries)
+}
+
+func TestDownloadFileTimeoutIsRetried(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			time.Sleep(200 * time.Millisecond)
+		}
+		fmt.Fprint(w, "content")
+	}))
+	defer ts.Close()
+
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	httpClient := NewWithConfig(&Config{})
+	httpClient.Timeout = 50 * time.Millisecond
+	config := &downloader.Config{HttpClient: *httpClient}
+
+	err = downloadFileWithRetries(context.Background(), tmp.Join("file"), ts.URL+"/file", "file", func(*rpc.DownloadProgress) {}, config, 3, time.Millisecond)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+func TestProxyOverridesEnvironment(t *testing.T) {
+	t.Setenv("HTTP_PROXY", "http://env-proxy:8080")
+	proxyURL, err := url.Parse("http://setting-proxy:3128")
+	require.NoError(t, err)
+
+	request, err := http.NewRequest("GET", "http://arduino.cc", nil)
+	require.NoError(t, err)
+	proxy, err := proxyFunc(proxyURL)(request)
+	require.NoError(t, err)
+	require.Equal(t, proxyURL, proxy)
+}
+
+func TestDownloadFileOffline(t *testing.T) {
+	defer func(settings *viper.Viper) { configuration.Settings = settings }(configuration.Settings)
+	configuration.Settings = viper.New()
+	configuration.Settings.Set("network.offline", true)
+
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	err = DownloadFile(tmp.Join("file"), "http://arduino.cc", "file", func(*rpc.DownloadProgress) {}, nil)
+	require.ErrorAs(t, err, new(*arduino.OfflineModeError))
+	require.NoFileExists(t, tmp.Join("file").String())
+
+	_, err = GetDownloaderConfig()
+	require.ErrorAs(t, err, new(*arduino.OfflineModeError))
+}
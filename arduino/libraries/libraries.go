@@ -49,6 +49,11 @@ var ValidCategories = map[string]bool{
 
 var tr = i18n.Tr
 
+// GitCommitFileName is the name of the file, in the library installation folder,
+// where the hash of the commit of a library installed from a git repository is
+// recorded.
+const GitCommitFileName = ".git-commit"
+
 // Library represents a library in the system
 type Library struct {
 	Name          string
@@ -76,6 +81,8 @@ type Library struct {
 	IsLegacy               bool
 	Version                *semver.Version
 	License                string
+	GitCommit              string
+	DevelopmentLink        *paths.Path
 	Properties             *properties.Map
 	Examples               paths.PathList
 	declaredHeaders        []string
@@ -142,6 +149,8 @@ func (library *Library) ToRPCLibrary() (*rpc.Library, error) {
 		Examples:          library.Examples.AsStrings(),
 		ProvidesIncludes:  headers,
 		CompatibleWith:    library.CompatibleWith,
+		GitCommit:         library.GitCommit,
+		DevelopmentLink:   library.DevelopmentLink != nil,
 	}, nil
 }
 
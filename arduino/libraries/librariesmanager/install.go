@@ -30,6 +30,7 @@ import (
 	"github.com/codeclysm/extract/v3"
 	"github.com/sirupsen/logrus"
 	"gopkg.in/src-d/go-git.v4"
+	"gopkg.in/src-d/go-git.v4/plumbing"
 )
 
 type alreadyInstalledError struct{}
@@ -91,6 +92,14 @@ func (lm *LibrariesManager) Uninstall(lib *libraries.Library) error {
 	if lib == nil || lib.InstallDir == nil {
 		return fmt.Errorf(tr("install directory not set"))
 	}
+	if lib.DevelopmentLink != nil {
+		// Remove only the link and keep the library sources
+		if err := lib.DevelopmentLink.Remove(); err != nil {
+			return fmt.Errorf(tr("removing lib link: %s"), err)
+		}
+		lm.Libraries[lib.Name].Remove(lib)
+		return nil
+	}
 	if err := lib.InstallDir.RemoveAll(); err != nil {
 		return fmt.Errorf(tr("removing lib directory: %s"), err)
 	}
@@ -184,12 +193,21 @@ func (lm *LibrariesManager) InstallZipLib(ctx context.Context, archivePath strin
 }
 
 //InstallGitLib  installs a library hosted on a git repository on the specified path.
-func (lm *LibrariesManager) InstallGitLib(gitURL string, overwrite bool) error {
+// The ref (a branch, a tag or a commit hash) to install may be specified with the
+// ref parameter or as a suffix of the URL (e.g. `url#v1.2.3`), if omitted the
+// default branch is installed. The hash of the installed commit is recorded in
+// the library installation folder.
+func (lm *LibrariesManager) InstallGitLib(gitURL string, ref string, overwrite bool) error {
 	libsDir := lm.getUserLibrariesDir()
 	if libsDir == nil {
 		return fmt.Errorf(tr("User directory not set"))
 	}
 
+	gitURL, urlRef := splitGitRef(gitURL)
+	if ref == "" {
+		ref = urlRef
+	}
+
 	libraryName, err := parseGitURL(gitURL)
 	if err != nil {
 		logrus.
@@ -216,13 +234,18 @@ func (lm *LibrariesManager) InstallGitLib(gitURL string, overwrite bool) error {
 		WithField("library name", libraryName).
 		WithField("install path", installPath).
 		WithField("git url", gitURL).
+		WithField("git ref", ref).
 		Trace("Installing library")
 
-	_, err = git.PlainClone(installPath.String(), false, &git.CloneOptions{
+	cloneOptions := &git.CloneOptions{
 		URL:      gitURL,
-		Depth:    1,
 		Progress: os.Stdout,
-	})
+	}
+	if ref == "" {
+		// The whole history is needed only to checkout a specific ref
+		cloneOptions.Depth = 1
+	}
+	repo, err := git.PlainClone(installPath.String(), false, cloneOptions)
 	if err != nil {
 		logrus.
 			WithError(err).
@@ -230,6 +253,15 @@ func (lm *LibrariesManager) InstallGitLib(gitURL string, overwrite bool) error {
 		return err
 	}
 
+	commit, err := checkoutGitRef(repo, ref)
+	if err != nil {
+		logrus.
+			WithError(err).
+			Warn("Checking out git ref")
+		installPath.RemoveAll()
+		return err
+	}
+
 	if err := validateLibrary(installPath); err != nil {
 		// Clean up installation directory since this is not a valid library
 		installPath.RemoveAll()
@@ -238,9 +270,138 @@ func (lm *LibrariesManager) InstallGitLib(gitURL string, overwrite bool) error {
 
 	// We don't want the installed library to be a git repository thus we delete this folder
 	installPath.Join(".git").RemoveAll()
+
+	if err := installPath.Join(libraries.GitCommitFileName).WriteFile([]byte(commit.String() + "\n")); err != nil {
+		logrus.
+			WithError(err).
+			Warn("Recording installed git commit")
+	}
 	return nil
 }
 
+// splitGitRef splits the `#ref` suffix from a git URL
+func splitGitRef(gitURL string) (string, string) {
+	if i := strings.LastIndex(gitURL, "#"); i != -1 {
+		return gitURL[:i], gitURL[i+1:]
+	}
+	return gitURL, ""
+}
+
+// checkoutGitRef checks out the given ref (a branch, a tag or a commit hash) in
+// the repository and returns the hash of the checked out commit. If the ref is
+// empty the current HEAD is kept.
+func checkoutGitRef(repo *git.Repository, ref string) (*plumbing.Hash, error) {
+	if ref == "" {
+		head, err := repo.Head()
+		if err != nil {
+			return nil, err
+		}
+		hash := head.Hash()
+		return &hash, nil
+	}
+
+	hash, err := repo.ResolveRevision(plumbing.Revision(ref))
+	if err != nil {
+		// Branches are available only as remote branches after a clone
+		if remoteHash, remoteErr := repo.ResolveRevision(plumbing.Revision("origin/" + ref)); remoteErr == nil {
+			hash, err = remoteHash, nil
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf(tr("git ref %[1]s not found: %[2]s"), ref, err)
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return nil, err
+	}
+	if err := worktree.Checkout(&git.CheckoutOptions{Hash: *hash, Force: true}); err != nil {
+		return nil, err
+	}
+	return hash, nil
+}
+
+// InstallLocalLibLink installs a library from a local directory as a development link:
+// a symlink to the library directory is created in the user libraries folder, in this
+// way the changes to the library sources are immediately available.
+func (lm *LibrariesManager) InstallLocalLibLink(libPath *paths.Path, overwrite bool) error {
+	libsDir := lm.getUserLibrariesDir()
+	if libsDir == nil {
+		return fmt.Errorf(tr("User directory not set"))
+	}
+
+	libPath, err := libPath.Abs()
+	if err != nil {
+		return err
+	}
+	if err := validateLibrary(libPath); err != nil {
+		return err
+	}
+	if err := libsDir.MkdirAll(); err != nil {
+		return err
+	}
+
+	libraryName := libPath.Base()
+	installPath := libsDir.Join(libraryName)
+	if _, err := os.Lstat(installPath.String()); err == nil {
+		if !overwrite {
+			return fmt.Errorf(tr("library %s already installed"), libraryName)
+		}
+		logrus.
+			WithField("library name", libraryName).
+			WithField("install path", installPath).
+			Trace("Deleting library")
+		// RemoveAll doesn't follow symlinks, a previous link is removed without
+		// touching the linked sources
+		if err := installPath.RemoveAll(); err != nil {
+			return err
+		}
+	}
+
+	logrus.
+		WithField("library name", libraryName).
+		WithField("install path", installPath).
+		WithField("library path", libPath).
+		Trace("Linking library")
+	return os.Symlink(libPath.String(), installPath.String())
+}
+
+// InstallLibraryDir installs a library by copying the given directory in the user
+// libraries folder.
+func (lm *LibrariesManager) InstallLibraryDir(libPath *paths.Path, overwrite bool) error {
+	libsDir := lm.getUserLibrariesDir()
+	if libsDir == nil {
+		return fmt.Errorf(tr("User directory not set"))
+	}
+	if err := validateLibrary(libPath); err != nil {
+		return err
+	}
+	if err := libsDir.MkdirAll(); err != nil {
+		return err
+	}
+
+	libraryName := libPath.Base()
+	installPath := libsDir.Join(libraryName)
+	if installPath.Exist() {
+		if !overwrite {
+			return fmt.Errorf(tr("library %s already installed"), libraryName)
+		}
+		logrus.
+			WithField("library name", libraryName).
+			WithField("install path", installPath).
+			Trace("Deleting library")
+		if err := installPath.RemoveAll(); err != nil {
+			return err
+		}
+	}
+
+	logrus.
+		WithField("library name", libraryName).
+		WithField("install path", installPath).
+		Trace("Installing library")
+	return libPath.CopyDirTo(installPath)
+}
+
 // parseGitURL tries to recover a library name from a git URL.
 // Returns an error in case the URL is not a valid git URL.
 func parseGitURL(gitURL string) (string, error) {
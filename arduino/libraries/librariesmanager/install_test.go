@@ -17,9 +17,15 @@ package librariesmanager
 
 import (
 	"testing"
+	"time"
 
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
 	"github.com/arduino/go-paths-helper"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/src-d/go-git.v4"
+	"gopkg.in/src-d/go-git.v4/plumbing"
+	"gopkg.in/src-d/go-git.v4/plumbing/object"
 )
 
 func TestParseGitURL(t *testing.T) {
@@ -103,3 +109,119 @@ func TestValidateLibrary(t *testing.T) {
 	err = validateLibrary(validLib)
 	require.NoError(t, err)
 }
+
+func TestSplitGitRef(t *testing.T) {
+	url, ref := splitGitRef("https://github.com/arduino-libraries/WiFi101.git#0.16.0")
+	require.Equal(t, "https://github.com/arduino-libraries/WiFi101.git", url)
+	require.Equal(t, "0.16.0", ref)
+
+	url, ref = splitGitRef("git@github.com:arduino-libraries/WiFi101.git")
+	require.Equal(t, "git@github.com:arduino-libraries/WiFi101.git", url)
+	require.Equal(t, "", ref)
+}
+
+func TestInstallGitLibRef(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "git_lib_install")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	// Prepare a repository with a tagged commit followed by another commit
+	repoDir := tmp.Join("src", "MyLib")
+	require.NoError(t, repoDir.MkdirAll())
+	repo, err := git.PlainInit(repoDir.String(), false)
+	require.NoError(t, err)
+	worktree, err := repo.Worktree()
+	require.NoError(t, err)
+	commitVersion := func(version string) plumbing.Hash {
+		require.NoError(t, repoDir.Join("MyLib.h").WriteFile([]byte("// "+version+"\n")))
+		require.NoError(t, repoDir.Join("library.properties").WriteFile([]byte("name=MyLib\nversion="+version+"\n")))
+		_, err := worktree.Add(".")
+		require.NoError(t, err)
+		hash, err := worktree.Commit(version, &git.CommitOptions{
+			Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+		})
+		require.NoError(t, err)
+		return hash
+	}
+	tagged := commitVersion("1.0.0")
+	_, err = repo.CreateTag("v1.0.0", tagged, nil)
+	require.NoError(t, err)
+	commitVersion("1.1.0")
+
+	userDir := tmp.Join("libraries")
+	require.NoError(t, userDir.MkdirAll())
+	lm := NewLibraryManager(nil, nil)
+	lm.AddLibrariesDir(userDir, libraries.User)
+
+	require.NoError(t, lm.InstallGitLib(repoDir.String()+"#v1.0.0", "", false))
+	installed := userDir.Join("MyLib")
+	header, err := installed.Join("MyLib.h").ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "// 1.0.0\n", string(header))
+	require.False(t, installed.Join(".git").Exist())
+
+	lib, err := libraries.Load(installed, libraries.User)
+	require.NoError(t, err)
+	require.Equal(t, tagged.String(), lib.GitCommit)
+
+	require.NoError(t, installed.RemoveAll())
+	require.Error(t, lm.InstallGitLib(repoDir.String(), "not-existing", false))
+	require.False(t, installed.Exist())
+}
+
+func TestInstallLocalLibLink(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "local_lib_install")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	libDir := tmp.Join("src", "MyLib")
+	require.NoError(t, libDir.MkdirAll())
+	require.NoError(t, libDir.Join("MyLib.h").WriteFile([]byte("// MyLib\n")))
+
+	userDir := tmp.Join("libraries")
+	lm := NewLibraryManager(nil, nil)
+	lm.AddLibrariesDir(userDir, libraries.User)
+
+	require.NoError(t, lm.InstallLocalLibLink(libDir, false))
+	require.Error(t, lm.InstallLocalLibLink(libDir, false))
+	require.NoError(t, lm.InstallLocalLibLink(libDir, true))
+
+	lm.RescanLibraries()
+	lib := lm.FindByReference(&librariesindex.Reference{Name: "MyLib"})
+	require.NotNil(t, lib)
+	require.NotNil(t, lib.DevelopmentLink)
+	require.True(t, lib.DevelopmentLink.EquivalentTo(userDir.Join("MyLib")))
+
+	// The uninstall removes only the link
+	require.NoError(t, lm.Uninstall(lib))
+	require.False(t, userDir.Join("MyLib").Exist())
+	require.True(t, libDir.Join("MyLib.h").Exist())
+}
+
+func TestInstallLibraryDir(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "lib_dir_install")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	libDir := tmp.Join("src", "MyLib")
+	require.NoError(t, libDir.MkdirAll())
+	require.NoError(t, libDir.Join("MyLib.h").WriteFile([]byte("// MyLib\n")))
+
+	userDir := tmp.Join("libraries")
+	lm := NewLibraryManager(nil, nil)
+	lm.AddLibrariesDir(userDir, libraries.User)
+
+	require.NoError(t, lm.InstallLibraryDir(libDir, false))
+	require.Error(t, lm.InstallLibraryDir(libDir, false))
+	require.NoError(t, lm.InstallLibraryDir(libDir, true))
+
+	lm.RescanLibraries()
+	lib := lm.FindByReference(&librariesindex.Reference{Name: "MyLib"})
+	require.NotNil(t, lib)
+	require.Nil(t, lib.DevelopmentLink)
+
+	// The installed library is a copy of the sources
+	require.NoError(t, lm.Uninstall(lib))
+	require.False(t, userDir.Join("MyLib").Exist())
+	require.True(t, libDir.Join("MyLib.h").Exist())
+}
@@ -17,6 +17,7 @@ package libraries
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/arduino/arduino-cli/arduino/sketch"
@@ -28,10 +29,34 @@ import (
 
 // Load loads a library from the given LibraryLocation
 func Load(libDir *paths.Path, location LibraryLocation) (*Library, error) {
+	var library *Library
+	var err error
 	if libDir.Join("library.properties").Exist() {
-		return makeNewLibrary(libDir, location)
+		library, err = makeNewLibrary(libDir, location)
+	} else {
+		library, err = makeLegacyLibrary(libDir, location)
+	}
+	if err != nil {
+		return nil, err
+	}
+	addGitCommit(library)
+	if isSymlink(libDir) {
+		library.DevelopmentLink = libDir
+	}
+	return library, nil
+}
+
+func isSymlink(path *paths.Path) bool {
+	info, err := os.Lstat(path.String())
+	return err == nil && info.Mode()&os.ModeSymlink != 0
+}
+
+// addGitCommit loads the hash of the commit of a library installed from a git
+// repository
+func addGitCommit(library *Library) {
+	if commit, err := library.InstallDir.Join(GitCommitFileName).ReadFile(); err == nil {
+		library.GitCommit = strings.TrimSpace(string(commit))
 	}
-	return makeLegacyLibrary(libDir, location)
 }
 
 func addUtilityDirectory(library *Library) {
@@ -157,10 +182,10 @@ func addExamples(lib *Library) error {
 		if !file.IsDir() {
 			continue
 		}
+		// Both folders are scanned if present
 		if err := addExamplesToPathList(file, &examples); err != nil {
 			return err
 		}
-		break
 	}
 
 	lib.Examples = examples
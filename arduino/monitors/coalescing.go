@@ -0,0 +1,109 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package monitors
+
+import (
+	"sync"
+	"time"
+)
+
+// CoalescingMonitor wraps a Monitor and batches the incoming data: a Read
+// waits until the given buffer is full or the flush interval expires since
+// the first byte of the batch has been received.
+type CoalescingMonitor struct {
+	Monitor
+	interval  time.Duration
+	chunks    chan []byte
+	pending   []byte
+	err       error
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewCoalescingMonitor creates a CoalescingMonitor that reads from mon using
+// chunks of readBufferSize bytes and flushes them at least every interval.
+func NewCoalescingMonitor(mon Monitor, readBufferSize int, interval time.Duration) *CoalescingMonitor {
+	if readBufferSize <= 0 {
+		readBufferSize = 1024
+	}
+	res := &CoalescingMonitor{
+		Monitor:  mon,
+		interval: interval,
+		chunks:   make(chan []byte, 16),
+		done:     make(chan struct{}),
+	}
+	go res.readLoop(readBufferSize)
+	return res
+}
+
+func (mon *CoalescingMonitor) readLoop(readBufferSize int) {
+	defer close(mon.chunks)
+	for {
+		buf := make([]byte, readBufferSize)
+		n, err := mon.Monitor.Read(buf)
+		if n > 0 {
+			select {
+			case mon.chunks <- buf[:n]:
+			case <-mon.done:
+				// nobody is going to read the data after Close
+				return
+			}
+		}
+		if err != nil || n == 0 {
+			// the error is read only after chunks is closed
+			mon.err = err
+			return
+		}
+	}
+}
+
+// Read bytes from the wrapped monitor, coalescing them in a single batch
+func (mon *CoalescingMonitor) Read(bytes []byte) (int, error) {
+	if len(mon.pending) == 0 {
+		chunk, ok := <-mon.chunks
+		if !ok {
+			return 0, mon.err
+		}
+		mon.pending = chunk
+	}
+	n := copy(bytes, mon.pending)
+	mon.pending = mon.pending[n:]
+
+	timeout := time.NewTimer(mon.interval)
+	defer timeout.Stop()
+	for n < len(bytes) {
+		select {
+		case chunk, ok := <-mon.chunks:
+			if !ok {
+				// return what we have, the error will be returned
+				// on the next Read
+				return n, nil
+			}
+			c := copy(bytes[n:], chunk)
+			n += c
+			mon.pending = chunk[c:]
+		case <-timeout.C:
+			return n, nil
+		}
+	}
+	return n, nil
+}
+
+// Close the wrapped monitor and stop reading from it
+func (mon *CoalescingMonitor) Close() error {
+	mon.closeOnce.Do(func() { close(mon.done) })
+	return mon.Monitor.Close()
+}
@@ -0,0 +1,107 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package monitors
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// pipeMonitor is a Monitor that reads the data written in a pipe
+type pipeMonitor struct {
+	*io.PipeReader
+}
+
+func (mon *pipeMonitor) Write(bytes []byte) (int, error) {
+	return len(bytes), nil
+}
+
+func TestCoalescingMonitor(t *testing.T) {
+	r, w := io.Pipe()
+	mon := NewCoalescingMonitor(&pipeMonitor{r}, 16, 100*time.Millisecond)
+
+	go func() {
+		w.Write([]byte("ab"))
+		w.Write([]byte("cd"))
+		w.Write([]byte("ef"))
+	}()
+	buf := make([]byte, 6)
+	n, err := mon.Read(buf)
+	require.NoError(t, err)
+	require.Equal(t, "abcdef", string(buf[:n]))
+
+	// the batch is flushed after the interval even if the buffer is not full
+	go w.Write([]byte("gh"))
+	buf = make([]byte, 100)
+	start := time.Now()
+	n, err = mon.Read(buf)
+	require.NoError(t, err)
+	require.Equal(t, "gh", string(buf[:n]))
+	require.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+
+	// data exceeding the buffer is kept for the next read
+	go w.Write([]byte("0123456789"))
+	buf = make([]byte, 4)
+	n, err = mon.Read(buf)
+	require.NoError(t, err)
+	require.Equal(t, "0123", string(buf[:n]))
+	n, err = mon.Read(buf)
+	require.NoError(t, err)
+	require.Equal(t, "4567", string(buf[:n]))
+
+	w.Close()
+	n, err = mon.Read(buf)
+	require.NoError(t, err)
+	require.Equal(t, "89", string(buf[:n]))
+	_, err = mon.Read(buf)
+	require.Equal(t, io.EOF, err)
+}
+
+// endlessMonitor is a Monitor that always has data to read, even after Close
+type endlessMonitor struct{}
+
+func (mon *endlessMonitor) Read(bytes []byte) (int, error) {
+	return copy(bytes, "data"), nil
+}
+
+func (mon *endlessMonitor) Write(bytes []byte) (int, error) {
+	return len(bytes), nil
+}
+
+func (mon *endlessMonitor) Close() error {
+	return nil
+}
+
+func TestCoalescingMonitorClose(t *testing.T) {
+	mon := NewCoalescingMonitor(&endlessMonitor{}, 16, time.Millisecond)
+	require.NoError(t, mon.Close())
+
+	// the read loop stops even if the data is not consumed anymore
+	stopped := make(chan bool)
+	go func() {
+		for range mon.chunks {
+		}
+		stopped <- true
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the read loop has not been stopped")
+	}
+}
@@ -0,0 +1,101 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package monitors
+
+import (
+	"bufio"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// mirrorFlushInterval is the interval at which the mirror file is flushed
+var mirrorFlushInterval = time.Second
+
+// MirrorMonitor wraps a Monitor and copies all the data read from it
+// into a file, prefixing each line with a timestamp.
+type MirrorMonitor struct {
+	Monitor
+	lock       sync.Mutex
+	file       *os.File
+	out        *bufio.Writer
+	timestamps *lineTimestamper
+	done       chan bool
+	closeOnce  sync.Once
+}
+
+// NewMirrorMonitor creates a MirrorMonitor that appends the data read from
+// mon to the file at path.
+func NewMirrorMonitor(mon Monitor, path string) (*MirrorMonitor, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, errors.Wrap(err, tr("error opening monitor mirror file"))
+	}
+	res := &MirrorMonitor{
+		Monitor:    mon,
+		file:       file,
+		out:        bufio.NewWriter(file),
+		timestamps: newLineTimestamper(),
+		done:       make(chan bool),
+	}
+	go res.flushLoop()
+	return res, nil
+}
+
+func (mon *MirrorMonitor) flushLoop() {
+	ticker := time.NewTicker(mirrorFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			mon.lock.Lock()
+			mon.out.Flush()
+			mon.lock.Unlock()
+		case <-mon.done:
+			return
+		}
+	}
+}
+
+// Read bytes from the wrapped monitor and copy them into the mirror file
+func (mon *MirrorMonitor) Read(bytes []byte) (int, error) {
+	n, err := mon.Monitor.Read(bytes)
+	if n > 0 {
+		mon.lock.Lock()
+		mon.out.Write(mon.timestamps.stamp(bytes[:n]))
+		mon.lock.Unlock()
+	}
+	return n, err
+}
+
+// Close the wrapped monitor and the mirror file
+func (mon *MirrorMonitor) Close() error {
+	err := mon.Monitor.Close()
+	mon.closeOnce.Do(func() {
+		close(mon.done)
+		mon.lock.Lock()
+		defer mon.lock.Unlock()
+		if flushErr := mon.out.Flush(); flushErr != nil && err == nil {
+			err = flushErr
+		}
+		if closeErr := mon.file.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	})
+	return err
+}
@@ -0,0 +1,52 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package monitors
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMirrorMonitor(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "test_monitor_mirror")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	mirrorFile := tmp.Join("mirror.log")
+
+	r, w := io.Pipe()
+	mon, err := NewMirrorMonitor(&pipeMonitor{r}, mirrorFile.String())
+	require.NoError(t, err)
+	mon.timestamps.now = func() time.Time { return time.Date(2021, 6, 1, 10, 20, 30, 0, time.UTC) }
+
+	go w.Write([]byte("line1\nli"))
+	buf := make([]byte, 100)
+	n, err := mon.Read(buf)
+	require.NoError(t, err)
+	require.Equal(t, "line1\nli", string(buf[:n]))
+	go w.Write([]byte("ne2\n"))
+	_, err = mon.Read(buf)
+	require.NoError(t, err)
+
+	require.NoError(t, mon.Close())
+	data, err := mirrorFile.ReadFile()
+	require.NoError(t, err)
+	prefix := "2021-06-01T10:20:30.000Z -> "
+	require.Equal(t, prefix+"line1\n"+prefix+"line2\n", string(data))
+}
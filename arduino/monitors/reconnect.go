@@ -0,0 +1,178 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package monitors
+
+import (
+	"sync"
+	"time"
+
+	"go.bug.st/serial/enumerator"
+)
+
+// reconnectPollInterval is the interval between two scans of the serial
+// ports while waiting for a disconnected port to come back
+var reconnectPollInterval = 500 * time.Millisecond
+
+// ReconnectingSerialMonitor is a serial monitor that, when the port
+// disappears (for example after a board reset), waits for the same device to
+// re-enumerate and transparently reopens it. The device is matched by USB
+// VID/PID/serial number, or by port name for non-USB ports.
+// Data written while the port is disconnected is discarded.
+type ReconnectingSerialMonitor struct {
+	lock        sync.Mutex
+	mon         *SerialMonitor
+	portName    string
+	baudRate    int
+	device      *enumerator.PortDetails
+	closed      bool
+	onReconnect func(portName string)
+}
+
+// OpenReconnectingSerialMonitor creates a ReconnectingSerialMonitor for the
+// serial port portName
+func OpenReconnectingSerialMonitor(portName string, baudRate int) (*ReconnectingSerialMonitor, error) {
+	mon, err := OpenSerialMonitor(portName, baudRate)
+	if err != nil {
+		return nil, err
+	}
+	res := &ReconnectingSerialMonitor{
+		mon:      mon,
+		portName: portName,
+		baudRate: baudRate,
+	}
+	if ports, err := enumerator.GetDetailedPortsList(); err == nil {
+		for _, port := range ports {
+			if port.Name == portName && port.IsUSB {
+				res.device = port
+			}
+		}
+	}
+	return res, nil
+}
+
+// OnReconnect sets a callback called each time the port is reopened
+func (mon *ReconnectingSerialMonitor) OnReconnect(cb func(portName string)) {
+	mon.lock.Lock()
+	defer mon.lock.Unlock()
+	mon.onReconnect = cb
+}
+
+// Read bytes from the port, waiting for the device to reconnect if needed
+func (mon *ReconnectingSerialMonitor) Read(bytes []byte) (int, error) {
+	for {
+		mon.lock.Lock()
+		current := mon.mon
+		mon.lock.Unlock()
+
+		n, err := current.Read(bytes)
+		if n > 0 {
+			return n, err
+		}
+		if reconnected := mon.reconnect(); !reconnected {
+			return n, err
+		}
+	}
+}
+
+// reconnect waits for the device to come back and reopens it, it returns
+// false if the monitor has been closed in the meantime
+func (mon *ReconnectingSerialMonitor) reconnect() bool {
+	mon.lock.Lock()
+	if mon.closed {
+		mon.lock.Unlock()
+		return false
+	}
+	mon.mon.Close()
+	mon.lock.Unlock()
+
+	for {
+		time.Sleep(reconnectPollInterval)
+
+		mon.lock.Lock()
+		if mon.closed {
+			mon.lock.Unlock()
+			return false
+		}
+		mon.lock.Unlock()
+
+		portName := mon.findDevice()
+		if portName == "" {
+			continue
+		}
+		newMon, err := OpenSerialMonitor(portName, mon.baudRate)
+		if err != nil {
+			// the port may not be ready yet
+			continue
+		}
+
+		mon.lock.Lock()
+		if mon.closed {
+			mon.lock.Unlock()
+			newMon.Close()
+			return false
+		}
+		mon.mon = newMon
+		mon.portName = portName
+		cb := mon.onReconnect
+		mon.lock.Unlock()
+		if cb != nil {
+			cb(portName)
+		}
+		return true
+	}
+}
+
+// findDevice returns the name of the port of the monitored device, or an
+// empty string if it's not connected
+func (mon *ReconnectingSerialMonitor) findDevice() string {
+	ports, err := enumerator.GetDetailedPortsList()
+	if err != nil {
+		return ""
+	}
+	for _, port := range ports {
+		if mon.device == nil {
+			if port.Name == mon.portName {
+				return port.Name
+			}
+		} else if port.IsUSB &&
+			port.VID == mon.device.VID &&
+			port.PID == mon.device.PID &&
+			port.SerialNumber == mon.device.SerialNumber {
+			return port.Name
+		}
+	}
+	return ""
+}
+
+// Write bytes to the port
+func (mon *ReconnectingSerialMonitor) Write(bytes []byte) (int, error) {
+	mon.lock.Lock()
+	current := mon.mon
+	mon.lock.Unlock()
+	if n, err := current.Write(bytes); err == nil {
+		return n, nil
+	}
+	// the port is disconnected, discard the data
+	return len(bytes), nil
+}
+
+// Close the port and stop waiting for reconnections
+func (mon *ReconnectingSerialMonitor) Close() error {
+	mon.lock.Lock()
+	defer mon.lock.Unlock()
+	mon.closed = true
+	return mon.mon.Close()
+}
@@ -0,0 +1,258 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package monitors
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+// sharedMonitorBufferSize is the number of chunks of data buffered for each
+// client of a shared monitor. The data exceeding the buffer of a shared
+// read-only client is dropped for that client, to avoid a slow reader
+// blocking the others, while the exclusive client stops the reads from the
+// target until it consumes its buffer.
+const sharedMonitorBufferSize = 64
+
+var sharedTargets = map[string]*sharedTarget{}
+var sharedTargetsLock sync.Mutex
+
+// sharedTarget is an opened monitor whose incoming data is dispatched to
+// all its clients
+type sharedTarget struct {
+	key     string
+	mon     Monitor
+	lock    sync.Mutex
+	clients map[*SharedMonitor]bool
+	writer  *SharedMonitor
+}
+
+// SharedMonitor is a client of a monitor that may be shared between many
+// clients. Only the client that opened the monitor in exclusive mode is
+// allowed to write.
+type SharedMonitor struct {
+	target        *sharedTarget
+	writable      bool
+	data          chan []byte
+	done          chan struct{}
+	reconnections chan string
+	dropped       int64
+	pending       []byte
+	err           error
+	close         sync.Once
+}
+
+// OpenShared returns a client of the monitor identified by key. If the
+// monitor is not already open it's opened with the open function. If
+// exclusive is true the client is allowed to write to the monitor, only one
+// exclusive client at a time is allowed.
+func OpenShared(key string, exclusive bool, open func() (Monitor, error)) (*SharedMonitor, error) {
+	sharedTargetsLock.Lock()
+	defer sharedTargetsLock.Unlock()
+
+	target, ok := sharedTargets[key]
+	if !ok {
+		mon, err := open()
+		if err != nil {
+			return nil, err
+		}
+		target = &sharedTarget{
+			key:     key,
+			mon:     mon,
+			clients: map[*SharedMonitor]bool{},
+		}
+		sharedTargets[key] = target
+		if r, ok := mon.(reconnectNotifier); ok {
+			r.OnReconnect(target.notifyReconnect)
+		}
+		go target.readLoop()
+	}
+
+	target.lock.Lock()
+	defer target.lock.Unlock()
+	if exclusive && target.writer != nil {
+		return nil, errors.New(tr("monitor already opened in exclusive mode by another client"))
+	}
+	client := &SharedMonitor{
+		target:        target,
+		writable:      exclusive,
+		data:          make(chan []byte, sharedMonitorBufferSize),
+		done:          make(chan struct{}),
+		reconnections: make(chan string, 1),
+	}
+	target.clients[client] = true
+	if exclusive {
+		target.writer = client
+	}
+	return client, nil
+}
+
+func (target *sharedTarget) readLoop() {
+	for {
+		buf := make([]byte, 1024)
+		n, err := target.mon.Read(buf)
+		if n > 0 {
+			target.dispatch(buf[:n])
+		}
+		if err != nil || n == 0 {
+			target.terminate(err)
+			return
+		}
+	}
+}
+
+// dispatch sends a chunk of incoming data to all the clients. The exclusive
+// client receives all the data, blocking until it has room for the chunk,
+// while the chunks that a read-only client can't keep up with are dropped.
+func (target *sharedTarget) dispatch(chunk []byte) {
+	target.lock.Lock()
+	writer := target.writer
+	for client := range target.clients {
+		if client == writer {
+			continue
+		}
+		select {
+		case client.data <- chunk:
+		default:
+			// the client is not keeping up, drop the data
+			atomic.AddInt64(&client.dropped, int64(len(chunk)))
+		}
+	}
+	target.lock.Unlock()
+
+	// wait without holding the lock, the writer may be closed meanwhile
+	if writer != nil {
+		select {
+		case writer.data <- chunk:
+		case <-writer.done:
+		}
+	}
+}
+
+// reconnectNotifier is implemented by the monitors that may reopen their
+// target after a disconnection
+type reconnectNotifier interface {
+	OnReconnect(cb func(portName string))
+}
+
+// notifyReconnect tells all the clients that the target has been reopened
+func (target *sharedTarget) notifyReconnect(portName string) {
+	target.lock.Lock()
+	defer target.lock.Unlock()
+	for client := range target.clients {
+		select {
+		case client.reconnections <- portName:
+		default:
+		}
+	}
+}
+
+// terminate removes the target from the shared targets and closes the data
+// stream of all the clients
+func (target *sharedTarget) terminate(err error) {
+	sharedTargetsLock.Lock()
+	if sharedTargets[target.key] == target {
+		delete(sharedTargets, target.key)
+	}
+	sharedTargetsLock.Unlock()
+
+	target.lock.Lock()
+	defer target.lock.Unlock()
+	if len(target.clients) > 0 {
+		// the monitor has not been closed by the last client
+		target.mon.Close()
+	}
+	for client := range target.clients {
+		client.err = err
+		close(client.data)
+		close(client.reconnections)
+		delete(target.clients, client)
+	}
+	target.writer = nil
+}
+
+// Read bytes from the shared monitor
+func (mon *SharedMonitor) Read(bytes []byte) (int, error) {
+	if len(mon.pending) == 0 {
+		select {
+		case chunk, ok := <-mon.data:
+			if !ok {
+				return 0, mon.err
+			}
+			mon.pending = chunk
+		case <-mon.done:
+			return 0, nil
+		}
+	}
+	n := copy(bytes, mon.pending)
+	mon.pending = mon.pending[n:]
+	return n, nil
+}
+
+// Dropped returns the number of bytes of incoming data dropped because the
+// client was not keeping up, since the previous call
+func (mon *SharedMonitor) Dropped() int {
+	return int(atomic.SwapInt64(&mon.dropped, 0))
+}
+
+// Reconnections returns a channel that receives the new port name each time
+// the target is reopened after a disconnection. The channel is closed when
+// the client is closed.
+func (mon *SharedMonitor) Reconnections() <-chan string {
+	return mon.reconnections
+}
+
+// Write bytes to the shared monitor, this is allowed only for the client
+// that opened the monitor in exclusive mode
+func (mon *SharedMonitor) Write(bytes []byte) (int, error) {
+	if !mon.writable {
+		return 0, errors.New(tr("monitor opened in shared read-only mode"))
+	}
+	return mon.target.mon.Write(bytes)
+}
+
+// Close the client, the monitor is closed when its last client is closed
+func (mon *SharedMonitor) Close() error {
+	var err error
+	mon.close.Do(func() {
+		target := mon.target
+		sharedTargetsLock.Lock()
+		defer sharedTargetsLock.Unlock()
+		target.lock.Lock()
+		defer target.lock.Unlock()
+
+		if _, ok := target.clients[mon]; !ok {
+			// the target has already been terminated
+			return
+		}
+		delete(target.clients, mon)
+		// the data channel is closed only by the read loop, that may be
+		// sending to it
+		close(mon.done)
+		close(mon.reconnections)
+		if target.writer == mon {
+			target.writer = nil
+		}
+		if len(target.clients) == 0 {
+			if sharedTargets[target.key] == target {
+				delete(sharedTargets, target.key)
+			}
+			err = target.mon.Close()
+		}
+	})
+	return err
+}
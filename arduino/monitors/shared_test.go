@@ -0,0 +1,155 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package monitors
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSharedMonitor(t *testing.T) {
+	r, w := io.Pipe()
+	opened := 0
+	open := func() (Monitor, error) {
+		opened++
+		return &pipeMonitor{r}, nil
+	}
+
+	writer, err := OpenShared("test", true, open)
+	require.NoError(t, err)
+	reader, err := OpenShared("test", false, open)
+	require.NoError(t, err)
+	require.Equal(t, 1, opened)
+
+	// only one exclusive client is allowed
+	_, err = OpenShared("test", true, open)
+	require.Error(t, err)
+
+	// the readers can't write
+	_, err = reader.Write([]byte("x"))
+	require.Error(t, err)
+	n, err := writer.Write([]byte("x"))
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	// the incoming data is sent to all the clients
+	go w.Write([]byte("hello"))
+	buf := make([]byte, 10)
+	n, err = writer.Read(buf)
+	require.NoError(t, err)
+	require.Equal(t, "hello", string(buf[:n]))
+	n, err = reader.Read(buf)
+	require.NoError(t, err)
+	require.Equal(t, "hello", string(buf[:n]))
+
+	// closing the writer allows another exclusive client
+	require.NoError(t, writer.Close())
+	writer, err = OpenShared("test", true, open)
+	require.NoError(t, err)
+	require.Equal(t, 1, opened)
+
+	// the monitor is closed with the last client
+	require.NoError(t, reader.Close())
+	require.NoError(t, writer.Close())
+	_, err = w.Write([]byte("closed"))
+	require.Error(t, err)
+	_, ok := sharedTargets["test"]
+	require.False(t, ok)
+}
+
+func TestSharedMonitorSlowClients(t *testing.T) {
+	r, w := io.Pipe()
+	writer, err := OpenShared("test-slow", true, func() (Monitor, error) { return &pipeMonitor{r}, nil })
+	require.NoError(t, err)
+	reader, err := OpenShared("test-slow", false, nil)
+	require.NoError(t, err)
+
+	chunks := sharedMonitorBufferSize + 36
+	go func() {
+		for i := 0; i < chunks; i++ {
+			w.Write([]byte("x"))
+		}
+	}()
+
+	// the exclusive client receives all the data, even if it exceeds its
+	// buffer...
+	received := 0
+	buf := make([]byte, 10)
+	for received < chunks {
+		n, err := writer.Read(buf)
+		require.NoError(t, err)
+		received += n
+	}
+	require.Equal(t, chunks, received)
+
+	// ...while the data exceeding the buffer of the read-only client is
+	// dropped and counted
+	require.Equal(t, 36, reader.Dropped())
+	require.Equal(t, 0, reader.Dropped())
+	require.Equal(t, 0, writer.Dropped())
+
+	require.NoError(t, reader.Close())
+	require.NoError(t, writer.Close())
+}
+
+func TestSharedMonitorTargetClosed(t *testing.T) {
+	r, w := io.Pipe()
+	reader1, err := OpenShared("test-closed", false, func() (Monitor, error) { return &pipeMonitor{r}, nil })
+	require.NoError(t, err)
+	reader2, err := OpenShared("test-closed", false, nil)
+	require.NoError(t, err)
+
+	w.Close()
+	buf := make([]byte, 10)
+	_, err = reader1.Read(buf)
+	require.Equal(t, io.EOF, err)
+	_, err = reader2.Read(buf)
+	require.Equal(t, io.EOF, err)
+	require.NoError(t, reader1.Close())
+	require.NoError(t, reader2.Close())
+}
+
+// reconnectingPipeMonitor is a pipeMonitor that can simulate a reconnection
+type reconnectingPipeMonitor struct {
+	pipeMonitor
+	cb func(string)
+}
+
+func (mon *reconnectingPipeMonitor) OnReconnect(cb func(string)) {
+	mon.cb = cb
+}
+
+func TestSharedMonitorReconnections(t *testing.T) {
+	r, w := io.Pipe()
+	target := &reconnectingPipeMonitor{pipeMonitor: pipeMonitor{r}}
+	client1, err := OpenShared("test-reconnect", true, func() (Monitor, error) { return target, nil })
+	require.NoError(t, err)
+	client2, err := OpenShared("test-reconnect", false, nil)
+	require.NoError(t, err)
+
+	require.NotNil(t, target.cb)
+	target.cb("/dev/ttyACM1")
+	require.Equal(t, "/dev/ttyACM1", <-client1.Reconnections())
+	require.Equal(t, "/dev/ttyACM1", <-client2.Reconnections())
+
+	require.NoError(t, client1.Close())
+	_, ok := <-client1.Reconnections()
+	require.False(t, ok)
+	require.NoError(t, client2.Close())
+	w.Close()
+}
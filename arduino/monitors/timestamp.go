@@ -0,0 +1,90 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package monitors
+
+import (
+	"bytes"
+	"time"
+)
+
+// TimestampFormat is the ISO-8601 format used to prefix the lines
+const TimestampFormat = "2006-01-02T15:04:05.000Z07:00"
+
+// lineTimestamper prefixes each line of a stream of data with a timestamp.
+// The timestamp of a line is added when its first byte is received, so a
+// line that spans multiple chunks gets only one timestamp.
+type lineTimestamper struct {
+	midLine bool
+	now     func() time.Time
+}
+
+func newLineTimestamper() *lineTimestamper {
+	return &lineTimestamper{now: time.Now}
+}
+
+// stamp returns data with a timestamp inserted at the beginning of each line
+func (ts *lineTimestamper) stamp(data []byte) []byte {
+	if len(data) == 0 {
+		return data
+	}
+	prefix := []byte(ts.now().Format(TimestampFormat) + " -> ")
+	res := make([]byte, 0, len(data)+len(prefix))
+	for len(data) > 0 {
+		if !ts.midLine {
+			res = append(res, prefix...)
+			ts.midLine = true
+		}
+		i := bytes.IndexByte(data, '\n')
+		if i == -1 {
+			res = append(res, data...)
+			break
+		}
+		res = append(res, data[:i+1]...)
+		data = data[i+1:]
+		ts.midLine = false
+	}
+	return res
+}
+
+// TimestampMonitor wraps a Monitor and prefixes each line of the data read
+// with a timestamp.
+type TimestampMonitor struct {
+	Monitor
+	timestamps *lineTimestamper
+	pending    []byte
+}
+
+// NewTimestampMonitor creates a TimestampMonitor that reads from mon
+func NewTimestampMonitor(mon Monitor) *TimestampMonitor {
+	return &TimestampMonitor{
+		Monitor:    mon,
+		timestamps: newLineTimestamper(),
+	}
+}
+
+// Read bytes from the wrapped monitor and add the timestamps
+func (mon *TimestampMonitor) Read(bytes []byte) (int, error) {
+	if len(mon.pending) == 0 {
+		n, err := mon.Monitor.Read(bytes)
+		if n == 0 || err != nil {
+			return n, err
+		}
+		mon.pending = mon.timestamps.stamp(bytes[:n])
+	}
+	n := copy(bytes, mon.pending)
+	mon.pending = mon.pending[n:]
+	return n, nil
+}
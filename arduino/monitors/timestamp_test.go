@@ -0,0 +1,61 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package monitors
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLineTimestamper(t *testing.T) {
+	ts := newLineTimestamper()
+	ts.now = func() time.Time { return time.Date(2021, 6, 1, 10, 20, 30, 400000000, time.UTC) }
+	prefix := "2021-06-01T10:20:30.400Z -> "
+
+	require.Equal(t, prefix+"hello\n", string(ts.stamp([]byte("hello\n"))))
+	require.Equal(t, prefix+"par", string(ts.stamp([]byte("par"))))
+	require.Equal(t, "tial\n"+prefix+"two\n"+prefix+"x", string(ts.stamp([]byte("tial\ntwo\nx"))))
+	require.Equal(t, "", string(ts.stamp([]byte{})))
+}
+
+func TestTimestampMonitor(t *testing.T) {
+	r, w := io.Pipe()
+	mon := NewTimestampMonitor(&pipeMonitor{r})
+	mon.timestamps.now = func() time.Time { return time.Date(2021, 6, 1, 10, 20, 30, 0, time.UTC) }
+	prefix := "2021-06-01T10:20:30.000Z -> "
+
+	go w.Write([]byte("ab\ncd"))
+	buf := make([]byte, 10)
+	n, err := mon.Read(buf)
+	require.NoError(t, err)
+	require.Equal(t, (prefix + "ab\ncd")[:10], string(buf[:n]))
+
+	// the remaining data is returned without reading from the target
+	res := string(buf[:n])
+	for len(res) < len(prefix+"ab\n"+prefix+"cd") {
+		n, err = mon.Read(buf)
+		require.NoError(t, err)
+		res += string(buf[:n])
+	}
+	require.Equal(t, prefix+"ab\n"+prefix+"cd", res)
+
+	w.Close()
+	_, err = mon.Read(buf)
+	require.Equal(t, io.EOF, err)
+}
@@ -76,8 +76,9 @@ func (r *DownloadResource) TestLocalArchiveChecksum(downloadDir *paths.Path) (bo
 		return false, fmt.Errorf(tr("computing hash: %s"), err)
 	}
 
-	if !bytes.Equal(algo.Sum(nil), digest) {
-		return false, fmt.Errorf(tr("archive hash differs from hash in index"))
+	if sum := algo.Sum(nil); !bytes.Equal(sum, digest) {
+		return false, fmt.Errorf(tr("archive hash differs from hash in index: expected %[1]s, got %[2]s:%[3]s (file: %[4]s)"),
+			r.Checksum, split[0], hex.EncodeToString(sum), filePath)
 	}
 
 	return true, nil
@@ -94,7 +95,8 @@ func (r *DownloadResource) TestLocalArchiveSize(downloadDir *paths.Path) (bool,
 		return false, fmt.Errorf(tr("getting archive info: %s"), err)
 	}
 	if info.Size() != r.Size {
-		return false, fmt.Errorf(tr("fetched archive size differs from size specified in index"))
+		return false, fmt.Errorf(tr("fetched archive size differs from size specified in index: expected %[1]d bytes, got %[2]d bytes (file: %[3]s)"),
+			r.Size, info.Size(), filePath)
 	}
 
 	return true, nil
@@ -16,6 +16,7 @@
 package resources
 
 import (
+	"fmt"
 	"net/url"
 	"path"
 	"strings"
@@ -32,6 +33,9 @@ import (
 type IndexResource struct {
 	URL          *url.URL
 	SignatureURL *url.URL
+	// SignatureKey is the public key used to verify the signature, if nil
+	// the bundled Arduino public key is used
+	SignatureKey *paths.Path
 }
 
 // Download will download the index and possibly check the signature using the Arduino's public key.
@@ -80,10 +84,11 @@ func (res *IndexResource) Download(destDir *paths.Path, downloadCB rpc.DownloadP
 		}
 
 		// Check signature...
-		if valid, _, err := security.VerifyArduinoDetachedSignature(tmpIndexPath, tmpSignaturePath); err != nil {
-			return &arduino.PermissionDeniedError{Message: tr("Error verifying signature"), Cause: err}
-		} else if !valid {
-			return &arduino.SignatureVerificationFailedError{File: res.URL.String()}
+		if res.SignatureKey != nil && res.SignatureKey.NotExist() {
+			return &arduino.NotFoundError{Message: tr("Public key %s not found", res.SignatureKey)}
+		}
+		if valid, err := res.verifySignature(tmpIndexPath, tmpSignaturePath); !valid {
+			return &arduino.SignatureVerificationFailedError{File: res.URL.String(), Cause: err}
 		}
 	}
 
@@ -118,3 +123,19 @@ func (res *IndexResource) Download(destDir *paths.Path, downloadCB rpc.DownloadP
 	oldSignature.Remove()
 	return nil
 }
+
+// verifySignature checks the signature of the downloaded index with the
+// SignatureKey or, if not set, with the Arduino public key
+func (res *IndexResource) verifySignature(indexPath, signaturePath *paths.Path) (bool, error) {
+	if res.SignatureKey == nil {
+		valid, _, err := security.VerifyArduinoDetachedSignature(indexPath, signaturePath)
+		return valid, err
+	}
+	key, err := res.SignatureKey.Open()
+	if err != nil {
+		return false, fmt.Errorf(tr("opening public key %[1]s: %[2]s"), res.SignatureKey, err)
+	}
+	defer key.Close()
+	valid, _, err := security.VerifySignature(indexPath, signaturePath, key)
+	return valid, err
+}
@@ -0,0 +1,86 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package resources
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexDownloadWithSignatureKey(t *testing.T) {
+	configuration.Settings = configuration.Init("")
+
+	// Serve a signed index, a tampered copy of it and an unsigned one
+	srvDir, err := paths.MkTempDir("", "index_signature_test")
+	require.NoError(t, err)
+	defer srvDir.RemoveAll()
+	testdata := paths.New("..", "security", "testdata")
+	index, err := testdata.Join("module_firmware_index.json").ReadFile()
+	require.NoError(t, err)
+	require.NoError(t, srvDir.Join("signed_index.json").WriteFile(index))
+	require.NoError(t, testdata.Join("module_firmware_index.json.sig").CopyTo(srvDir.Join("signed_index.json.sig")))
+	require.NoError(t, srvDir.Join("tampered_index.json").WriteFile(append(index, ' ')))
+	require.NoError(t, testdata.Join("module_firmware_index.json.sig").CopyTo(srvDir.Join("tampered_index.json.sig")))
+	require.NoError(t, srvDir.Join("unsigned_index.json").WriteFile(index))
+	srv := httptest.NewServer(http.FileServer(http.Dir(srvDir.String())))
+	defer srv.Close()
+
+	download := func(indexName string, key *paths.Path) error {
+		destDir, err := paths.MkTempDir("", "index_signature_test")
+		require.NoError(t, err)
+		defer destDir.RemoveAll()
+		indexURL, err := url.Parse(srv.URL + "/" + indexName)
+		require.NoError(t, err)
+		signatureURL, err := url.Parse(srv.URL + "/" + indexName + ".sig")
+		require.NoError(t, err)
+		res := &IndexResource{URL: indexURL, SignatureURL: signatureURL, SignatureKey: key}
+		err = res.Download(destDir, func(*rpc.DownloadProgress) {})
+		if err == nil {
+			require.True(t, destDir.Join(indexName).Exist())
+		} else {
+			require.False(t, destDir.Join(indexName).Exist())
+		}
+		return err
+	}
+
+	key := testdata.Join("module_firmware_index_public.gpg.key")
+	require.NoError(t, download("signed_index.json", key))
+
+	var signatureErr *arduino.SignatureVerificationFailedError
+	err = download("tampered_index.json", key)
+	require.True(t, errors.As(err, &signatureErr), "unexpected error: %v", err)
+
+	// The index is not signed with the Arduino key
+	err = download("signed_index.json", nil)
+	require.True(t, errors.As(err, &signatureErr), "unexpected error: %v", err)
+
+	var downloadErr *arduino.FailedDownloadError
+	err = download("unsigned_index.json", key)
+	require.True(t, errors.As(err, &downloadErr), "unexpected error: %v", err)
+
+	var notFoundErr *arduino.NotFoundError
+	err = download("signed_index.json", paths.New("missing.gpg.key"))
+	require.True(t, errors.As(err, &notFoundErr), "unexpected error: %v", err)
+}
@@ -110,3 +110,30 @@ func TestDownloadAndChecksums(t *testing.T) {
 	_, err = r.TestLocalArchiveChecksum(tmp)
 	require.Error(t, err)
 }
+
+func TestLocalArchiveIntegrityErrors(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	require.NoError(t, tmp.Join("cache").MkdirAll())
+	testFile := tmp.Join("cache", "corrupted.zip")
+	require.NoError(t, testFile.WriteFile([]byte("corrupted")))
+
+	r := &DownloadResource{
+		ArchiveFileName: "corrupted.zip",
+		CachePath:       "cache",
+		Checksum:        "SHA-256:6a338cf4d6d501176a2d352c87a8d72ac7488b8c5b82cdf2a4e2cef630391092",
+		Size:            9,
+	}
+	_, err = r.TestLocalArchiveChecksum(tmp)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "expected SHA-256:6a338cf4d6d501176a2d352c87a8d72ac7488b8c5b82cdf2a4e2cef630391092")
+	require.Contains(t, err.Error(), "got SHA-256:3dbb3963d11aa418de8b61f846c3dbd5af43b40d252842adb823f90936fe6920")
+	require.Contains(t, err.Error(), testFile.String())
+
+	r.Size = 486
+	_, err = r.TestLocalArchiveSize(tmp)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "expected 486 bytes, got 9 bytes")
+	require.Contains(t, err.Error(), testFile.String())
+}
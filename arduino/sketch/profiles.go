@@ -0,0 +1,211 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+	semver "go.bug.st/relaxed-semver"
+	"gopkg.in/yaml.v2"
+)
+
+// Project represents all the profiles defined for the sketch in the sketch.yaml
+// project file
+type Project struct {
+	Profiles       map[string]*Profile `yaml:"profiles"`
+	DefaultProfile string              `yaml:"default_profile,omitempty"`
+}
+
+// Profile is a sketch profile, it pins the platforms and the libraries
+// used to build the sketch
+type Profile struct {
+	Name      string                   `yaml:"-"`
+	Notes     string                   `yaml:"notes,omitempty"`
+	FQBN      string                   `yaml:"fqbn"`
+	Platforms ProfileRequiredPlatforms `yaml:"platforms"`
+	Libraries ProfileRequiredLibraries `yaml:"libraries,omitempty"`
+}
+
+// AsYaml outputs the profile as Yaml, it is intended to be added to the
+// "profiles" section of a sketch project file
+func (p *Profile) AsYaml() string {
+	res := fmt.Sprintf("  %s:\n", p.Name)
+	if p.Notes != "" {
+		res += fmt.Sprintf("    notes: %s\n", p.Notes)
+	}
+	res += fmt.Sprintf("    fqbn: %s\n", p.FQBN)
+	res += p.Platforms.AsYaml()
+	res += p.Libraries.AsYaml()
+	return res
+}
+
+// ProfileRequiredPlatforms is a list of ProfilePlatformReference (platforms
+// required to build the sketch using this profile)
+type ProfileRequiredPlatforms []*ProfilePlatformReference
+
+// AsYaml outputs the required platforms as Yaml
+func (p *ProfileRequiredPlatforms) AsYaml() string {
+	res := "    platforms:\n"
+	for _, platform := range *p {
+		res += platform.AsYaml()
+	}
+	return res
+}
+
+// ProfileRequiredLibraries is a list of ProfileLibraryReference (libraries
+// required to build the sketch using this profile)
+type ProfileRequiredLibraries []*ProfileLibraryReference
+
+// AsYaml outputs the required libraries as Yaml
+func (p *ProfileRequiredLibraries) AsYaml() string {
+	if len(*p) == 0 {
+		return ""
+	}
+	res := "    libraries:\n"
+	for _, lib := range *p {
+		res += lib.AsYaml()
+	}
+	return res
+}
+
+// ProfilePlatformReference is a reference to a platform
+type ProfilePlatformReference struct {
+	Packager         string
+	Architecture     string
+	Version          *semver.Version
+	PlatformIndexURL *url.URL
+}
+
+func (p *ProfilePlatformReference) String() string {
+	return fmt.Sprintf("%s:%s@%s", p.Packager, p.Architecture, p.Version)
+}
+
+// AsYaml outputs the platform reference as Yaml
+func (p *ProfilePlatformReference) AsYaml() string {
+	res := fmt.Sprintf("      - platform: %s:%s (%s)\n", p.Packager, p.Architecture, p.Version)
+	if p.PlatformIndexURL != nil {
+		res += fmt.Sprintf("        platform_index_url: %s\n", p.PlatformIndexURL)
+	}
+	return res
+}
+
+// UnmarshalYAML decodes a ProfilePlatformReference from YAML source.
+func (p *ProfilePlatformReference) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var data map[string]string
+	if err := unmarshal(&data); err != nil {
+		return err
+	}
+	platformID, ok := data["platform"]
+	if !ok {
+		return fmt.Errorf(tr("missing '%s' directive"), "platform")
+	}
+	platformID, version, err := parseNameAndVersion(platformID)
+	if err != nil {
+		return fmt.Errorf(tr("invalid platform '%[1]s': %[2]s"), data["platform"], err)
+	}
+	split := strings.SplitN(platformID, ":", 2)
+	if len(split) != 2 || split[0] == "" || split[1] == "" {
+		return fmt.Errorf(tr("invalid platform identifier: '%s'"), platformID)
+	}
+	p.Packager, p.Architecture, p.Version = split[0], split[1], version
+	if rawIndexURL, ok := data["platform_index_url"]; ok {
+		indexURL, err := url.Parse(rawIndexURL)
+		if err != nil {
+			return fmt.Errorf(tr("invalid platform index URL '%[1]s': %[2]s"), rawIndexURL, err)
+		}
+		p.PlatformIndexURL = indexURL
+	}
+	return nil
+}
+
+// ProfileLibraryReference is a reference to a library
+type ProfileLibraryReference struct {
+	Library string
+	Version *semver.Version
+}
+
+func (l *ProfileLibraryReference) String() string {
+	return fmt.Sprintf("%s@%s", l.Library, l.Version)
+}
+
+// AsYaml outputs the library reference as Yaml
+func (l *ProfileLibraryReference) AsYaml() string {
+	return fmt.Sprintf("      - %s (%s)\n", l.Library, l.Version)
+}
+
+// UnmarshalYAML decodes a ProfileLibraryReference from YAML source.
+func (l *ProfileLibraryReference) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var data string
+	if err := unmarshal(&data); err != nil {
+		return err
+	}
+	name, version, err := parseNameAndVersion(data)
+	if err != nil {
+		return fmt.Errorf(tr("invalid library '%[1]s': %[2]s"), data, err)
+	}
+	l.Library, l.Version = name, version
+	return nil
+}
+
+// parseNameAndVersion parses a string in the form "NAME (VERSION)"
+func parseNameAndVersion(in string) (string, *semver.Version, error) {
+	split := strings.SplitN(strings.TrimSpace(in), " (", 2)
+	if len(split) != 2 || !strings.HasSuffix(split[1], ")") {
+		return "", nil, fmt.Errorf(tr("expected format is 'NAME (VERSION)'"))
+	}
+	version, err := semver.Parse(strings.TrimSuffix(split[1], ")"))
+	if err != nil {
+		return "", nil, err
+	}
+	return strings.TrimSpace(split[0]), version, nil
+}
+
+// ProfileNames returns the names of the profiles defined in the project, sorted
+// alphabetically
+func (p *Project) ProfileNames() []string {
+	names := []string{}
+	for name := range p.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LoadProjectFile reads a sketch project file
+func LoadProjectFile(file *paths.Path) (*Project, error) {
+	data, err := file.ReadFile()
+	if err != nil {
+		return nil, err
+	}
+	res := &Project{}
+	if err := yaml.Unmarshal(data, res); err != nil {
+		return nil, err
+	}
+	for name, profile := range res.Profiles {
+		if profile == nil {
+			return nil, fmt.Errorf(tr("profile '%s' is empty"), name)
+		}
+		profile.Name = name
+	}
+	if res.DefaultProfile != "" && res.Profiles[res.DefaultProfile] == nil {
+		return nil, fmt.Errorf(tr("default profile '%s' not found"), res.DefaultProfile)
+	}
+	return res, nil
+}
@@ -0,0 +1,96 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2022 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSketchWithProfiles(t *testing.T) {
+	sketch, err := New(paths.New("testdata", "SketchWithProfiles"))
+	require.NoError(t, err)
+	require.NotNil(t, sketch.Project)
+	require.Equal(t, []string{"avr", "esp", "nanorp"}, sketch.Project.ProfileNames())
+
+	defaultProfile := sketch.GetProfile("")
+	require.NotNil(t, defaultProfile)
+	require.Equal(t, "avr", defaultProfile.Name)
+	require.Equal(t, "arduino:avr:uno", defaultProfile.FQBN)
+	require.Len(t, defaultProfile.Platforms, 1)
+	require.Equal(t, "arduino:avr@1.8.5", defaultProfile.Platforms[0].String())
+	require.Nil(t, defaultProfile.Platforms[0].PlatformIndexURL)
+
+	nanorp := sketch.GetProfile("nanorp")
+	require.NotNil(t, nanorp)
+	require.Equal(t, "Arduino Nano RP2040 Connect", nanorp.Notes)
+	require.Len(t, nanorp.Libraries, 2)
+	require.Equal(t, "ArduinoIoTCloud@1.0.2", nanorp.Libraries[0].String())
+	require.Equal(t, "Arduino_ConnectionHandler@0.6.4", nanorp.Libraries[1].String())
+
+	esp := sketch.GetProfile("esp")
+	require.NotNil(t, esp)
+	require.Equal(t, "https://arduino.esp8266.com/stable/package_esp8266com_index.json", esp.Platforms[0].PlatformIndexURL.String())
+
+	require.Nil(t, sketch.GetProfile("missing"))
+}
+
+func TestLoadInvalidProjectFile(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	projectFile := tmp.Join("sketch.yaml")
+
+	invalid := []string{
+		"profiles:\n  uno:\n    fqbn: arduino:avr:uno\n    platforms:\n      - platform: arduino:avr\n",
+		"profiles:\n  uno:\n    fqbn: arduino:avr:uno\n    platforms:\n      - platform: avr (1.8.5)\n",
+		"profiles:\n  uno:\n    fqbn: arduino:avr:uno\n    libraries:\n      - Servo (latest)\n",
+		"profiles:\n  uno:\n",
+		"profiles:\n  uno:\n    fqbn: arduino:avr:uno\ndefault_profile: mega\n",
+	}
+	for _, content := range invalid {
+		require.NoError(t, projectFile.WriteFile([]byte(content)))
+		_, err := LoadProjectFile(projectFile)
+		require.Error(t, err, content)
+	}
+}
+
+func TestProfileAsYaml(t *testing.T) {
+	sketch, err := New(paths.New("testdata", "SketchWithProfiles"))
+	require.NoError(t, err)
+
+	esp := sketch.GetProfile("esp")
+	require.Equal(t, ""+
+		"  esp:\n"+
+		"    fqbn: esp8266:esp8266:generic\n"+
+		"    platforms:\n"+
+		"      - platform: esp8266:esp8266 (3.0.2)\n"+
+		"        platform_index_url: https://arduino.esp8266.com/stable/package_esp8266com_index.json\n",
+		esp.AsYaml())
+
+	// The generated profile must be loadable from a project file
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	projectFile := tmp.Join("sketch.yaml")
+	nanorp := sketch.GetProfile("nanorp")
+	require.NoError(t, projectFile.WriteFile([]byte("profiles:\n"+nanorp.AsYaml())))
+	project, err := LoadProjectFile(projectFile)
+	require.NoError(t, err)
+	require.Equal(t, nanorp, project.Profiles["nanorp"])
+}
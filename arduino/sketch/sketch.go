@@ -39,6 +39,7 @@ type Sketch struct {
 	AdditionalFiles  paths.PathList
 	RootFolderFiles  paths.PathList // All files that are in the Sketch root
 	Metadata         *Metadata
+	Project          *Project // Project contains the profiles defined in the sketch.yaml file, if present
 }
 
 // Metadata is the kind of data associated to a project such as the connected board
@@ -154,9 +155,37 @@ func New(path *paths.Path) (*Sketch, error) {
 	if err := sketch.importMetadata(); err != nil {
 		return nil, fmt.Errorf(tr("importing sketch metadata: %s"), err)
 	}
+	if projectFile := sketch.GetProjectPath(); projectFile.Exist() {
+		project, err := LoadProjectFile(projectFile)
+		if err != nil {
+			return nil, fmt.Errorf(tr("error loading sketch project file %[1]s: %[2]s"), projectFile, err)
+		}
+		sketch.Project = project
+	}
 	return sketch, nil
 }
 
+// GetProjectPath returns the path to the sketch project file (sketch.yaml)
+func (s *Sketch) GetProjectPath() *paths.Path {
+	if yml := s.FullPath.Join("sketch.yml"); yml.Exist() {
+		return yml
+	}
+	return s.FullPath.Join("sketch.yaml")
+}
+
+// GetProfile returns the requested profile, if an empty name is given the default
+// profile is returned. nil is returned if the profile is not found (or if no
+// default profile is set).
+func (s *Sketch) GetProfile(name string) *Profile {
+	if s.Project == nil {
+		return nil
+	}
+	if name == "" {
+		name = s.Project.DefaultProfile
+	}
+	return s.Project.Profiles[name]
+}
+
 // supportedFiles reads all files recursively contained in Sketch and
 // filter out unneded or unsupported ones and returns them
 func (s *Sketch) supportedFiles() (*paths.PathList, error) {
@@ -18,6 +18,7 @@ package utils
 import (
 	"strings"
 	"unicode"
+	"unicode/utf8"
 
 	"golang.org/x/text/runes"
 	"golang.org/x/text/transform"
@@ -43,22 +44,24 @@ func removeDiatrics(s string) (string, error) {
 	return s, nil
 }
 
+// NormalizeForSearch transforms s to lower case and removes its accents and
+// other unicode diatrics, as done by Match before comparing strings.
+func NormalizeForSearch(s string) string {
+	s = strings.ToLower(s)
+	if s2, err := removeDiatrics(s); err == nil {
+		return s2
+	}
+	return s
+}
+
 // Match returns true if all substrings are contained in str.
 // Both str and substrings are transforms to lower case and have their
 // accents and other unicode diatrics removed.
 // If strings transformation fails an error is returned.
 func Match(str string, substrings []string) bool {
-	clean := func(s string) string {
-		s = strings.ToLower(s)
-		if s2, err := removeDiatrics(s); err == nil {
-			return s2
-		}
-		return s
-	}
-
-	str = clean(str)
+	str = NormalizeForSearch(str)
 	for _, sub := range substrings {
-		if !strings.Contains(str, clean(sub)) {
+		if !strings.Contains(str, NormalizeForSearch(sub)) {
 			return false
 		}
 	}
@@ -79,3 +82,85 @@ func MatchAny(query string, arrayToMatch []string) bool {
 	}
 	return false
 }
+
+// FuzzyMatch checks if word matches str and returns true if the match is
+// exact, that is if word is contained in str. Otherwise word matches if it
+// differs by at most one character from one of the words in str, or from
+// their beginning, two characters for words with 8 or more characters. Words shorter than 4
+// characters must always match exactly.
+// Both word and str must have been normalized with NormalizeForSearch.
+func FuzzyMatch(word, str string) (matches bool, exact bool) {
+	if strings.Contains(str, word) {
+		return true, true
+	}
+	wordLen := utf8.RuneCountInString(word)
+	maxDistance := 0
+	if wordLen >= 8 {
+		maxDistance = 2
+	} else if wordLen >= 4 {
+		maxDistance = 1
+	}
+	if maxDistance == 0 {
+		return false, false
+	}
+	isSeparator := func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) }
+	for _, candidate := range strings.FieldsFunc(str, isSeparator) {
+		if editDistance(word, candidate, maxDistance) <= maxDistance {
+			return true, false
+		}
+		// Allow misspelled prefixes, like "sevro" for "servomotor"
+		if prefix := []rune(candidate); len(prefix) > wordLen {
+			if editDistance(word, string(prefix[:wordLen]), maxDistance) <= maxDistance {
+				return true, false
+			}
+		}
+	}
+	return false, false
+}
+
+// editDistance returns the number of single character insertions, deletions,
+// substitutions or transpositions of adjacent characters needed to transform
+// a into b. The computation stops as soon as the distance is known to be
+// greater than max, returning max+1.
+func editDistance(a, b string, max int) int {
+	ra, rb := []rune(a), []rune(b)
+	if d := len(ra) - len(rb); d > max || -d > max {
+		return max + 1
+	}
+	prevPrev := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = prev[j-1] + cost
+			if v := prev[j] + 1; v < curr[j] {
+				curr[j] = v
+			}
+			if v := curr[j-1] + 1; v < curr[j] {
+				curr[j] = v
+			}
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				if v := prevPrev[j-2] + 1; v < curr[j] {
+					curr[j] = v
+				}
+			}
+			if curr[j] < rowMin {
+				rowMin = curr[j]
+			}
+		}
+		if rowMin > max {
+			return max + 1
+		}
+		prevPrev, prev, curr = prev, curr, prevPrev
+	}
+	return prev[len(rb)]
+}
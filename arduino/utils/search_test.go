@@ -0,0 +1,50 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFuzzyMatch(t *testing.T) {
+	check := func(word, str string, expectedMatch, expectedExact bool) {
+		matches, exact := FuzzyMatch(word, str)
+		require.Equal(t, expectedMatch, matches, "matching %s with %s", word, str)
+		require.Equal(t, expectedExact, exact, "exact matching %s with %s", word, str)
+	}
+	check("servo", "arduino servo library", true, true)
+	check("serv", "arduino servo library", true, true)
+	check("sevro", "arduino servo library", true, false)
+	check("servi", "arduino servo library", true, false)
+	check("sevro", "servomotor library", true, false)
+	check("acelerometer", "an accelerometer-based library", true, false)
+	check("dht", "dth sensor", false, false)
+	check("motor", "arduino servo library", false, false)
+	// The length of the words is counted in characters, not bytes
+	check("шим", "шил library", false, false)
+	check("мотр", "мотор library", true, false)
+}
+
+func TestEditDistance(t *testing.T) {
+	require.Equal(t, 0, editDistance("servo", "servo", 2))
+	require.Equal(t, 1, editDistance("servo", "serv", 2))
+	require.Equal(t, 1, editDistance("servo", "sevro", 2))
+	require.Equal(t, 2, editDistance("kitten", "sittin", 2))
+	require.Equal(t, 3, editDistance("kitten", "sitting", 2))
+	require.Equal(t, 2, editDistance("abc", "abcdefgh", 1))
+}
@@ -58,9 +58,9 @@ func NewSketch(sketchPath *paths.Path) *sketch.Sketch {
 func WarnDeprecatedFiles(sketchPath *paths.Path) {
 	// .pde files are still supported but deprecated, this warning urges the user to rename them
 	if files := sketch.CheckForPdeFiles(sketchPath); len(files) > 0 {
-		feedback.Error(tr("Sketches with .pde extension are deprecated, please rename the following files to .ino:"))
+		feedback.Warning(tr("Sketches with .pde extension are deprecated, please rename the following files to .ino:"))
 		for _, f := range files {
-			feedback.Error(f)
+			feedback.Warning(f)
 		}
 	}
 }
@@ -138,6 +138,15 @@ func (dr detailsResult) String() string {
 		}
 	}
 
+	if memory := details.GetMemory(); len(memory.GetRegions()) > 0 {
+		t.AddRow() // get some space from above
+		header := tr("Memory (bytes):")
+		for _, region := range memory.GetRegions() {
+			t.AddRow(header, region.GetName(), fmt.Sprint(region.GetSize()))
+			header = ""
+		}
+	}
+
 	t.AddRow() // get some space from above
 	addIfNotEmpty(tr("Package name:"), details.Package.Name)
 	addIfNotEmpty(tr("Package maintainer:"), details.Package.Maintainer)
@@ -36,8 +36,9 @@ func initSearchCommand() *cobra.Command {
 	var searchCommand = &cobra.Command{
 		Use:   fmt.Sprintf("search [%s]", tr("boardname")),
 		Short: tr("List all known boards and their corresponding FQBN."),
-		Long: tr(`List all boards that have the support platform installed. You can search
-for a specific board if you specify the board name`),
+		Long: tr(`List all the boards of the installed platforms and of the platforms available
+in the package indexes, with the platform and the version providing them. You can
+search for a specific board if you specify part of the board name`),
 		Example: "" +
 			"  " + os.Args[0] + " board search\n" +
 			"  " + os.Args[0] + " board search zero",
@@ -82,13 +83,16 @@ func (r searchResults) String() string {
 	})
 
 	t := table.New()
-	t.SetHeader(tr("Board Name"), tr("FQBN"), tr("Platform ID"), "")
+	t.SetHeader(tr("Board Name"), tr("FQBN"), tr("Platform ID"), tr("Version"), "")
 	for _, item := range r.boards {
-		hidden := ""
+		notes := []string{}
+		if !item.GetInstalled() {
+			notes = append(notes, tr("(not installed)"))
+		}
 		if item.IsHidden {
-			hidden = tr("(hidden)")
+			notes = append(notes, tr("(hidden)"))
 		}
-		t.AddRow(item.GetName(), item.GetFqbn(), item.Platform.Id, hidden)
+		t.AddRow(item.GetName(), item.GetFqbn(), item.Platform.Id, item.GetPlatformVersion(), strings.Join(notes, " "))
 	}
 	return t.Render()
 }
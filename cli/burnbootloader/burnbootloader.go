@@ -74,7 +74,7 @@ func runBootloaderCommand(command *cobra.Command, args []string) {
 		os.Exit(errorcodes.ErrGeneric)
 	}
 
-	if _, err := upload.BurnBootloader(context.Background(), &rpc.BurnBootloaderRequest{
+	res, err := upload.BurnBootloader(context.Background(), &rpc.BurnBootloaderRequest{
 		Instance:   instance,
 		Fqbn:       fqbn.String(),
 		Port:       discoveryPort.ToRPC(),
@@ -82,9 +82,28 @@ func runBootloaderCommand(command *cobra.Command, args []string) {
 		Verify:     verify,
 		Programmer: programmer.String(),
 		DryRun:     dryRun,
-	}, os.Stdout, os.Stderr); err != nil {
+	}, os.Stdout, os.Stderr, nil)
+	if err != nil {
 		feedback.Errorf(tr("Error during Upload: %v"), err)
 		os.Exit(errorcodes.ErrGeneric)
 	}
+	feedback.PrintResult(burnBootloaderResult{res})
 	os.Exit(0)
 }
+
+// output from this command requires special formatting, let's create a dedicated
+// feedback.Result implementation
+type burnBootloaderResult struct {
+	res *rpc.BurnBootloaderResponse
+}
+
+func (r burnBootloaderResult) Data() interface{} {
+	return r.res
+}
+
+func (r burnBootloaderResult) String() string {
+	if r.res.GetProgrammer() == nil {
+		return tr("Bootloader burned successfully")
+	}
+	return tr("Bootloader burned successfully using programmer %s", r.res.GetProgrammer().GetName())
+}
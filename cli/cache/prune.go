@@ -0,0 +1,95 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package cache
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/commands"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var pruneDryRun bool
+
+func initPruneCommand() *cobra.Command {
+	pruneCommand := &cobra.Command{
+		Use:   "prune",
+		Short: tr("Delete the orphaned archives from the Boards/Library Manager download cache."),
+		Long:  tr("Delete the archives in the `directories.downloads` folder that are not referenced by any installed platform, tool or library. The archives modified in the last 10 minutes are kept, since they may be used by an install in progress."),
+		Example: "  " + os.Args[0] + " cache prune\n" +
+			"  " + os.Args[0] + " cache prune --dry-run",
+		Args: cobra.NoArgs,
+		Run:  runPruneCommand,
+	}
+	pruneCommand.Flags().BoolVar(&pruneDryRun, "dry-run", false, tr("Show the archives that would be removed without removing them."))
+	return pruneCommand
+}
+
+func runPruneCommand(cmd *cobra.Command, args []string) {
+	inst := instance.CreateAndInit()
+	logrus.Info("Executing `arduino-cli cache prune`")
+
+	resp, err := commands.PruneDownloadsCache(context.Background(), &rpc.PruneDownloadsCacheRequest{
+		Instance: inst,
+		DryRun:   pruneDryRun,
+	})
+	if err != nil {
+		feedback.Errorf(tr("Error pruning the download cache: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+	feedback.PrintResult(pruneResult{
+		RemovedFiles: resp.GetRemovedFiles(),
+		Freed:        resp.GetFreed(),
+		DryRun:       pruneDryRun,
+	})
+}
+
+type pruneResult struct {
+	RemovedFiles []string `json:"removed_files"`
+	Freed        int64    `json:"freed"`
+	DryRun       bool     `json:"dry_run"`
+}
+
+func (r pruneResult) Data() interface{} {
+	return r
+}
+
+func (r pruneResult) String() string {
+	if len(r.RemovedFiles) == 0 {
+		return tr("No orphaned archives found.")
+	}
+	res := []string{}
+	for _, file := range r.RemovedFiles {
+		if r.DryRun {
+			res = append(res, tr("Would remove %s", file))
+		} else {
+			res = append(res, tr("Removed %s", file))
+		}
+	}
+	if r.DryRun {
+		res = append(res, tr("%d bytes would be freed.", r.Freed))
+	} else {
+		res = append(res, tr("%d bytes freed.", r.Freed))
+	}
+	return strings.Join(res, "\n")
+}
@@ -30,9 +30,11 @@ import (
 	"github.com/arduino/arduino-cli/cli/core"
 	"github.com/arduino/arduino-cli/cli/daemon"
 	"github.com/arduino/arduino-cli/cli/debug"
+	"github.com/arduino/arduino-cli/cli/doctor"
 	"github.com/arduino/arduino-cli/cli/errorcodes"
 	"github.com/arduino/arduino-cli/cli/feedback"
 	"github.com/arduino/arduino-cli/cli/generatedocs"
+	i18ncmd "github.com/arduino/arduino-cli/cli/i18n"
 	"github.com/arduino/arduino-cli/cli/globals"
 	"github.com/arduino/arduino-cli/cli/lib"
 	"github.com/arduino/arduino-cli/cli/monitor"
@@ -57,8 +59,10 @@ import (
 
 var (
 	verbose            bool
+	quiet              bool
 	outputFormat       string
-	configFile         string
+	progressFormat     string
+	configFiles        []string
 	updaterMessageChan chan *semver.Version = make(chan *semver.Version)
 )
 
@@ -92,7 +96,10 @@ func createCliCommandTree(cmd *cobra.Command) {
 	cmd.AddCommand(config.NewCommand())
 	cmd.AddCommand(core.NewCommand())
 	cmd.AddCommand(daemon.NewCommand())
+	cmd.AddCommand(doctor.NewCommand())
+	cmd.AddCommand(errorcodes.NewCommand())
 	cmd.AddCommand(generatedocs.NewCommand())
+	cmd.AddCommand(i18ncmd.NewCommand())
 	cmd.AddCommand(lib.NewCommand())
 	cmd.AddCommand(monitor.NewCommand())
 	cmd.AddCommand(outdated.NewCommand())
@@ -105,6 +112,7 @@ func createCliCommandTree(cmd *cobra.Command) {
 	cmd.AddCommand(version.NewCommand())
 
 	cmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, tr("Print the logs on the standard output."))
+	cmd.PersistentFlags().BoolVar(&quiet, "quiet", false, tr("Suppress the progress and the informational messages, only the errors and the results are printed."))
 	validLogLevels := []string{"trace", "debug", "info", "warn", "error", "fatal", "panic"}
 	cmd.PersistentFlags().String("log-level", "", tr("Messages with this level and above will be logged. Valid levels are: %s", strings.Join(validLogLevels, ", ")))
 	cmd.RegisterFlagCompletionFunc("log-level", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -121,9 +129,15 @@ func createCliCommandTree(cmd *cobra.Command) {
 	cmd.RegisterFlagCompletionFunc("format", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return validOutputFormats, cobra.ShellCompDirectiveDefault
 	})
-	cmd.PersistentFlags().StringVar(&configFile, "config-file", "", tr("The custom config file (if not specified the default will be used)."))
+	validProgressFormats := []string{"bar", "ndjson"}
+	cmd.PersistentFlags().StringVar(&progressFormat, "progress-format", "bar", tr("The format of the progress reports, can be: %s. With ndjson each progress update is printed as a JSON object on a single line.", strings.Join(validProgressFormats, ", ")))
+	cmd.RegisterFlagCompletionFunc("progress-format", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return validProgressFormats, cobra.ShellCompDirectiveDefault
+	})
+	cmd.PersistentFlags().StringArrayVar(&configFiles, "config-file", []string{}, tr("The custom config file (if not specified the default will be used). Can be used multiple times, the values set by a file override the ones set by the previous files."))
 	cmd.PersistentFlags().StringSlice("additional-urls", []string{}, tr("Comma-separated list of additional URLs for the Boards Manager."))
-	cmd.PersistentFlags().Bool("no-color", false, "Disable colored output.")
+	cmd.PersistentFlags().Bool("no-color", false, tr("Disable colored output."))
+	cmd.PersistentFlags().Bool("offline", false, tr("Run in offline mode: the commands requiring a network connection fail immediately."))
 	configuration.BindFlags(cmd, configuration.Settings)
 }
 
@@ -165,7 +179,19 @@ func preRun(cmd *cobra.Command, args []string) {
 	}
 
 	// https://no-color.org/
-	color.NoColor = configuration.Settings.GetBool("output.no_color") || os.Getenv("NO_COLOR") != ""
+	switch colorMode := strings.ToLower(configuration.Settings.GetString("output.color")); {
+	case configuration.Settings.GetBool("output.no_color") || os.Getenv("NO_COLOR") != "":
+		color.NoColor = true
+	case colorMode == "always":
+		color.NoColor = false
+	case colorMode == "never":
+		color.NoColor = true
+	case colorMode == "auto":
+		// color.NoColor is already set if the output is not a terminal
+	default:
+		feedback.Errorf(tr("Invalid value for output.color: %s, can be: auto, always, never"), colorMode)
+		os.Exit(errorcodes.ErrBadCall)
+	}
 
 	// Set default feedback output to colorable
 	feedback.SetOut(colorable.NewColorableStdout())
@@ -249,6 +275,14 @@ func preRun(cmd *cobra.Command, args []string) {
 
 	// use the output format to configure the Feedback
 	feedback.SetFormat(format)
+	feedback.SetQuiet(quiet)
+
+	progressFormat = strings.ToLower(progressFormat)
+	if progressFormat != "bar" && progressFormat != "ndjson" {
+		feedback.Errorf(tr("Invalid progress format: %s"), progressFormat)
+		os.Exit(errorcodes.ErrBadCall)
+	}
+	output.ProgressFormat = progressFormat
 
 	//
 	// Print some status info and check command is consistent
@@ -256,6 +290,11 @@ func preRun(cmd *cobra.Command, args []string) {
 
 	if configFile != "" {
 		logrus.Infof("Using config file: %s", configFile)
+		for i, mergedConfigFile := range configuration.ConfigFilesUsed() {
+			if i > 0 {
+				logrus.Infof("Merging config file: %s", mergedConfigFile)
+			}
+		}
 	} else {
 		logrus.Info("Config file not found, using default values")
 	}
@@ -273,7 +312,7 @@ func preRun(cmd *cobra.Command, args []string) {
 
 func postRun(cmd *cobra.Command, args []string) {
 	latestVersion := <-updaterMessageChan
-	if latestVersion != nil {
+	if latestVersion != nil && !quiet {
 		// Notify the user a new version is available
 		updater.NotifyNewVersionIsAvailable(latestVersion.String())
 	}
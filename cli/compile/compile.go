@@ -21,6 +21,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
 	"strings"
 
@@ -58,7 +60,6 @@ var (
 	encryptKey              string               // The name of the custom encryption key to use to encrypt a binary during the compile process. Used only by the platforms that supports it
 	warnings                string               // Used to tell gcc which warning level to use.
 	verbose                 bool                 // Turns on verbose mode.
-	quiet                   bool                 // Suppresses almost every output.
 	vidPid                  string               // VID/PID specific build properties.
 	uploadAfterCompile      bool                 // Upload the binary after the compilation.
 	port                    arguments.Port       // Upload port, e.g.: COM10 or /dev/ttyACM0.
@@ -67,8 +68,14 @@ var (
 	optimizeForDebug        bool                 // Optimize compile output for debug, not for release
 	programmer              arguments.Programmer // Use the specified programmer to upload
 	clean                   bool                 // Cleanup the build folder and do not use any cached build
+	jobs                    int32                // Max number of parallel compiles
 	compilationDatabaseOnly bool                 // Only create compilation database without actually compiling
+	dryRun                  bool                 // Print the build commands without running them
 	sourceOverrides         string               // Path to a .json file that contains a set of replacements of the sketch source code.
+	profile                 string               // Profile of the sketch project file to use for the build
+	definesFile             string               // Path of a file of NAME=VALUE macros passed to the compiler
+	expectedSHA256          []string             // Expected SHA-256 of the build artifacts, as NAME=HASH
+	watch                   bool                 // Compile again every time the sketch changes
 	// library and libraries sound similar but they're actually different.
 	// library expects a path to the root folder of one single library.
 	// libraries expects a path to a directory containing multiple libraries, similarly to the <directories.user>/libraries path.
@@ -87,7 +94,9 @@ func NewCommand() *cobra.Command {
 			"  " + os.Args[0] + " compile -b arduino:avr:uno /home/user/Arduino/MySketch\n" +
 			"  " + os.Args[0] + ` compile -b arduino:avr:uno --build-property "build.extra_flags=\"-DMY_DEFINE=\"hello world\"\"" /home/user/Arduino/MySketch` + "\n" +
 			"  " + os.Args[0] + ` compile -b arduino:avr:uno --build-property "build.extra_flags=-DPIN=2 \"-DMY_DEFINE=\"hello world\"\"" /home/user/Arduino/MySketch` + "\n" +
-			"  " + os.Args[0] + ` compile -b arduino:avr:uno --build-property build.extra_flags=-DPIN=2 --build-property "compiler.cpp.extra_flags=\"-DSSID=\"hello world\"\"" /home/user/Arduino/MySketch` + "\n",
+			"  " + os.Args[0] + ` compile -b arduino:avr:uno --build-property build.extra_flags=-DPIN=2 --build-property "compiler.cpp.extra_flags=\"-DSSID=\"hello world\"\"" /home/user/Arduino/MySketch` + "\n" +
+			"  " + os.Args[0] + " compile -b arduino:avr:uno --defines-file /home/user/secrets.txt /home/user/Arduino/MySketch\n" +
+			"  " + os.Args[0] + " compile -b arduino:avr:uno --watch /home/user/Arduino/MySketch\n",
 		Args: cobra.MaximumNArgs(1),
 		Run:  runCompileCommand,
 	}
@@ -112,7 +121,6 @@ func NewCommand() *cobra.Command {
 	compileCommand.Flags().StringVar(&warnings, "warnings", "none",
 		tr(`Optional, can be: %s. Used to tell gcc which warning level to use (-W flag).`, "none, default, more, all"))
 	compileCommand.Flags().BoolVarP(&verbose, "verbose", "v", false, tr("Optional, turns on verbose mode."))
-	compileCommand.Flags().BoolVar(&quiet, "quiet", false, tr("Optional, suppresses almost every output."))
 	compileCommand.Flags().BoolVarP(&uploadAfterCompile, "upload", "u", false, tr("Upload the binary after the compilation."))
 	port.AddToCommand(compileCommand)
 	compileCommand.Flags().BoolVarP(&verify, "verify", "t", false, tr("Verify uploaded binary after the upload."))
@@ -124,7 +132,15 @@ func NewCommand() *cobra.Command {
 	compileCommand.Flags().BoolVar(&optimizeForDebug, "optimize-for-debug", false, tr("Optional, optimize compile output for debugging, rather than for release."))
 	programmer.AddToCommand(compileCommand)
 	compileCommand.Flags().BoolVar(&compilationDatabaseOnly, "only-compilation-database", false, tr("Just produce the compilation database, without actually compiling. All build commands are skipped except pre* hooks."))
+	compileCommand.Flags().BoolVar(&dryRun, "dry-run", false, tr("Print the compile, archive and link commands that would be run, without actually running them."))
+	compileCommand.Flags().StringVarP(&profile, "profile", "m", "", tr("Sketch profile to use, as defined in the sketch project file."))
+	compileCommand.Flags().StringVar(&definesFile, "defines-file", "",
+		tr("Path of a file of NAME=VALUE lines, passed to the compiler as -DNAME=VALUE macros. Useful to keep secrets out of the sketch."))
+	compileCommand.Flags().StringArrayVar(&expectedSHA256, "expect-sha256", []string{},
+		tr("Fail if the SHA-256 of a build artifact is not the expected one, e.g.: %s. Can be used multiple times for multiple artifacts.", "--expect-sha256 MySketch.ino.hex=<hash>"))
 	compileCommand.Flags().BoolVar(&clean, "clean", false, tr("Optional, cleanup the build folder and do not use any cached build."))
+	compileCommand.Flags().Int32VarP(&jobs, "jobs", "j", 0, tr("Max number of parallel compiles. If set to 0 the number of available CPUs cores will be used."))
+	compileCommand.Flags().BoolVar(&watch, "watch", false, tr("Compile again every time a file of the sketch changes, until interrupted."))
 	// We must use the following syntax for this flag since it's also bound to settings.
 	// This must be done because the value is set when the binding is accessed from viper. Accessing from cobra would only
 	// read the value if the flag is set explicitly by the user.
@@ -155,6 +171,10 @@ func runCompileCommand(cmd *cobra.Command, args []string) {
 		arguments.CheckFlagsMandatory(cmd, "keys-keychain", "sign-key", "encrypt-key")
 	}
 
+	arguments.CheckFlagsConflicts(cmd, "watch", "upload")
+	arguments.CheckFlagsConflicts(cmd, "watch", "show-properties")
+	arguments.CheckFlagsConflicts(cmd, "watch", "preprocess")
+
 	var overrides map[string]string
 	if sourceOverrides != "" {
 		data, err := paths.New(sourceOverrides).ReadFile()
@@ -172,6 +192,16 @@ func runCompileCommand(cmd *cobra.Command, args []string) {
 		overrides = o.Overrides
 	}
 
+	expectedArtifactsSHA256 := map[string]string{}
+	for _, expected := range expectedSHA256 {
+		split := strings.SplitN(expected, "=", 2)
+		if len(split) != 2 || split[0] == "" || split[1] == "" {
+			feedback.Errorf(tr("Invalid expected SHA-256 '%s', it must be in the form NAME=HASH", expected))
+			os.Exit(errorcodes.ErrBadArgument)
+		}
+		expectedArtifactsSHA256[split[0]] = split[1]
+	}
+
 	detectedFqbn := fqbn.String()
 	var sk *sketch.Sketch
 	var discoveryPort *discovery.Port
@@ -202,29 +232,43 @@ func runCompileCommand(cmd *cobra.Command, args []string) {
 		BuildProperties:               buildProperties,
 		Warnings:                      warnings,
 		Verbose:                       verbose,
-		Quiet:                         quiet,
+		Quiet:                         feedback.IsQuiet(),
 		VidPid:                        vidPid,
 		ExportDir:                     exportDir,
 		Libraries:                     libraries,
 		OptimizeForDebug:              optimizeForDebug,
 		Clean:                         clean,
+		Jobs:                          jobs,
 		CreateCompilationDatabaseOnly: compilationDatabaseOnly,
+		DryRun:                        dryRun,
 		SourceOverride:                overrides,
 		Library:                       library,
 		KeysKeychain:                  keysKeychain,
 		SignKey:                       signKey,
 		EncryptKey:                    encryptKey,
+		Profile:                       profile,
+		DefinesFile:                   definesFile,
+		ExpectedArtifactsSha256:       expectedArtifactsSHA256,
 	}
 	compileStdOut := new(bytes.Buffer)
 	compileStdErr := new(bytes.Buffer)
 	verboseCompile := configuration.Settings.GetString("logging.level") == "debug"
-	var compileRes *rpc.CompileResponse
-	var compileError error
-	if output.OutputFormat == "json" {
-		compileRes, compileError = compile.Compile(context.Background(), compileRequest, compileStdOut, compileStdErr, nil, verboseCompile)
-	} else {
-		compileRes, compileError = compile.Compile(context.Background(), compileRequest, os.Stdout, os.Stderr, nil, verboseCompile)
+	var compileOut, compileErr io.Writer = os.Stdout, os.Stderr
+	if output.OutputFormat != "text" {
+		compileOut, compileErr = compileStdOut, compileStdErr
+	} else if feedback.IsQuiet() && !preprocess && !showProperties {
+		// Only the errors and the warnings are printed in quiet mode
+		compileOut = compileStdOut
 	}
+	var compileProgress rpc.TaskProgressCB
+	if output.ProgressFormat == "ndjson" {
+		compileProgress = output.TaskProgress()
+	}
+	if watch {
+		runCompileWatch(compileRequest, compileOut, compileErr, compileStdOut, compileStdErr, compileProgress)
+		return
+	}
+	compileRes, compileError := compile.Compile(context.Background(), compileRequest, compileOut, compileErr, compileProgress, verboseCompile)
 
 	if compileError == nil && uploadAfterCompile {
 		if sk == nil {
@@ -260,18 +304,19 @@ func runCompileCommand(cmd *cobra.Command, args []string) {
 			Verify:     verify,
 			ImportDir:  buildPath,
 			Programmer: programmer.String(),
+			DryRun:     dryRun,
 			UserFields: fields,
+			Profile:    profile,
 		}
 
-		var uploadError error
-		if output.OutputFormat == "json" {
+		var uploadOut, uploadErr io.Writer = os.Stdout, os.Stderr
+		if output.OutputFormat != "text" {
 			// TODO: do not print upload output in json mode
-			uploadStdOut := new(bytes.Buffer)
-			uploadStdErr := new(bytes.Buffer)
-			_, uploadError = upload.Upload(context.Background(), uploadRequest, uploadStdOut, uploadStdErr)
-		} else {
-			_, uploadError = upload.Upload(context.Background(), uploadRequest, os.Stdout, os.Stderr)
+			uploadOut, uploadErr = new(bytes.Buffer), new(bytes.Buffer)
+		} else if feedback.IsQuiet() {
+			uploadOut = ioutil.Discard
 		}
+		_, uploadError := upload.Upload(context.Background(), uploadRequest, uploadOut, uploadErr, nil)
 		if uploadError != nil {
 			feedback.Errorf(tr("Error during Upload: %v"), uploadError)
 			os.Exit(errorcodes.ErrGeneric)
@@ -283,6 +328,7 @@ func runCompileCommand(cmd *cobra.Command, args []string) {
 		CompileErr:    compileStdErr.String(),
 		BuilderResult: compileRes,
 		Success:       compileError == nil,
+		verbose:       verbose,
 	})
 	if compileError != nil {
 		feedback.Errorf(tr("Error during build: %v"), compileError)
@@ -317,6 +363,7 @@ type compileResult struct {
 	CompileErr    string               `json:"compiler_err"`
 	BuilderResult *rpc.CompileResponse `json:"builder_result"`
 	Success       bool                 `json:"success"`
+	verbose       bool
 }
 
 func (r *compileResult) Data() interface{} {
@@ -325,5 +372,14 @@ func (r *compileResult) Data() interface{} {
 
 func (r *compileResult) String() string {
 	// The output is already printed via os.Stdout/os.Stdin
-	return ""
+	if !r.verbose || r.BuilderResult.GetBuildHashes() == nil {
+		return ""
+	}
+	hashes := r.BuilderResult.GetBuildHashes()
+	res := tr("SHA-256 of the build artifacts:") + "\n"
+	for _, artifact := range hashes.GetArtifacts() {
+		res += fmt.Sprintf("  %s  %s\n", artifact.GetSha256(), artifact.GetName())
+	}
+	res += tr("SHA-256 of the build commands: %s", hashes.GetCommandsSha256())
+	return res
 }
@@ -0,0 +1,76 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/output"
+	"github.com/arduino/arduino-cli/commands/compile"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// runCompileWatch compiles the sketch every time it changes, until the
+// command is interrupted. A result is printed at the end of each compile.
+func runCompileWatch(req *rpc.CompileRequest, compileOut, compileErr io.Writer, compileStdOut, compileStdErr *bytes.Buffer, progressCB rpc.TaskProgressCB) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctrlc := make(chan os.Signal, 1)
+	signal.Notify(ctrlc, os.Interrupt)
+	defer signal.Stop(ctrlc)
+	go func() {
+		select {
+		case <-ctrlc:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	eventCB := func(event *rpc.CompileWatchResponse) {
+		if event.GetCompileStarted() {
+			if len(event.GetChangedFiles()) > 0 && output.OutputFormat == "text" {
+				feedback.Print(tr("Changes detected in %s, compiling again...", strings.Join(event.GetChangedFiles(), ", ")))
+			}
+			return
+		}
+		feedback.PrintResult(&compileResult{
+			CompileOut:    compileStdOut.String(),
+			CompileErr:    compileStdErr.String(),
+			BuilderResult: event.GetCompileResponse(),
+			Success:       event.GetError() == "",
+		})
+		compileStdOut.Reset()
+		compileStdErr.Reset()
+		if event.GetError() != "" {
+			feedback.Errorf(tr("Error during build: %v"), event.GetError())
+		}
+		if ctx.Err() == nil && output.OutputFormat == "text" {
+			feedback.Print(tr("Watching %s for changes, press Ctrl+C to stop...", req.GetSketchPath()))
+		}
+	}
+	watchReq := &rpc.CompileWatchRequest{CompileRequest: req}
+	if err := compile.Watch(ctx, watchReq, compileOut, compileErr, progressCB, eventCB); err != nil {
+		feedback.Errorf(tr("Error during build: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+}
@@ -52,12 +52,16 @@ func runAddCommand(cmd *cobra.Command, args []string) {
 		feedback.Errorf(tr("The key '%[1]v' is not a list of items, can't add to it.\nMaybe use '%[2]s'?"), key, "config set")
 		os.Exit(errorcodes.ErrGeneric)
 	}
+	if key == "board_manager.index_signature_keys" {
+		feedback.Errorf(tr("The key '%v' is a list of objects, edit the config file to change it."), key)
+		os.Exit(errorcodes.ErrGeneric)
+	}
 
 	v := configuration.Settings.GetStringSlice(key)
 	v = append(v, args[1:]...)
 	configuration.Settings.Set(key, v)
 
-	if err := configuration.Settings.WriteConfig(); err != nil {
+	if err := configuration.WriteConfigFile(configuration.Settings, configuration.Settings.ConfigFileUsed()); err != nil {
 		feedback.Errorf(tr("Can't write config file: %v"), err)
 		os.Exit(errorcodes.ErrGeneric)
 	}
@@ -36,10 +36,12 @@ func NewCommand() *cobra.Command {
 
 	configCommand.AddCommand(initAddCommand())
 	configCommand.AddCommand(initDeleteCommand())
+	configCommand.AddCommand(initDescribeCommand())
 	configCommand.AddCommand(initDumpCommand())
 	configCommand.AddCommand(initInitCommand())
 	configCommand.AddCommand(initRemoveCommand())
 	configCommand.AddCommand(initSetCommand())
+	configCommand.AddCommand(initValidateCommand())
 
 	return configCommand
 }
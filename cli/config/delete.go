@@ -24,7 +24,6 @@ import (
 	"github.com/arduino/arduino-cli/configuration"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 func initDeleteCommand() *cobra.Command {
@@ -48,14 +47,11 @@ func runDeleteCommand(cmd *cobra.Command, args []string) {
 	logrus.Info("Executing `arduino-cli config delete`")
 	toDelete := args[0]
 
-	keys := []string{}
 	exists := false
 	for _, v := range configuration.Settings.AllKeys() {
-		if !strings.HasPrefix(v, toDelete) {
-			keys = append(keys, v)
-			continue
+		if strings.HasPrefix(v, toDelete) {
+			exists = true
 		}
-		exists = true
 	}
 
 	if !exists {
@@ -63,12 +59,15 @@ func runDeleteCommand(cmd *cobra.Command, args []string) {
 		os.Exit(errorcodes.ErrGeneric)
 	}
 
-	updatedSettings := viper.New()
-	for _, k := range keys {
-		updatedSettings.Set(k, configuration.Settings.Get(k))
+	configFile := configuration.Settings.ConfigFileUsed()
+	updatedSettings := configuration.SettingsForFile(configuration.Settings, configFile)
+	for k := range updatedSettings {
+		if strings.HasPrefix(k, toDelete) {
+			delete(updatedSettings, k)
+		}
 	}
 
-	if err := updatedSettings.WriteConfigAs(configuration.Settings.ConfigFileUsed()); err != nil {
+	if err := configuration.WriteSettingsFile(updatedSettings, configFile); err != nil {
 		feedback.Errorf(tr("Can't write config file: %v"), err)
 		os.Exit(errorcodes.ErrGeneric)
 	}
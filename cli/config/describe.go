@@ -0,0 +1,138 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// descriptionsMap contains a description of every key in validMap
+var descriptionsMap = map[string]string{
+	"board_manager.additional_urls":      tr("The URLs to any additional Boards Manager package index files needed for your boards platforms."),
+	"board_manager.index_signature_keys": tr("The public keys used to verify the signatures of the additional Boards Manager package indexes, as a list of url and public_key pairs."),
+	"board_manager.pinned_platforms":     tr("The platforms that must not be upgraded past a version, in the form PACKAGER:ARCH@VERSION."),
+	"daemon.port":                        tr("TCP port used for gRPC client connections."),
+	"directories.data":                   tr("Directory used to store Boards/Library Manager index files and Boards Manager platform installations."),
+	"directories.downloads":              tr("Directory used to stage downloaded archives during Boards/Library Manager installations."),
+	"directories.user":                   tr("The sketchbook directory, libraries are installed in its libraries subfolder."),
+	"library.enable_unsafe_install":      tr("Enables the use of the --git-url and --zip-file flags with lib install."),
+	"i18n.extra_catalogs":                tr("Directory containing additional translation catalogs (<locale>.po or <locale>.json), used before the built-in ones."),
+	"locale":                             tr("The language used for the messages, for example it_IT."),
+	"logging.file":                       tr("Path to the file where logs will be written."),
+	"logging.format":                     tr("Output format for the logs: text or json."),
+	"logging.level":                      tr("Messages with this level and above will be logged: trace, debug, info, warn, error, fatal or panic."),
+	"sketch.always_export_binaries":      tr("Always save the binaries in the sketch folder after the compilation."),
+	"metrics.addr":                       tr("TCP port used for metrics communication."),
+	"metrics.enabled":                    tr("Controls the use of metrics."),
+	"metrics.endpoint":                   tr("URL of a self-hosted collector the metrics are pushed to, using the InfluxDB line protocol."),
+	"network.offline":                    tr("Makes all the operations requiring a network connection fail, without contacting any server."),
+	"network.parallel_downloads":         tr("The maximum number of tools downloaded at the same time during a platform installation."),
+	"network.proxy":                      tr("URL of the proxy server used for the network connections."),
+	"network.retries":                    tr("The number of times a failed download is retried."),
+	"network.retry_backoff":              tr("The time to wait before the first retry of a failed download, doubled at each retry."),
+	"network.user_agent_ext":             tr("Extension added to the user agent of the network requests."),
+	"output.color":                       tr("When to use the colors in the output: auto (only if the output is a terminal), always or never."),
+	"output.no_color":                    tr("Disables the colors in the output."),
+	"updater.enable_notification":        tr("Enables the notifications of new Arduino CLI releases."),
+}
+
+// schemaTypes maps the kinds of the settings to the types of the JSON schema
+var schemaTypes = map[reflect.Kind]string{
+	reflect.Slice:   "array",
+	reflect.String:  "string",
+	reflect.Bool:    "boolean",
+	reflect.Int:     "integer",
+	reflect.Float64: "number",
+}
+
+func initDescribeCommand() *cobra.Command {
+	describeCommand := &cobra.Command{
+		Use:   "describe",
+		Short: tr("Describes all the known settings."),
+		Long:  tr("Describes all the known settings with their type, default value and description. Use --format json to get a machine-readable output."),
+		Example: "" +
+			"  " + os.Args[0] + " config describe\n" +
+			"  " + os.Args[0] + " config describe --format json",
+		Args: cobra.NoArgs,
+		Run:  runDescribeCommand,
+	}
+	return describeCommand
+}
+
+func runDescribeCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino-cli config describe`")
+	feedback.PrintResult(describeResult{Settings: describeSettings()})
+}
+
+// settingDescription describes a configuration key
+type settingDescription struct {
+	Key         string      `json:"key"`
+	Type        string      `json:"type"`
+	Default     interface{} `json:"default,omitempty"`
+	Description string      `json:"description"`
+	EnvVar      string      `json:"env_var"`
+}
+
+// describeSettings returns the description of all the keys in validMap, sorted
+// by key
+func describeSettings() []*settingDescription {
+	defaults := viper.New()
+	configuration.SetDefaults(defaults)
+
+	res := []*settingDescription{}
+	for key, kind := range validMap {
+		res = append(res, &settingDescription{
+			Key:         key,
+			Type:        schemaTypes[kind],
+			Default:     defaults.Get(key),
+			Description: descriptionsMap[key],
+			EnvVar:      configuration.EnvVarNames(key)[0],
+		})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Key < res[j].Key })
+	return res
+}
+
+type describeResult struct {
+	Settings []*settingDescription `json:"settings"`
+}
+
+func (dr describeResult) Data() interface{} {
+	return dr
+}
+
+func (dr describeResult) String() string {
+	t := table.New()
+	t.SetHeader(tr("Key"), tr("Type"), tr("Default"), tr("Description"))
+	for _, setting := range dr.Settings {
+		defaultValue := ""
+		if setting.Default != nil {
+			defaultValue = fmt.Sprint(setting.Default)
+		}
+		t.AddRow(setting.Key, setting.Type, defaultValue, setting.Description)
+	}
+	return t.Render()
+}
@@ -17,31 +17,76 @@ package config
 
 import (
 	"os"
+	"sort"
+	"strings"
 
 	"github.com/arduino/arduino-cli/cli/feedback"
 	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/table"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v2"
 )
 
+var showEnv bool
+
 func initDumpCommand() *cobra.Command {
 	var dumpCommand = &cobra.Command{
-		Use:     "dump",
-		Short:   tr("Prints the current configuration"),
-		Long:    tr("Prints the current configuration."),
-		Example: "  " + os.Args[0] + " config dump",
-		Args:    cobra.NoArgs,
-		Run:     runDumpCommand,
+		Use:   "dump",
+		Short: tr("Prints the current configuration"),
+		Long:  tr("Prints the current configuration."),
+		Example: "" +
+			"  " + os.Args[0] + " config dump\n" +
+			"  " + os.Args[0] + " config dump --show-env",
+		Args: cobra.NoArgs,
+		Run:  runDumpCommand,
 	}
+	dumpCommand.Flags().BoolVar(&showEnv, "show-env", false, tr("Lists the settings currently overridden by an environment variable."))
 	return dumpCommand
 }
 
 func runDumpCommand(cmd *cobra.Command, args []string) {
 	logrus.Info("Executing `arduino-cli config dump`")
+	if showEnv {
+		// Keys not having a default value are not listed by AllKeys when unset
+		knownKeys := map[string]bool{}
+		for _, key := range configuration.Settings.AllKeys() {
+			knownKeys[key] = true
+		}
+		for key := range validMap {
+			knownKeys[key] = true
+		}
+		keys := []string{}
+		for key := range knownKeys {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		feedback.PrintResult(envOverridesResult{EnvOverrides: configuration.GetEnvOverrides(keys)})
+		return
+	}
 	feedback.PrintResult(dumpResult{configuration.Settings.AllSettings()})
 }
 
+type envOverridesResult struct {
+	EnvOverrides []*configuration.EnvOverride `json:"env_overrides"`
+}
+
+func (er envOverridesResult) Data() interface{} {
+	return er
+}
+
+func (er envOverridesResult) String() string {
+	if len(er.EnvOverrides) == 0 {
+		return tr("No settings are overridden by environment variables.")
+	}
+	t := table.New()
+	t.SetHeader(tr("Key"), tr("Environment variable"), tr("Value"))
+	for _, override := range er.EnvOverrides {
+		t.AddRow(override.Key, override.EnvVar, override.Value)
+	}
+	return t.Render()
+}
+
 // output from this command requires special formatting, let's create a dedicated
 // feedback.Result implementation
 type dumpResult struct {
@@ -53,6 +98,13 @@ func (dr dumpResult) Data() interface{} {
 }
 
 func (dr dumpResult) String() string {
+	if len(configuration.ConfigFilesUsed()) > 1 {
+		// Multiple configuration files are merged, show which file sets each value
+		res := &strings.Builder{}
+		writeSettingsWithSources(res, dr.data, "", "")
+		return res.String()
+	}
+
 	bs, err := yaml.Marshal(dr.data)
 	if err != nil {
 		feedback.Errorf(tr("unable to marshal config to YAML: %v"), err)
@@ -61,3 +113,34 @@ func (dr dumpResult) String() string {
 
 	return string(bs)
 }
+
+// writeSettingsWithSources writes the settings as YAML, each value is followed
+// by a comment reporting the configuration file setting it
+func writeSettingsWithSources(out *strings.Builder, data map[string]interface{}, keyPrefix, indent string) {
+	keys := []string{}
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := data[key]
+		if section, ok := value.(map[string]interface{}); ok && len(section) > 0 {
+			out.WriteString(indent + key + ":\n")
+			writeSettingsWithSources(out, section, keyPrefix+key+".", indent+"  ")
+			continue
+		}
+
+		bs, err := yaml.Marshal(map[string]interface{}{key: value})
+		if err != nil {
+			feedback.Errorf(tr("unable to marshal config to YAML: %v"), err)
+			continue
+		}
+		lines := strings.Split(strings.TrimSuffix(string(bs), "\n"), "\n")
+		if source := configuration.GetKeySource(keyPrefix + key); source != "" {
+			lines[0] += " # " + tr("from %s", source)
+		}
+		for _, line := range lines {
+			out.WriteString(indent + line + "\n")
+		}
+	}
+}
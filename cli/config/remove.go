@@ -66,7 +66,7 @@ func runRemoveCommand(cmd *cobra.Command, args []string) {
 	}
 	configuration.Settings.Set(key, values)
 
-	if err := configuration.Settings.WriteConfig(); err != nil {
+	if err := configuration.WriteConfigFile(configuration.Settings, configuration.Settings.ConfigFileUsed()); err != nil {
 		feedback.Errorf(tr("Can't write config file: %v"), err)
 		os.Exit(errorcodes.ErrGeneric)
 	}
@@ -16,6 +16,7 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"reflect"
 	"strconv"
@@ -51,30 +52,46 @@ func runSetCommand(cmd *cobra.Command, args []string) {
 	key := args[0]
 	kind := validateKey(key)
 
-	if kind != reflect.Slice && len(args) > 2 {
-		feedback.Errorf(tr("Can't set multiple values in key %v"), key)
+	value, err := parseValue(key, kind, args[1:])
+	if err != nil {
+		feedback.Error(err)
 		os.Exit(errorcodes.ErrGeneric)
 	}
 
-	var value interface{}
-	switch kind {
-	case reflect.Slice:
-		value = args[1:]
-	case reflect.String:
-		value = args[1]
-	case reflect.Bool:
-		var err error
-		value, err = strconv.ParseBool(args[1])
-		if err != nil {
-			feedback.Errorf(tr("error parsing value: %v"), err)
-			os.Exit(errorcodes.ErrGeneric)
-		}
-	}
-
 	configuration.Settings.Set(key, value)
 
-	if err := configuration.Settings.WriteConfig(); err != nil {
+	if err := configuration.WriteConfigFile(configuration.Settings, configuration.Settings.ConfigFileUsed()); err != nil {
 		feedback.Errorf(tr("Writing config file: %v"), err)
 		os.Exit(errorcodes.ErrGeneric)
 	}
 }
+
+// parseValue converts the values given in the command line to the type of the
+// setting key
+func parseValue(key string, kind reflect.Kind, args []string) (interface{}, error) {
+	if kind == reflect.Slice {
+		return args, nil
+	}
+	if len(args) != 1 {
+		return nil, fmt.Errorf(tr("Can't set multiple values in key %v"), key)
+	}
+	arg := args[0]
+	switch kind {
+	case reflect.Bool:
+		if value, err := strconv.ParseBool(arg); err == nil {
+			return value, nil
+		}
+		return nil, fmt.Errorf(tr("Invalid value '%[1]s' for key %[2]s: expected a boolean (true or false)"), arg, key)
+	case reflect.Int:
+		if value, err := strconv.Atoi(arg); err == nil {
+			return value, nil
+		}
+		return nil, fmt.Errorf(tr("Invalid value '%[1]s' for key %[2]s: expected an integer"), arg, key)
+	case reflect.Float64:
+		if value, err := strconv.ParseFloat(arg, 64); err == nil {
+			return value, nil
+		}
+		return nil, fmt.Errorf(tr("Invalid value '%[1]s' for key %[2]s: expected a number"), arg, key)
+	}
+	return arg, nil
+}
@@ -17,30 +17,48 @@ package config
 
 import (
 	"fmt"
+	"math"
 	"os"
 	"reflect"
+	"sort"
+	"strings"
 
 	"github.com/arduino/arduino-cli/cli/errorcodes"
 	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 var validMap = map[string]reflect.Kind{
-	"board_manager.additional_urls": reflect.Slice,
-	"daemon.port":                   reflect.String,
-	"directories.data":              reflect.String,
-	"directories.downloads":         reflect.String,
-	"directories.user":              reflect.String,
-	"library.enable_unsafe_install": reflect.Bool,
-	"logging.file":                  reflect.String,
-	"logging.format":                reflect.String,
-	"logging.level":                 reflect.String,
-	"sketch.always_export_binaries": reflect.Bool,
-	"metrics.addr":                  reflect.String,
-	"metrics.enabled":               reflect.Bool,
-	"network.proxy":                 reflect.String,
-	"network.user_agent_ext":        reflect.String,
-	"output.no_color":               reflect.Bool,
-	"updater.enable_notification":   reflect.Bool,
+	"board_manager.additional_urls":      reflect.Slice,
+	"board_manager.index_signature_keys": reflect.Slice,
+	"board_manager.pinned_platforms":     reflect.Slice,
+	"daemon.port":                        reflect.String,
+	"directories.data":                   reflect.String,
+	"directories.downloads":              reflect.String,
+	"directories.user":                   reflect.String,
+	"i18n.extra_catalogs":                reflect.String,
+	"library.enable_unsafe_install":      reflect.Bool,
+	"locale":                             reflect.String,
+	"logging.file":                       reflect.String,
+	"logging.format":                     reflect.String,
+	"logging.level":                      reflect.String,
+	"sketch.always_export_binaries":      reflect.Bool,
+	"metrics.addr":                       reflect.String,
+	"metrics.enabled":                    reflect.Bool,
+	"metrics.endpoint":                   reflect.String,
+	"network.offline":                    reflect.Bool,
+	"network.parallel_downloads":         reflect.Int,
+	"network.proxy":                      reflect.String,
+	"network.retries":                    reflect.Int,
+	"network.retry_backoff":              reflect.String,
+	"network.user_agent_ext":             reflect.String,
+	"output.color":                       reflect.String,
+	"output.no_color":                    reflect.Bool,
+	"updater.enable_notification":        reflect.Bool,
 }
 
 func typeOf(key string) (reflect.Kind, error) {
@@ -59,3 +77,220 @@ func validateKey(key string) reflect.Kind {
 	}
 	return kind
 }
+
+func initValidateCommand() *cobra.Command {
+	validateCommand := &cobra.Command{
+		Use:   fmt.Sprintf("validate [<%s>]", tr("configFile")),
+		Short: tr("Validates a configuration file."),
+		Long:  tr("Validates a configuration file, reporting the unknown keys, the values with a wrong type and the missing directories. If no file is specified the configuration file in use is validated."),
+		Example: "" +
+			"  " + os.Args[0] + " config validate\n" +
+			"  " + os.Args[0] + " config validate /home/user/arduino-cli.yaml",
+		Args: cobra.MaximumNArgs(1),
+		Run:  runValidateCommand,
+	}
+	return validateCommand
+}
+
+func runValidateCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino-cli config validate`")
+
+	configFile := configuration.Settings.ConfigFileUsed()
+	if len(args) == 1 {
+		configFile = args[0]
+	}
+	if configFile == "" {
+		feedback.Errorf(tr("No configuration file found, use %s to create one."), "`config init`")
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	errs, err := validateConfigFile(configFile)
+	if err != nil {
+		feedback.Errorf(tr("Error reading config file: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	res := validateResult{ConfigFile: configFile, Errors: errs}
+	feedback.PrintResult(res)
+	if len(res.Errors) > 0 {
+		os.Exit(errorcodes.ErrGeneric)
+	}
+}
+
+// ValidateConfigFile reads the given configuration file and returns the
+// problems found in its keys, formatted as "<key>: <problem>"
+func ValidateConfigFile(configFile string) ([]string, error) {
+	errs, err := validateConfigFile(configFile)
+	if err != nil {
+		return nil, err
+	}
+	res := []string{}
+	for _, e := range errs {
+		res = append(res, fmt.Sprintf("%s: %s", e.Key, e.Message))
+	}
+	return res, nil
+}
+
+func validateConfigFile(configFile string) ([]*validationError, error) {
+	settings := viper.New()
+	settings.SetConfigFile(configFile)
+	if err := settings.ReadInConfig(); err != nil {
+		return nil, err
+	}
+	return validateSettings(settings), nil
+}
+
+// validationError is a problem found in a configuration key
+type validationError struct {
+	Key     string `json:"key"`
+	Message string `json:"message"`
+}
+
+// validateSettings checks the keys set in the given settings against validMap,
+// and that the configured directories exist
+func validateSettings(settings *viper.Viper) []*validationError {
+	errs := []*validationError{}
+	keys := settings.AllKeys()
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := settings.Get(key)
+		if value == nil {
+			continue
+		}
+		kind, ok := validMap[key]
+		if !ok {
+			errs = append(errs, &validationError{Key: key, Message: tr("unknown key")})
+			continue
+		}
+		if key == "board_manager.index_signature_keys" {
+			// A list of objects, not of strings
+			keys, err := configuration.IndexSignatureKeys(settings)
+			if err != nil {
+				errs = append(errs, &validationError{Key: key, Message: err.Error()})
+				continue
+			}
+			for _, k := range keys {
+				if paths.New(k.PublicKey).NotExist() {
+					errs = append(errs, &validationError{Key: key, Message: tr("public key %s not found", k.PublicKey)})
+				}
+			}
+			continue
+		}
+		if !isValueOfKind(value, kind) {
+			expected := schemaTypes[kind]
+			if kind == reflect.Slice {
+				expected = tr("array of strings")
+			}
+			errs = append(errs, &validationError{
+				Key:     key,
+				Message: tr("invalid value '%[1]v': expected type %[2]s", value, expected),
+			})
+			continue
+		}
+
+		switch key {
+		case "board_manager.pinned_platforms":
+			if _, err := configuration.PinnedPlatforms(settings); err != nil {
+				errs = append(errs, &validationError{Key: key, Message: err.Error()})
+			}
+		case "directories.data", "directories.user", "i18n.extra_catalogs":
+			if err := checkDirectory(paths.New(settings.GetString(key)), false); err != nil {
+				errs = append(errs, &validationError{Key: key, Message: err.Error()})
+			}
+		case "directories.downloads":
+			// The downloads directory is created when needed
+			if err := checkDirectory(paths.New(settings.GetString(key)), true); err != nil {
+				errs = append(errs, &validationError{Key: key, Message: err.Error()})
+			}
+		case "logging.file":
+			if logFile := paths.New(settings.GetString(key)); logFile != nil {
+				if err := checkDirectory(logFile.Parent(), false); err != nil {
+					errs = append(errs, &validationError{Key: key, Message: err.Error()})
+				}
+			}
+		}
+	}
+	return errs
+}
+
+// isValueOfKind returns true if the value read from a configuration file can be
+// used for a key of the given kind
+func isValueOfKind(value interface{}, kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Slice:
+		switch v := value.(type) {
+		case []string:
+			return true
+		case []interface{}:
+			for _, item := range v {
+				if _, ok := item.(string); !ok {
+					return false
+				}
+			}
+			return true
+		}
+	case reflect.String:
+		// Numbers are accepted too, for example "daemon.port: 50051"
+		switch value.(type) {
+		case string, int, int64, float64:
+			return true
+		}
+	case reflect.Bool:
+		_, ok := value.(bool)
+		return ok
+	case reflect.Int:
+		switch v := value.(type) {
+		case int, int64:
+			return true
+		case float64:
+			return v == math.Trunc(v)
+		}
+	case reflect.Float64:
+		switch value.(type) {
+		case int, int64, float64:
+			return true
+		}
+	}
+	return false
+}
+
+// checkDirectory returns an error if dir is not a readable directory. If
+// creatable is true a missing directory is accepted if its parent exists.
+func checkDirectory(dir *paths.Path, creatable bool) error {
+	if dir == nil {
+		return nil
+	}
+	if !dir.Exist() {
+		if creatable && dir.Parent().IsDir() {
+			return nil
+		}
+		return fmt.Errorf(tr("directory %s does not exist"), dir)
+	}
+	if !dir.IsDir() {
+		return fmt.Errorf(tr("%s is not a directory"), dir)
+	}
+	if _, err := dir.ReadDir(); err != nil {
+		return fmt.Errorf(tr("directory %[1]s is not readable: %[2]s"), dir, err)
+	}
+	return nil
+}
+
+type validateResult struct {
+	ConfigFile string             `json:"config_file"`
+	Errors     []*validationError `json:"errors"`
+}
+
+func (vr validateResult) Data() interface{} {
+	return vr
+}
+
+func (vr validateResult) String() string {
+	if len(vr.Errors) == 0 {
+		return tr("Configuration file %s is valid.", vr.ConfigFile)
+	}
+	res := []string{tr("Configuration file %s is not valid:", vr.ConfigFile)}
+	for _, err := range vr.Errors {
+		res = append(res, fmt.Sprintf("  %s: %s", err.Key, err.Message))
+	}
+	return strings.Join(res, "\n")
+}
@@ -38,6 +38,7 @@ func NewCommand() *cobra.Command {
 	coreCommand.AddCommand(initListCommand())
 	coreCommand.AddCommand(initUpdateIndexCommand())
 	coreCommand.AddCommand(initUpgradeCommand())
+	coreCommand.AddCommand(initRollbackCommand())
 	coreCommand.AddCommand(initUninstallCommand())
 	coreCommand.AddCommand(initSearchCommand())
 
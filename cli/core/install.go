@@ -32,7 +32,8 @@ import (
 )
 
 var (
-	postInstallFlags arguments.PostInstallFlags
+	postInstallFlags   arguments.PostInstallFlags
+	noCleanupOnFailure bool
 )
 
 func initInstallCommand() *cobra.Command {
@@ -54,6 +55,7 @@ func initInstallCommand() *cobra.Command {
 		},
 	}
 	postInstallFlags.AddToCommand(installCommand)
+	installCommand.Flags().BoolVar(&noCleanupOnFailure, "no-cleanup-on-failure", false, tr("Keep the downloaded archives that fail the integrity check, to allow their inspection."))
 	return installCommand
 }
 
@@ -69,11 +71,12 @@ func runInstallCommand(cmd *cobra.Command, args []string) {
 
 	for _, platformRef := range platformsRefs {
 		platformInstallRequest := &rpc.PlatformInstallRequest{
-			Instance:        inst,
-			PlatformPackage: platformRef.PackageName,
-			Architecture:    platformRef.Architecture,
-			Version:         platformRef.Version,
-			SkipPostInstall: postInstallFlags.DetectSkipPostInstallValue(),
+			Instance:           inst,
+			PlatformPackage:    platformRef.PackageName,
+			Architecture:       platformRef.Architecture,
+			Version:            platformRef.Version,
+			SkipPostInstall:    postInstallFlags.DetectSkipPostInstallValue(),
+			NoCleanupOnFailure: noCleanupOnFailure,
 		}
 		_, err := core.PlatformInstall(context.Background(), platformInstallRequest, output.ProgressBar(), output.TaskProgress())
 		if err != nil {
@@ -0,0 +1,77 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/arduino/arduino-cli/cli/arguments"
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/cli/output"
+	"github.com/arduino/arduino-cli/commands/core"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initRollbackCommand() *cobra.Command {
+	rollbackCommand := &cobra.Command{
+		Use:   fmt.Sprintf("rollback %s:%s ...", tr("PACKAGER"), tr("ARCH")),
+		Short: tr("Restores the version of one or more cores installed before the last upgrade."),
+		Long: tr("Restores the version of one or more cores installed before the last upgrade.") + "\n" +
+			tr("The version replaced by the rollback is kept, so a further rollback restores it."),
+		Example: "  " + os.Args[0] + " core rollback arduino:samd\n",
+		Args:    cobra.MinimumNArgs(1),
+		Run:     runRollbackCommand,
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return arguments.GetUninstallableCores(), cobra.ShellCompDirectiveDefault
+		},
+	}
+	return rollbackCommand
+}
+
+func runRollbackCommand(cmd *cobra.Command, args []string) {
+	inst := instance.CreateAndInit()
+	logrus.Info("Executing `arduino-cli core rollback`")
+
+	platformsRefs, err := arguments.ParseReferences(args)
+	if err != nil {
+		feedback.Errorf(tr("Invalid argument passed: %v"), err)
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+
+	for _, platformRef := range platformsRefs {
+		if platformRef.Version != "" {
+			feedback.Errorf(tr("Invalid parameter %s: version not allowed"), platformRef)
+			os.Exit(errorcodes.ErrBadArgument)
+		}
+	}
+	for _, platformRef := range platformsRefs {
+		_, err := core.PlatformRollback(context.Background(), &rpc.PlatformRollbackRequest{
+			Instance:        inst,
+			PlatformPackage: platformRef.PackageName,
+			Architecture:    platformRef.Architecture,
+		}, output.ProgressBar(), output.TaskProgress())
+		if err != nil {
+			feedback.Errorf(tr("Error during rollback: %v"), err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+	}
+}
@@ -45,11 +45,12 @@ func runUpdateIndexCommand(cmd *cobra.Command, args []string) {
 	inst := instance.CreateInstanceAndRunFirstUpdate()
 	logrus.Info("Executing `arduino-cli core update-index`")
 
-	_, err := commands.UpdateIndex(context.Background(), &rpc.UpdateIndexRequest{
+	resp, err := commands.UpdateIndex(context.Background(), &rpc.UpdateIndexRequest{
 		Instance: inst,
 	}, output.ProgressBar())
 	if err != nil {
 		feedback.Errorf(tr("Error updating index: %v"), err)
 		os.Exit(errorcodes.ErrGeneric)
 	}
+	feedback.PrintResult(output.IndexUpdateResult{Reports: resp.GetIndexUpdateReports()})
 }
@@ -42,13 +42,19 @@ func initUpgradeCommand() *cobra.Command {
 			"  # " + tr("upgrade everything to the latest version") + "\n" +
 			"  " + os.Args[0] + " core upgrade\n\n" +
 			"  # " + tr("upgrade arduino:samd to the latest version") + "\n" +
-			"  " + os.Args[0] + " core upgrade arduino:samd",
+			"  " + os.Args[0] + " core upgrade arduino:samd\n\n" +
+			"  # " + tr("upgrade everything except arduino:avr") + "\n" +
+			"  " + os.Args[0] + " core upgrade --exclude arduino:avr",
 		Run: runUpgradeCommand,
 	}
 	postInstallFlags.AddToCommand(upgradeCommand)
+	upgradeCommand.Flags().StringSliceVar(&excludedPlatforms, "exclude", []string{},
+		fmt.Sprintf(tr("Do not upgrade the specified platforms, in the form %s:%s."), tr("PACKAGER"), tr("ARCH")))
 	return upgradeCommand
 }
 
+var excludedPlatforms []string
+
 func runUpgradeCommand(cmd *cobra.Command, args []string) {
 	inst := instance.CreateAndInit()
 	logrus.Info("Executing `arduino-cli core upgrade`")
@@ -82,12 +88,30 @@ func runUpgradeCommand(cmd *cobra.Command, args []string) {
 		os.Exit(errorcodes.ErrBadArgument)
 	}
 
+	excluded := map[string]bool{}
+	excludedRefs, err := arguments.ParseReferences(excludedPlatforms)
+	if err != nil {
+		feedback.Errorf(tr("Invalid argument passed: %v"), err)
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	for i, excludedRef := range excludedRefs {
+		if excludedRef.Version != "" {
+			feedback.Errorf(tr("Invalid item %s"), excludedPlatforms[i])
+			os.Exit(errorcodes.ErrBadArgument)
+		}
+		excluded[excludedRef.PackageName+":"+excludedRef.Architecture] = true
+	}
+
 	for i, platformRef := range platformsRefs {
 		if platformRef.Version != "" {
 			feedback.Errorf(tr("Invalid item %s"), args[i])
 			exitErr = true
 			continue
 		}
+		if excluded[platformRef.PackageName+":"+platformRef.Architecture] {
+			feedback.Print(fmt.Sprintf(tr("Platform %s skipped: excluded with %s"), platformRef, "--exclude"))
+			continue
+		}
 
 		r := &rpc.PlatformUpgradeRequest{
 			Instance:        inst,
@@ -97,7 +121,9 @@ func runUpgradeCommand(cmd *cobra.Command, args []string) {
 		}
 
 		if _, err := core.PlatformUpgrade(context.Background(), r, output.ProgressBar(), output.TaskProgress()); err != nil {
-			if errors.Is(err, &arduino.PlatformAlreadyAtTheLatestVersionError{}) {
+			var alreadyLatest *arduino.PlatformAlreadyAtTheLatestVersionError
+			var pinned *arduino.PlatformPinnedError
+			if errors.As(err, &alreadyLatest) || errors.As(err, &pinned) {
 				feedback.Print(err.Error())
 				continue
 			}
@@ -16,14 +16,17 @@
 package daemon
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
-	"fmt"
 	"io"
 	"io/ioutil"
 	"net"
 	"os"
+	"os/signal"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/arduino/arduino-cli/cli/errorcodes"
 	"github.com/arduino/arduino-cli/cli/feedback"
@@ -41,15 +44,43 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/reflection"
 )
 
 var (
-	tr           = i18n.Tr
-	ip           string
-	daemonize    bool
-	debug        bool
-	debugFile    string
-	debugFilters []string
+	tr                = i18n.Tr
+	ips               []string
+	daemonize         bool
+	debug             bool
+	debugFile         string
+	debugFilters      []string
+	debugFormat       string
+	debugNoRedact     bool
+	debugMaxSize      string
+	debugMaxBackups   int
+	slowRPCThreshold  time.Duration
+	idleTimeout       time.Duration
+	watchConfig       bool
+	defaultRPCTimeout time.Duration
+	maxConcurrentRPCs int
+	tlsCert           string
+	tlsKey            string
+	tlsClientCA       string
+	unixSocket        string
+	portFile          string
+	enableReflection  bool
+	noHealth          bool
+	maxRecvMsgSize    string
+	maxSendMsgSize    string
+	keepaliveTime     time.Duration
+	keepaliveTimeout  time.Duration
+	// shutdownTimeout is the time given to in-flight RPCs to complete
+	// once a termination signal is received
+	shutdownTimeout time.Duration
 )
 
 // NewCommand created a new `daemon` command
@@ -62,13 +93,34 @@ func NewCommand() *cobra.Command {
 		Args:    cobra.NoArgs,
 		Run:     runDaemonCommand,
 	}
-	daemonCommand.PersistentFlags().StringVar(&ip, "ip", "127.0.0.1", tr("The IP address the daemon will listen to"))
+	daemonCommand.PersistentFlags().StringSliceVar(&ips, "ip", []string{"127.0.0.1"}, tr("The IP addresses the daemon will listen to, can be specified multiple times"))
 	daemonCommand.PersistentFlags().String("port", "", tr("The TCP port the daemon will listen to"))
 	configuration.Settings.BindPFlag("daemon.port", daemonCommand.PersistentFlags().Lookup("port"))
+	daemonCommand.Flags().StringVar(&portFile, "port-file", "", tr("Write the TCP port the daemon is listening to in the specified file"))
+	daemonCommand.Flags().StringVar(&unixSocket, "unix-socket", "", tr("Listen on the specified Unix domain socket instead of a TCP port"))
 	daemonCommand.Flags().BoolVar(&daemonize, "daemonize", false, tr("Do not terminate daemon process if the parent process dies"))
+	daemonCommand.Flags().DurationVar(&shutdownTimeout, "shutdown-timeout", 30*time.Second, tr("Time to wait for pending gRPC calls to complete when a termination signal is received"))
+	daemonCommand.Flags().BoolVar(&enableReflection, "reflection", false, tr("Enable gRPC server reflection"))
+	daemonCommand.Flags().BoolVar(&noHealth, "no-health", false, tr("Disable the gRPC health check service"))
+	daemonCommand.Flags().StringVar(&maxRecvMsgSize, "max-recv-msg-size", "4MB", tr("Maximum size of a gRPC message the daemon can receive, for example 16MB"))
+	daemonCommand.Flags().StringVar(&maxSendMsgSize, "max-send-msg-size", "", tr("Maximum size of a gRPC message the daemon can send, for example 16MB (unlimited if not set)"))
+	daemonCommand.Flags().DurationVar(&keepaliveTime, "keepalive-time", 0, tr("Ping idle clients after this amount of time to keep the connection alive (disabled if 0)"))
+	daemonCommand.Flags().DurationVar(&keepaliveTimeout, "keepalive-timeout", 20*time.Second, tr("Close the connection if a keepalive ping is not acknowledged within this amount of time"))
 	daemonCommand.Flags().BoolVar(&debug, "debug", false, tr("Enable debug logging of gRPC calls"))
 	daemonCommand.Flags().StringVar(&debugFile, "debug-file", "", tr("Append debug logging to the specified file"))
-	daemonCommand.Flags().StringSliceVar(&debugFilters, "debug-filter", []string{}, tr("Display only the provided gRPC calls"))
+	daemonCommand.Flags().StringVar(&debugMaxSize, "debug-file-max-size", "", tr("Rotate the debug logging file when it exceeds the specified size, for example 10MB"))
+	daemonCommand.Flags().IntVar(&debugMaxBackups, "debug-file-max-backups", 3, tr("Number of rotated debug logging files to keep"))
+	daemonCommand.Flags().StringSliceVar(&debugFilters, "debug-filter", []string{}, tr("Display only the gRPC calls matching the provided regular expressions"))
+	daemonCommand.Flags().BoolVar(&debugNoRedact, "debug-no-redact", false, tr("Do not mask passwords, tokens and credentials in the debug logging"))
+	daemonCommand.Flags().StringVar(&debugFormat, "debug-format", "text", tr("The format of the debug logging, can be: %s", "text, json"))
+	daemonCommand.Flags().BoolVar(&watchConfig, "watch-config", false, tr("Reload the configuration when the config file changes"))
+	daemonCommand.Flags().DurationVar(&idleTimeout, "idle-timeout", 0, tr("Shut down the daemon after the specified time without gRPC calls (disabled if 0)"))
+	daemonCommand.Flags().DurationVar(&defaultRPCTimeout, "default-rpc-timeout", 0, tr("Deadline applied to the gRPC calls that don't set one (disabled if 0)"))
+	daemonCommand.Flags().IntVar(&maxConcurrentRPCs, "max-concurrent-rpcs", 0, tr("Maximum number of gRPC calls served at the same time, the others are queued (unlimited if 0)"))
+	daemonCommand.Flags().DurationVar(&slowRPCThreshold, "slow-rpc-threshold", 0, tr("Log the gRPC calls taking longer than the specified duration, even if --debug is not set (disabled if 0)"))
+	daemonCommand.Flags().StringVar(&tlsCert, "tls-cert", "", tr("Path to the TLS certificate used to serve gRPC calls"))
+	daemonCommand.Flags().StringVar(&tlsKey, "tls-key", "", tr("Path to the private key of the TLS certificate"))
+	daemonCommand.Flags().StringVar(&tlsClientCA, "tls-client-ca", "", tr("Path to a CA certificate used to require and verify client certificates"))
 	return daemonCommand
 }
 
@@ -76,22 +128,125 @@ func runDaemonCommand(cmd *cobra.Command, args []string) {
 	logrus.Info("Executing `arduino-cli daemon`")
 
 	if configuration.Settings.GetBool("metrics.enabled") {
-		metrics.Activate("daemon")
+		if err := metrics.Activate("daemon"); err != nil {
+			feedback.Errorf(tr("Error activating the metrics: %v"), err)
+			os.Exit(errorcodes.ErrBadArgument)
+		}
 		stats.Incr("daemon", stats.T("success", "true"))
 		defer stats.Flush()
 	}
 	port := configuration.Settings.GetString("daemon.port")
 	gRPCOptions := []grpc.ServerOption{}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{}
+	streamInterceptors := []grpc.StreamServerInterceptor{}
 	if debugFile != "" {
 		if !debug {
 			feedback.Error(tr("The flag --debug-file must be used with --debug."))
 			os.Exit(errorcodes.ErrBadArgument)
 		}
 	}
+	if debugMaxSize != "" && debugFile == "" {
+		feedback.Error(tr("The flag --debug-file-max-size must be used with --debug-file."))
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	if debugMaxBackups < 0 {
+		feedback.Error(tr("The flag --debug-file-max-backups must not be negative."))
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	if patterns, err := compileDebugFilters(debugFilters); err != nil {
+		feedback.Error(err)
+		os.Exit(errorcodes.ErrBadArgument)
+	} else {
+		debugFilterPatterns = patterns
+	}
+	if debugFormat != "text" && debugFormat != "json" {
+		feedback.Errorf(tr("Invalid option for --debug-format: %s"), debugFormat)
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	if size, err := parseSize(maxRecvMsgSize); err != nil {
+		feedback.Errorf(tr("Invalid value for --max-recv-msg-size: %v"), err)
+		os.Exit(errorcodes.ErrBadArgument)
+	} else {
+		gRPCOptions = append(gRPCOptions, grpc.MaxRecvMsgSize(size))
+	}
+	if maxSendMsgSize != "" {
+		size, err := parseSize(maxSendMsgSize)
+		if err != nil {
+			feedback.Errorf(tr("Invalid value for --max-send-msg-size: %v"), err)
+			os.Exit(errorcodes.ErrBadArgument)
+		}
+		gRPCOptions = append(gRPCOptions, grpc.MaxSendMsgSize(size))
+	}
+	if idleTimeout < 0 {
+		feedback.Error(tr("The flag --idle-timeout must not be negative."))
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	if defaultRPCTimeout < 0 {
+		feedback.Error(tr("The flag --default-rpc-timeout must not be negative."))
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	if maxConcurrentRPCs < 0 {
+		feedback.Error(tr("The flag --max-concurrent-rpcs must not be negative."))
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	if slowRPCThreshold < 0 {
+		feedback.Error(tr("The flag --slow-rpc-threshold must not be negative."))
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	if keepaliveTime < 0 || keepaliveTimeout <= 0 {
+		feedback.Error(tr("The keepalive time and timeout must be positive."))
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	if keepaliveTime > 0 {
+		// Keep long-lived streams (like monitor and debug) alive through
+		// proxies that drop idle connections
+		gRPCOptions = append(gRPCOptions,
+			grpc.KeepaliveParams(keepalive.ServerParameters{
+				Time:    keepaliveTime,
+				Timeout: keepaliveTimeout,
+			}),
+			// Allow the clients to ping the server as often as the server does
+			grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+				MinTime:             keepaliveTime,
+				PermitWithoutStream: true,
+			}),
+		)
+	}
+	if unixSocket != "" && portFile != "" {
+		feedback.Error(tr("The flag --port-file cannot be used with --unix-socket."))
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	if unixSocket == "" && len(ips) == 0 {
+		feedback.Error(tr("At least one IP address must be specified with --ip."))
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	if tlsCert != "" || tlsKey != "" {
+		if tlsCert == "" || tlsKey == "" {
+			feedback.Error(tr("The flags --tls-cert and --tls-key must be used together."))
+			os.Exit(errorcodes.ErrBadArgument)
+		}
+		tlsConfig, err := loadTLSConfig(tlsCert, tlsKey, tlsClientCA)
+		if err != nil {
+			feedback.Errorf(tr("Error loading TLS configuration: %v"), err)
+			os.Exit(errorcodes.ErrBadArgument)
+		}
+		gRPCOptions = append(gRPCOptions, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	} else if tlsClientCA != "" {
+		feedback.Error(tr("The flag --tls-client-ca must be used with --tls-cert and --tls-key."))
+		os.Exit(errorcodes.ErrBadArgument)
+	}
 	if debug {
 		if debugFile != "" {
-			outFile := paths.New(debugFile)
-			f, err := outFile.Append()
+			maxSize := 0
+			if debugMaxSize != "" {
+				size, err := parseSize(debugMaxSize)
+				if err != nil {
+					feedback.Errorf(tr("Invalid value for --debug-file-max-size: %v"), err)
+					os.Exit(errorcodes.ErrBadArgument)
+				}
+				maxSize = size
+			}
+			f, err := openRotatingFile(paths.New(debugFile), int64(maxSize), debugMaxBackups)
 			if err != nil {
 				feedback.Error(tr("Error opening debug logging file: %s", err))
 				os.Exit(errorcodes.ErrBadCall)
@@ -99,11 +254,52 @@ func runDaemonCommand(cmd *cobra.Command, args []string) {
 			debugStdOut = f
 			defer f.Close()
 		}
-		gRPCOptions = append(gRPCOptions,
-			grpc.UnaryInterceptor(unaryLoggerInterceptor),
-			grpc.StreamInterceptor(streamLoggerInterceptor),
-		)
+		if debugFormat == "json" {
+			unaryInterceptors = append(unaryInterceptors, unaryJSONLoggerInterceptor)
+			streamInterceptors = append(streamInterceptors, streamJSONLoggerInterceptor)
+		} else {
+			unaryInterceptors = append(unaryInterceptors, unaryLoggerInterceptor)
+			streamInterceptors = append(streamInterceptors, streamLoggerInterceptor)
+		}
+	}
+	var idle <-chan struct{}
+	if idleTimeout > 0 {
+		activity := newActivityTracker()
+		unaryInterceptors = append(unaryInterceptors, activity.unaryInterceptor)
+		streamInterceptors = append(streamInterceptors, activity.streamInterceptor)
+		idle = activity.idle(idleTimeout)
+	}
+	if defaultRPCTimeout > 0 {
+		unaryInterceptors = append(unaryInterceptors, unaryTimeoutInterceptor)
+		streamInterceptors = append(streamInterceptors, streamTimeoutInterceptor)
+	}
+	if maxConcurrentRPCs > 0 {
+		// This must come after the timeout interceptor, so that the queued
+		// calls are rejected when their deadline expires
+		limiter := newConcurrencyLimiter(maxConcurrentRPCs)
+		unaryInterceptors = append(unaryInterceptors, limiter.unaryInterceptor)
+		streamInterceptors = append(streamInterceptors, limiter.streamInterceptor)
 	}
+	if slowRPCThreshold > 0 {
+		unaryInterceptors = append(unaryInterceptors, unarySlowRPCInterceptor)
+		streamInterceptors = append(streamInterceptors, streamSlowRPCInterceptor)
+	}
+	gRPCOptions = append(gRPCOptions,
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
+	if watchConfig {
+		configFile := configuration.Settings.ConfigFileUsed()
+		if configFile == "" {
+			feedback.Error(tr("The flag --watch-config requires a config file."))
+			os.Exit(errorcodes.ErrNoConfigFile)
+		}
+		if err := watchConfigFile(configFile); err != nil {
+			feedback.Errorf(tr("Error watching config file: %v"), err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+	}
+
 	s := grpc.NewServer(gRPCOptions...)
 	// Set specific user-agent for the daemon
 	configuration.Settings.Set("network.user_agent_ext", "daemon")
@@ -122,6 +318,20 @@ func runDaemonCommand(cmd *cobra.Command, args []string) {
 	// Register the debug session service
 	srv_debug.RegisterDebugServiceServer(s, &daemon.DebugService{})
 
+	// Register the health check service, the status is set to SERVING right
+	// before starting to serve the calls
+	var healthServer *health.Server
+	if !noHealth {
+		healthServer = health.NewServer()
+		healthServer.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+		healthpb.RegisterHealthServer(s, healthServer)
+	}
+
+	if enableReflection {
+		// Allow clients to introspect the registered services at runtime
+		reflection.Register(s)
+	}
+
 	if !daemonize {
 		// When parent process ends terminate also the daemon
 		go func() {
@@ -129,11 +339,102 @@ func runDaemonCommand(cmd *cobra.Command, args []string) {
 			_, _ = io.Copy(ioutil.Discard, os.Stdin)
 			// Flush metrics stats (this is a no-op if metrics is disabled)
 			stats.Flush()
+			removeUnixSocket()
 			os.Exit(0)
 		}()
 	}
 
-	lis, err := net.Listen("tcp", fmt.Sprintf("%s:%s", ip, port))
+	var listeners []net.Listener
+	if unixSocket != "" {
+		listeners = append(listeners, listenUnixSocket(unixSocket))
+		feedback.PrintResult(daemonResult{
+			Socket: unixSocket,
+		})
+	} else {
+		res := daemonResult{}
+		for _, ip := range ips {
+			lis, lisPort := listenTCP(ip, port)
+			listeners = append(listeners, lis)
+			res.Addresses = append(res.Addresses, daemonAddress{IP: ip, Port: lisPort})
+		}
+		// The first address is reported also in the IP and Port fields
+		// for backward compatibility
+		res.IP = res.Addresses[0].IP
+		res.Port = res.Addresses[0].Port
+		if portFile != "" {
+			if err := writePortFile(portFile, res.Addresses); err != nil {
+				feedback.Errorf(tr("Error writing port file: %v"), err)
+				os.Exit(errorcodes.ErrGeneric)
+			}
+		}
+		feedback.PrintResult(res)
+	}
+
+	// Gracefully stop the server on SIGINT/SIGTERM or when idle, letting the
+	// pending calls (for example a compile or an upload) complete before exiting
+	shutdownComplete := make(chan struct{})
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		select {
+		case sig := <-signals:
+			logrus.Infof("Received signal %s, shutting down daemon", sig)
+		case <-idle:
+			// idle is nil, and never selected, if --idle-timeout is not set
+			logrus.Infof("No gRPC calls in the last %s, shutting down daemon", idleTimeout)
+		}
+		gracefulStop(s, healthServer, shutdownTimeout)
+		close(shutdownComplete)
+	}()
+
+	if healthServer != nil {
+		healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	}
+
+	// Serve all the listeners on the same server
+	serveErrors := make(chan error, len(listeners))
+	for _, lis := range listeners {
+		go func(lis net.Listener) {
+			serveErrors <- s.Serve(lis)
+		}(lis)
+	}
+	for range listeners {
+		if err := <-serveErrors; err != nil {
+			removeUnixSocket()
+			logrus.Fatalf("Failed to serve: %v", err)
+		}
+	}
+	// Serve returns as soon as the listeners are closed, wait for the pending
+	// calls to be completed before exiting
+	<-shutdownComplete
+	removeUnixSocket()
+}
+
+// gracefulStop stops the gRPC server waiting for the pending calls to complete.
+// If they do not complete within the given timeout the server is stopped
+// forcefully. The health status, if available, is set to NOT_SERVING so that
+// load balancers can drain the connections.
+func gracefulStop(s *grpc.Server, healthServer *health.Server, timeout time.Duration) {
+	if healthServer != nil {
+		healthServer.Shutdown()
+	}
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(timeout):
+		logrus.Warnf("Pending gRPC calls not completed after %s, forcing daemon shutdown", timeout)
+		s.Stop()
+	}
+}
+
+// listenTCP listens on the given TCP address and returns the listener together
+// with the port actually used.
+func listenTCP(ip, port string) (net.Listener, string) {
+	lis, err := net.Listen("tcp", net.JoinHostPort(ip, port))
 	if err != nil {
 		// Invalid port, such as "Foo"
 		var dnsError *net.DNSError
@@ -162,26 +463,143 @@ func runDaemonCommand(cmd *cobra.Command, args []string) {
 	// know which is used.
 	if port == "0" {
 		address := lis.Addr()
-		split := strings.Split(address.String(), ":")
-
-		if len(split) == 0 {
+		_, lisPort, err := net.SplitHostPort(address.String())
+		if err != nil {
 			feedback.Error(tr("Failed choosing port, address: %s", address))
+			os.Exit(errorcodes.ErrGeneric)
 		}
 
-		port = split[len(split)-1]
+		port = lisPort
 	}
+	return lis, port
+}
 
-	feedback.PrintResult(daemonResult{
-		IP:   ip,
-		Port: port,
-	})
+// writePortFile atomically writes the ports of the given addresses, one per
+// line, in the file at the given path. The file is written to a temporary file
+// first and then renamed, so that a reader never sees a partially written file.
+func writePortFile(portFile string, addresses []daemonAddress) error {
+	content := ""
+	for _, address := range addresses {
+		content += address.Port + "\n"
+	}
+	target := paths.New(portFile)
+	tmp, err := ioutil.TempFile(target.Parent().String(), target.Base()+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.WriteString(content); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), target.String()); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
+
+// loadTLSConfig builds the TLS configuration of the gRPC server from the given
+// certificate and key files. If clientCAFile is not empty the server will
+// require and verify client certificates signed by that CA (mTLS).
+func loadTLSConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if clientCAFile != "" {
+		caData, err := paths.New(clientCAFile).ReadFile()
+		if err != nil {
+			return nil, err
+		}
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(caData) {
+			return nil, errors.New(tr("no valid certificates found in %s", clientCAFile))
+		}
+		tlsConfig.ClientCAs = certPool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return tlsConfig, nil
+}
 
-	if err := s.Serve(lis); err != nil {
-		logrus.Fatalf("Failed to serve: %v", err)
+// listenUnixSocket listens on the Unix domain socket at the given path,
+// removing a stale socket file left by a previous run. The socket is created
+// with permissions restricted to the current user.
+func listenUnixSocket(socketPath string) net.Listener {
+	socket := paths.New(socketPath)
+	if socket.Exist() {
+		if info, err := socket.Stat(); err == nil && info.Mode()&os.ModeSocket == 0 {
+			feedback.Errorf(tr("Failed to listen on Unix socket: %s. File exists and is not a socket."), socketPath)
+			os.Exit(errorcodes.ErrBadArgument)
+		}
+		if err := socket.Remove(); err != nil {
+			feedback.Errorf(tr("Failed to remove stale Unix socket %[1]s: %[2]v"), socketPath, err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+	}
+	lis, err := listenUnix(socketPath)
+	if err != nil {
+		feedback.Errorf(tr("Failed to listen on Unix socket: %[1]s. Unexpected error: %[2]v"), socketPath, err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+	return lis
+}
+
+// listenUnix creates the Unix domain socket inside a private directory, where
+// other users can't connect to it, and moves it to socketPath after its
+// permissions have been restricted to the current user.
+func listenUnix(socketPath string) (net.Listener, error) {
+	socket := paths.New(socketPath)
+	privateDir, err := paths.MkTempDir(socket.Parent().String(), ".s")
+	if err != nil {
+		return nil, err
+	}
+	defer privateDir.RemoveAll()
+	tmpSocket := privateDir.Join(socket.Base())
+	lis, err := net.Listen("unix", tmpSocket.String())
+	if err != nil {
+		return nil, err
+	}
+	// The socket is moved, it's removed by removeUnixSocket on exit
+	lis.(*net.UnixListener).SetUnlinkOnClose(false)
+	if err := os.Chmod(tmpSocket.String(), 0600); err != nil {
+		lis.Close()
+		return nil, err
+	}
+	if err := tmpSocket.Rename(socket); err != nil {
+		lis.Close()
+		return nil, err
+	}
+	return lis, nil
+}
+
+// removeUnixSocket removes the Unix domain socket file, if the daemon is
+// listening on one, so that it's not left around when the daemon exits.
+func removeUnixSocket() {
+	if unixSocket == "" {
+		return
+	}
+	if err := os.Remove(unixSocket); err != nil && !os.IsNotExist(err) {
+		logrus.WithError(err).Warnf("Removing Unix socket %s", unixSocket)
 	}
 }
 
 type daemonResult struct {
+	IP        string          `json:",omitempty"`
+	Port      string          `json:",omitempty"`
+	Addresses []daemonAddress `json:",omitempty"`
+	Socket    string          `json:",omitempty"`
+}
+
+type daemonAddress struct {
 	IP   string
 	Port string
 }
@@ -191,5 +609,15 @@ func (r daemonResult) Data() interface{} {
 }
 
 func (r daemonResult) String() string {
-	return tr("Daemon is now listening on %s:%s", r.IP, r.Port)
+	if r.Socket != "" {
+		return tr("Daemon is now listening on %s", r.Socket)
+	}
+	if len(r.Addresses) > 1 {
+		addresses := []string{}
+		for _, address := range r.Addresses {
+			addresses = append(addresses, net.JoinHostPort(address.IP, address.Port))
+		}
+		return tr("Daemon is now listening on %s", strings.Join(addresses, ", "))
+	}
+	return tr("Daemon is now listening on %s", net.JoinHostPort(r.IP, r.Port))
 }
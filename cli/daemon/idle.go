@@ -0,0 +1,103 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// activityTracker keeps track of the gRPC calls in progress and of the time
+// the last one completed, to detect when the daemon is idle.
+type activityTracker struct {
+	mu           sync.Mutex
+	inFlight     int
+	lastActivity time.Time
+}
+
+func newActivityTracker() *activityTracker {
+	return &activityTracker{lastActivity: time.Now()}
+}
+
+// isTracked returns false for the calls that must not keep the daemon alive,
+// like the health checks periodically made by orchestrators.
+func isTracked(method string) bool {
+	return !isHealthCheck(method)
+}
+
+func (a *activityTracker) begin() {
+	a.mu.Lock()
+	a.inFlight++
+	a.mu.Unlock()
+}
+
+func (a *activityTracker) end() {
+	a.mu.Lock()
+	a.inFlight--
+	a.lastActivity = time.Now()
+	a.mu.Unlock()
+}
+
+// idleSince returns the time since the last call completed, or 0 if there
+// are calls in progress.
+func (a *activityTracker) idleSince() time.Duration {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.inFlight > 0 {
+		return 0
+	}
+	return time.Since(a.lastActivity)
+}
+
+// idle returns a channel that is closed when the daemon has been idle for
+// longer than the given timeout.
+func (a *activityTracker) idle(timeout time.Duration) <-chan struct{} {
+	res := make(chan struct{})
+	go func() {
+		check := timeout / 10
+		if check > time.Second {
+			check = time.Second
+		}
+		ticker := time.NewTicker(check)
+		defer ticker.Stop()
+		for range ticker.C {
+			if a.idleSince() > timeout {
+				close(res)
+				return
+			}
+		}
+	}()
+	return res
+}
+
+func (a *activityTracker) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if isTracked(info.FullMethod) {
+		a.begin()
+		defer a.end()
+	}
+	return handler(ctx, req)
+}
+
+func (a *activityTracker) streamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if isTracked(info.FullMethod) {
+		a.begin()
+		defer a.end()
+	}
+	return handler(srv, stream)
+}
@@ -19,16 +19,22 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
-	"strings"
+	"regexp"
+	"time"
 
+	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
-var debugStdOut = os.Stdout
+var debugStdOut io.Writer = os.Stdout
 
 func log(isRequest bool, msg interface{}) {
-	j, _ := json.MarshalIndent(msg, "|  ", "  ")
+	j, _ := json.MarshalIndent(redact(msg), "|  ", "  ")
 	inOut := map[bool]string{true: "REQ:  ", false: "RESP: "}
 	fmt.Fprintln(debugStdOut, "|  "+inOut[isRequest]+string(j))
 }
@@ -39,12 +45,37 @@ func logError(err error) {
 	}
 }
 
+// peerAddress returns the address of the client that made the call
+func peerAddress(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil && p.Addr.String() != "" {
+		return p.Addr.String()
+	}
+	return "unknown"
+}
+
+// debugFilterPatterns are the compiled regular expressions of --debug-filter
+var debugFilterPatterns []*regexp.Regexp
+
+// compileDebugFilters compiles the regular expressions passed with
+// --debug-filter, so that they're parsed only once at startup.
+func compileDebugFilters(filters []string) ([]*regexp.Regexp, error) {
+	patterns := []*regexp.Regexp{}
+	for _, filter := range filters {
+		pattern, err := regexp.Compile(filter)
+		if err != nil {
+			return nil, fmt.Errorf(tr("invalid debug filter '%[1]s': %[2]v"), filter, err)
+		}
+		patterns = append(patterns, pattern)
+	}
+	return patterns, nil
+}
+
 func logSelector(method string) bool {
-	if len(debugFilters) == 0 {
+	if len(debugFilterPatterns) == 0 {
 		return true
 	}
-	for _, filter := range debugFilters {
-		if strings.Contains(method, filter) {
+	for _, pattern := range debugFilterPatterns {
+		if pattern.MatchString(method) {
 			return true
 		}
 	}
@@ -55,7 +86,7 @@ func unaryLoggerInterceptor(ctx context.Context, req interface{}, info *grpc.Una
 	if !logSelector(info.FullMethod) {
 		return handler(ctx, req)
 	}
-	fmt.Fprintln(debugStdOut, "CALLED:", info.FullMethod)
+	fmt.Fprintln(debugStdOut, "CALLED:", info.FullMethod, "FROM:", peerAddress(ctx))
 	log(true, req)
 	resp, err := handler(ctx, req)
 	logError(err)
@@ -75,10 +106,11 @@ func streamLoggerInterceptor(srv interface{}, stream grpc.ServerStream, info *gr
 	if info.IsServerStream {
 		streamReq += "STREAM_RESP"
 	}
-	fmt.Fprintln(debugStdOut, "CALLED:", info.FullMethod, streamReq)
+	from := peerAddress(stream.Context())
+	fmt.Fprintln(debugStdOut, "CALLED:", info.FullMethod, streamReq, "FROM:", from)
 	err := handler(srv, &loggingServerStream{ServerStream: stream})
 	logError(err)
-	fmt.Fprintln(debugStdOut, "STREAM CLOSED")
+	fmt.Fprintln(debugStdOut, "STREAM CLOSED", "FROM:", from)
 	fmt.Fprintln(debugStdOut)
 	return err
 }
@@ -100,3 +132,152 @@ func (l *loggingServerStream) SendMsg(m interface{}) error {
 	log(false, m)
 	return err
 }
+
+// jsonLogEntry is a single line of the debug log when the JSON format is used
+type jsonLogEntry struct {
+	Time       string          `json:"time"`
+	Method     string          `json:"method"`
+	Peer       string          `json:"peer"`
+	Event      string          `json:"event"`
+	DurationMs float64         `json:"duration_ms,omitempty"`
+	Code       string          `json:"code,omitempty"`
+	Error      string          `json:"error,omitempty"`
+	Size       int             `json:"size,omitempty"`
+	Message    json.RawMessage `json:"message,omitempty"`
+}
+
+func logJSON(entry *jsonLogEntry) {
+	entry.Time = time.Now().Format(time.RFC3339Nano)
+	j, _ := json.Marshal(entry)
+	// Write the whole line at once so concurrent calls are not interleaved
+	debugStdOut.Write(append(j, '\n'))
+}
+
+func messageEntry(method, from, event string, msg interface{}) *jsonLogEntry {
+	entry := &jsonLogEntry{Method: method, Peer: from, Event: event}
+	if m, ok := msg.(proto.Message); ok {
+		entry.Size = proto.Size(m)
+	}
+	entry.Message, _ = json.Marshal(redact(msg))
+	return entry
+}
+
+func resultEntry(method, from string, start time.Time, err error) *jsonLogEntry {
+	entry := &jsonLogEntry{
+		Method:     method,
+		Peer:       from,
+		Event:      "end",
+		DurationMs: float64(time.Since(start).Microseconds()) / 1000,
+		Code:       status.Code(err).String(),
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	return entry
+}
+
+func unaryJSONLoggerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !logSelector(info.FullMethod) {
+		return handler(ctx, req)
+	}
+	start := time.Now()
+	from := peerAddress(ctx)
+	logJSON(messageEntry(info.FullMethod, from, "request", req))
+	resp, err := handler(ctx, req)
+	if err == nil {
+		logJSON(messageEntry(info.FullMethod, from, "response", resp))
+	}
+	logJSON(resultEntry(info.FullMethod, from, start, err))
+	return resp, err
+}
+
+func streamJSONLoggerInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !logSelector(info.FullMethod) {
+		return handler(srv, stream)
+	}
+	start := time.Now()
+	from := peerAddress(stream.Context())
+	logJSON(&jsonLogEntry{Method: info.FullMethod, Peer: from, Event: "stream_open"})
+	err := handler(srv, &jsonLoggingServerStream{ServerStream: stream, method: info.FullMethod, peer: from})
+	logJSON(resultEntry(info.FullMethod, from, start, err))
+	return err
+}
+
+type jsonLoggingServerStream struct {
+	grpc.ServerStream
+	method string
+	peer   string
+}
+
+func (l *jsonLoggingServerStream) RecvMsg(m interface{}) error {
+	err := l.ServerStream.RecvMsg(m)
+	if err == nil {
+		logJSON(messageEntry(l.method, l.peer, "request", m))
+	}
+	return err
+}
+
+func (l *jsonLoggingServerStream) SendMsg(m interface{}) error {
+	err := l.ServerStream.SendMsg(m)
+	if err == nil {
+		logJSON(messageEntry(l.method, l.peer, "response", m))
+	}
+	return err
+}
+
+// logSlowRPC reports the calls that took longer than the --slow-rpc-threshold
+func logSlowRPC(ctx context.Context, method string, start time.Time) {
+	if elapsed := time.Since(start); elapsed > slowRPCThreshold {
+		logrus.WithFields(logrus.Fields{
+			"method":  method,
+			"peer":    peerAddress(ctx),
+			"elapsed": elapsed,
+		}).Warn("Slow RPC")
+	}
+}
+
+func unarySlowRPCInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	defer logSlowRPC(ctx, info.FullMethod, time.Now())
+	return handler(ctx, req)
+}
+
+func streamSlowRPCInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	defer logSlowRPC(stream.Context(), info.FullMethod, time.Now())
+	return handler(srv, stream)
+}
+
+// unaryTimeoutInterceptor sets the --default-rpc-timeout deadline on the calls
+// that do not already have one set by the client
+func unaryTimeoutInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if _, ok := ctx.Deadline(); ok {
+		return handler(ctx, req)
+	}
+	ctx, cancel := context.WithTimeout(ctx, defaultRPCTimeout)
+	defer cancel()
+	return handler(ctx, req)
+}
+
+// streamTimeoutInterceptor is the same as unaryTimeoutInterceptor for server
+// streaming calls (like UpdateIndex). Bidirectional streams, like monitor and
+// debug, are meant to be long-lived and are never subject to the timeout.
+func streamTimeoutInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if info.IsClientStream {
+		return handler(srv, stream)
+	}
+	if _, ok := stream.Context().Deadline(); ok {
+		return handler(srv, stream)
+	}
+	ctx, cancel := context.WithTimeout(stream.Context(), defaultRPCTimeout)
+	defer cancel()
+	return handler(srv, &contextServerStream{ServerStream: stream, ctx: ctx})
+}
+
+// contextServerStream is a grpc.ServerStream with a different context
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (c *contextServerStream) Context() context.Context {
+	return c.ctx
+}
@@ -0,0 +1,72 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"context"
+	"strings"
+
+	"golang.org/x/sync/semaphore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// concurrencyLimiter bounds the number of gRPC calls served at the same time.
+// The calls beyond the limit are queued until a slot is free, if the call
+// context is done while waiting the call is rejected with ResourceExhausted.
+// Bidirectional streams (monitor, debug, board list watch) and health checks
+// are long-lived or lightweight and are not limited.
+type concurrencyLimiter struct {
+	sem *semaphore.Weighted
+}
+
+func newConcurrencyLimiter(max int) *concurrencyLimiter {
+	return &concurrencyLimiter{sem: semaphore.NewWeighted(int64(max))}
+}
+
+func (l *concurrencyLimiter) acquire(ctx context.Context, method string) error {
+	if err := l.sem.Acquire(ctx, 1); err != nil {
+		return status.Errorf(codes.ResourceExhausted, tr("Too many concurrent calls, %[1]s not served: %[2]v"), method, err)
+	}
+	return nil
+}
+
+func isHealthCheck(method string) bool {
+	return strings.HasPrefix(method, "/grpc.health.v1.Health/")
+}
+
+func (l *concurrencyLimiter) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if isHealthCheck(info.FullMethod) {
+		return handler(ctx, req)
+	}
+	if err := l.acquire(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	defer l.sem.Release(1)
+	return handler(ctx, req)
+}
+
+func (l *concurrencyLimiter) streamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if info.IsClientStream || isHealthCheck(info.FullMethod) {
+		return handler(srv, stream)
+	}
+	if err := l.acquire(stream.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	defer l.sem.Release(1)
+	return handler(srv, stream)
+}
@@ -0,0 +1,120 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/arduino/go-paths-helper"
+)
+
+// rotatingFile is an io.WriteCloser that appends to a file and rotates it
+// when its size exceeds maxSize. The rotated files are renamed to
+// <file>.1, <file>.2, ... up to maxBackups, the oldest are deleted.
+// It's safe to use it from multiple goroutines.
+type rotatingFile struct {
+	mu         sync.Mutex
+	path       *paths.Path
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+}
+
+// openRotatingFile opens the file at the given path in append mode. If
+// maxSize is 0 the file is never rotated.
+func openRotatingFile(path *paths.Path, maxSize int64, maxBackups int) (*rotatingFile, error) {
+	r := &rotatingFile{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *rotatingFile) open() error {
+	f, err := r.path.Append()
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	r.file = f
+	r.size = info.Size()
+	return nil
+}
+
+func (r *rotatingFile) backup(n int) *paths.Path {
+	return paths.New(fmt.Sprintf("%s.%d", r.path, n))
+}
+
+func (r *rotatingFile) rotate() error {
+	if err := r.file.Close(); err != nil {
+		return err
+	}
+	if r.maxBackups < 1 {
+		if err := r.path.Remove(); err != nil {
+			return err
+		}
+		return r.open()
+	}
+	if oldest := r.backup(r.maxBackups); oldest.Exist() {
+		if err := oldest.Remove(); err != nil {
+			return err
+		}
+	}
+	for n := r.maxBackups - 1; n >= 1; n-- {
+		if b := r.backup(n); b.Exist() {
+			if err := b.Rename(r.backup(n + 1)); err != nil {
+				return err
+			}
+		}
+	}
+	if err := r.path.Rename(r.backup(1)); err != nil {
+		return err
+	}
+	return r.open()
+}
+
+// Write appends p to the file, rotating it beforehand if the write would
+// exceed the maximum size.
+func (r *rotatingFile) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.maxSize > 0 && r.size > 0 && r.size+int64(len(p)) > r.maxSize {
+		if err := r.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := r.file.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+// Close closes the underlying file
+func (r *rotatingFile) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.file.Close()
+}
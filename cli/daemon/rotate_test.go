@@ -0,0 +1,59 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotatingFile(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "rotate")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	log := tmp.Join("debug.log")
+	r, err := openRotatingFile(log, 10, 2)
+	require.NoError(t, err)
+	for _, line := range []string{"line1\n", "line2\n", "line3\n", "line4\n"} {
+		_, err := r.Write([]byte(line))
+		require.NoError(t, err)
+	}
+	require.NoError(t, r.Close())
+
+	data, err := log.ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "line4\n", string(data))
+	data, err = tmp.Join("debug.log.1").ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "line3\n", string(data))
+	data, err = tmp.Join("debug.log.2").ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "line2\n", string(data))
+	require.False(t, tmp.Join("debug.log.3").Exist())
+
+	// Reopening appends to the existing file
+	r, err = openRotatingFile(log, 100, 2)
+	require.NoError(t, err)
+	_, err = r.Write([]byte("line5\n"))
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	data, err = log.ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "line4\nline5\n", string(data))
+}
@@ -0,0 +1,68 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var sizeUnits = []struct {
+	suffix     string
+	multiplier float64
+}{
+	// Longer suffixes must come first
+	{"KIB", 1 << 10},
+	{"MIB", 1 << 20},
+	{"GIB", 1 << 30},
+	{"KB", 1 << 10},
+	{"MB", 1 << 20},
+	{"GB", 1 << 30},
+	{"K", 1 << 10},
+	{"M", 1 << 20},
+	{"G", 1 << 30},
+	{"B", 1},
+}
+
+// parseSize converts a human-readable size, like "512KB" or "16MB", into the
+// corresponding number of bytes. Units are 1024-based, a value without unit is
+// interpreted as bytes. The size must be positive and fit an int32, that is the
+// limit imposed by gRPC on the message size.
+func parseSize(size string) (int, error) {
+	s := strings.ToUpper(strings.TrimSpace(size))
+	multiplier := 1.0
+	for _, unit := range sizeUnits {
+		if strings.HasSuffix(s, unit.suffix) {
+			s = strings.TrimSpace(strings.TrimSuffix(s, unit.suffix))
+			multiplier = unit.multiplier
+			break
+		}
+	}
+	value, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, errors.New(tr("invalid size: %s", size))
+	}
+	bytes := value * multiplier
+	if value < 0 || bytes < 1 {
+		return 0, errors.New(tr("size must be positive: %s", size))
+	}
+	if bytes > math.MaxInt32 {
+		return 0, errors.New(tr("size too big: %s", size))
+	}
+	return int(bytes), nil
+}
@@ -0,0 +1,45 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSize(t *testing.T) {
+	valid := map[string]int{
+		"1024":   1024,
+		"100B":   100,
+		"512KB":  512 * 1024,
+		"512kb":  512 * 1024,
+		"16MB":   16 * 1024 * 1024,
+		"16 MiB": 16 * 1024 * 1024,
+		"1.5M":   1536 * 1024,
+		"1GB":    1024 * 1024 * 1024,
+	}
+	for in, out := range valid {
+		size, err := parseSize(in)
+		require.NoError(t, err, in)
+		require.Equal(t, out, size, in)
+	}
+
+	for _, in := range []string{"", "MB", "foo", "-1MB", "0", "0.1B", "4GB", "NaN", "nanMB", "Inf", "+InfKB", "-Inf", "-0"} {
+		_, err := parseSize(in)
+		require.Error(t, err, in)
+	}
+}
@@ -0,0 +1,82 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/arduino/arduino-cli/commands/daemon"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/fsnotify/fsnotify"
+	"github.com/sirupsen/logrus"
+)
+
+// watchConfigFile reloads configuration.Settings every time the given config
+// file changes. The parent directory is watched, instead of the file itself,
+// to catch the editors that save a file by replacing it.
+func watchConfigFile(configFile string) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	configFile = filepath.Clean(configFile)
+	if err := watcher.Add(filepath.Dir(configFile)); err != nil {
+		watcher.Close()
+		return err
+	}
+
+	go func() {
+		defer watcher.Close()
+		// Editors usually generate a burst of events when saving a file,
+		// reload the configuration only once they settle down
+		var reload <-chan time.Time
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if filepath.Clean(event.Name) != configFile {
+					continue
+				}
+				if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) != 0 {
+					reload = time.After(200 * time.Millisecond)
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				logrus.Errorf("Error watching config file: %v", err)
+			case <-reload:
+				reload = nil
+				reloadConfigFile()
+			}
+		}
+	}()
+	return nil
+}
+
+func reloadConfigFile() {
+	logrus.Infof("Config file changed, reloading %s", configuration.Settings.ConfigFileUsed())
+	if err := daemon.ReloadSettings(); err != nil {
+		logrus.Errorf("Error reloading config file: %v", err)
+		return
+	}
+	// The new board manager URLs are used by the next Init of an instance
+	logrus.WithField("urls", configuration.Settings.GetStringSlice("board_manager.additional_urls")).
+		Info("Reloaded board manager URLs")
+}
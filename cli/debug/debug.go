@@ -41,6 +41,7 @@ var (
 	interpreter string
 	importDir   string
 	printInfo   bool
+	attach      bool
 	programmer  arguments.Programmer
 	tr          = i18n.Tr
 )
@@ -62,6 +63,7 @@ func NewCommand() *cobra.Command {
 	debugCommand.Flags().StringVar(&interpreter, "interpreter", "console", tr("Debug interpreter e.g.: %s", "console, mi, mi1, mi2, mi3"))
 	debugCommand.Flags().StringVarP(&importDir, "input-dir", "", "", tr("Directory containing binaries for debug."))
 	debugCommand.Flags().BoolVarP(&printInfo, "info", "I", false, tr("Show metadata about the debug session instead of starting the debugger."))
+	debugCommand.Flags().BoolVar(&attach, "attach", false, tr("Attach to the program already running on the board, without halting or resetting it."))
 
 	return debugCommand
 }
@@ -87,6 +89,7 @@ func runDebugCommand(command *cobra.Command, args []string) {
 		Interpreter: interpreter,
 		ImportDir:   importDir,
 		Programmer:  programmer.String(),
+		Attach:      attach,
 	}
 
 	if printInfo {
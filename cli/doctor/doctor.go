@@ -0,0 +1,269 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package doctor
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/httpclient"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	"github.com/arduino/arduino-cli/cli/config"
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/globals"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/i18n"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/arduino/go-paths-helper"
+	"github.com/fatih/color"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var (
+	tr = i18n.Tr
+	// networkTimeout is the maximum time to wait for the reply of an index URL
+	networkTimeout = 10 * time.Second
+)
+
+// The status of a check
+const (
+	statusOK      = "ok"
+	statusWarning = "warning"
+	statusError   = "error"
+	statusSkipped = "skipped"
+)
+
+// NewCommand created a new `doctor` command
+func NewCommand() *cobra.Command {
+	doctorCommand := &cobra.Command{
+		Use:   "doctor",
+		Short: tr("Checks the environment of Arduino CLI."),
+		Long: tr("Checks the validity of the configuration file, the permissions of the directories, the reachability of the package " +
+			"indexes and the presence of the tools required by the installed platforms. The report can be attached to the bug reports."),
+		Example: "  " + os.Args[0] + " doctor\n" +
+			"  " + os.Args[0] + " doctor --format json",
+		Args: cobra.NoArgs,
+		Run:  runDoctorCommand,
+	}
+	return doctorCommand
+}
+
+func runDoctorCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino-cli doctor`")
+
+	res := &doctorResult{
+		Version: globals.VersionInfo.VersionString,
+		OS:      runtime.GOOS + "/" + runtime.GOARCH,
+		Checks:  []*check{},
+	}
+	res.Checks = append(res.Checks, checkConfigFile())
+	res.Checks = append(res.Checks, checkDirectories()...)
+	res.Checks = append(res.Checks, checkNetwork()...)
+	res.Checks = append(res.Checks, checkTools())
+
+	feedback.PrintResult(res)
+	for _, c := range res.Checks {
+		if c.Status == statusError {
+			os.Exit(errorcodes.ErrGeneric)
+		}
+	}
+}
+
+// check is the result of one of the checks performed by the doctor
+type check struct {
+	Name    string   `json:"name"`
+	Status  string   `json:"status"`
+	Details []string `json:"details"`
+}
+
+func newCheck(name, status string, details ...string) *check {
+	if details == nil {
+		details = []string{}
+	}
+	return &check{Name: name, Status: status, Details: details}
+}
+
+func checkConfigFile() *check {
+	name := tr("Configuration file")
+	configFile := configuration.Settings.ConfigFileUsed()
+	if configFile == "" {
+		return newCheck(name, statusWarning, tr("No configuration file found, the default settings are used."))
+	}
+	errs, err := config.ValidateConfigFile(configFile)
+	if err != nil {
+		return newCheck(name, statusError, tr("Error reading config file %[1]s: %[2]v", configFile, err))
+	}
+	if len(errs) > 0 {
+		return newCheck(name, statusError, append([]string{configFile}, errs...)...)
+	}
+	return newCheck(name, statusOK, configFile)
+}
+
+func checkDirectories() []*check {
+	res := []*check{}
+	for _, key := range []string{"directories.Data", "directories.Downloads", "directories.User"} {
+		name := strings.ToLower(key)
+		dir := paths.New(configuration.Settings.GetString(key))
+		if dir == nil {
+			res = append(res, newCheck(name, statusError, tr("The directory is not set.")))
+			continue
+		}
+		if !dir.Exist() {
+			// The directories are created when needed
+			if err := checkWritable(dir.Parent()); err != nil {
+				res = append(res, newCheck(name, statusError, dir.String(), tr("The directory does not exist and cannot be created: %v", err)))
+			} else {
+				res = append(res, newCheck(name, statusWarning, dir.String(), tr("The directory does not exist, it will be created when needed.")))
+			}
+			continue
+		}
+		if !dir.IsDir() {
+			res = append(res, newCheck(name, statusError, dir.String(), tr("%s is not a directory", dir)))
+			continue
+		}
+		if err := checkWritable(dir); err != nil {
+			res = append(res, newCheck(name, statusError, dir.String(), tr("The directory is not writable: %v", err)))
+			continue
+		}
+		res = append(res, newCheck(name, statusOK, dir.String()))
+	}
+	return res
+}
+
+// checkWritable returns an error if a file cannot be created in dir
+func checkWritable(dir *paths.Path) error {
+	f, err := ioutil.TempFile(dir.String(), "arduino-cli-doctor-")
+	if err != nil {
+		return err
+	}
+	f.Close()
+	return os.Remove(f.Name())
+}
+
+func checkNetwork() []*check {
+	urls := []string{globals.DefaultIndexURL, librariesmanager.LibraryIndexURL.String()}
+	urls = append(urls, configuration.Settings.GetStringSlice("board_manager.additional_urls")...)
+
+	res := []*check{}
+	if configuration.Settings.GetBool("network.offline") {
+		for _, u := range urls {
+			res = append(res, newCheck(tr("Index %s", u), statusSkipped, tr("The offline mode is enabled.")))
+		}
+		return res
+	}
+
+	client, err := httpclient.New()
+	if err != nil {
+		return append(res, newCheck(tr("Network"), statusError, tr("Error creating the network client: %v", err)))
+	}
+	client.Timeout = networkTimeout
+	for _, u := range urls {
+		name := tr("Index %s", u)
+		if strings.HasPrefix(u, "file://") {
+			res = append(res, newCheck(name, statusSkipped, tr("The index is a local file.")))
+			continue
+		}
+		resp, err := client.Get(u)
+		if err != nil {
+			res = append(res, newCheck(name, statusError, err.Error()))
+			continue
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			res = append(res, newCheck(name, statusError, tr("Server responded with: %s", resp.Status)))
+			continue
+		}
+		res = append(res, newCheck(name, statusOK))
+	}
+	return res
+}
+
+func checkTools() *check {
+	name := tr("Required tools")
+	inst, err := instance.Create()
+	if err != nil {
+		return newCheck(name, statusError, tr("Error creating instance: %v", err))
+	}
+	details := []string{}
+	err = commands.Init(&rpc.InitRequest{Instance: inst}, func(res *rpc.InitResponse) {
+		if st := res.GetError(); st != nil {
+			details = append(details, st.Message)
+		}
+	})
+	if err != nil {
+		return newCheck(name, statusError, tr("Error initializing instance: %v", err))
+	}
+	// The loading errors are not fatal, the indexes might be missing
+	status := statusOK
+	if len(details) > 0 {
+		status = statusWarning
+	}
+
+	pm := commands.GetPackageManager(inst.GetId())
+	platforms := pm.InstalledPlatformReleases()
+	for _, platform := range platforms {
+		for _, dep := range platform.ToolDependencies {
+			if tool := pm.FindToolDependency(dep); tool == nil || !tool.IsInstalled() {
+				status = statusError
+				details = append(details, tr("Tool %[1]s required by platform %[2]s is not installed", dep, platform))
+			}
+		}
+	}
+	details = append(details, tr("%d installed platforms", len(platforms)))
+	return newCheck(name, status, details...)
+}
+
+type doctorResult struct {
+	Version string   `json:"version"`
+	OS      string   `json:"os"`
+	Checks  []*check `json:"checks"`
+}
+
+func (dr *doctorResult) Data() interface{} {
+	return dr
+}
+
+func (dr *doctorResult) String() string {
+	statusColors := map[string]*color.Color{
+		statusOK:      feedback.SuccessColor,
+		statusWarning: feedback.WarningColor,
+		statusError:   feedback.ErrorColor,
+		statusSkipped: color.New(color.Faint),
+	}
+	t := table.New()
+	t.SetHeader(tr("Check"), tr("Status"), tr("Details"))
+	for _, c := range dr.Checks {
+		if len(c.Details) == 0 {
+			t.AddRow(c.Name, table.NewCell(c.Status, statusColors[c.Status]), "")
+			continue
+		}
+		t.AddRow(c.Name, table.NewCell(c.Status, statusColors[c.Status]), c.Details[0])
+		for _, detail := range c.Details[1:] {
+			t.AddRow("", "", detail)
+		}
+	}
+	return fmt.Sprintf("Arduino CLI %s (%s)\n\n", dr.Version, dr.OS) + t.Render()
+}
@@ -0,0 +1,66 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package errorcodes
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/i18n"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var tr = i18n.Tr
+
+// NewCommand created a new `errorcodes` command
+func NewCommand() *cobra.Command {
+	errorCodesCommand := &cobra.Command{
+		Use:   "errorcodes",
+		Short: tr("Lists the exit codes of Arduino CLI."),
+		Long:  tr("Lists the exit codes of Arduino CLI with their name and meaning. Use --format json to get a machine readable list."),
+		Example: "  " + os.Args[0] + " errorcodes\n" +
+			"  " + os.Args[0] + " errorcodes --format json",
+		Args:   cobra.NoArgs,
+		Hidden: true,
+		Run:    runErrorCodesCommand,
+	}
+	return errorCodesCommand
+}
+
+func runErrorCodesCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino-cli errorcodes`")
+	feedback.PrintResult(errorCodesResult{ErrorCodes: Descriptions()})
+}
+
+type errorCodesResult struct {
+	ErrorCodes []*Description `json:"error_codes"`
+}
+
+func (er errorCodesResult) Data() interface{} {
+	return er
+}
+
+func (er errorCodesResult) String() string {
+	t := table.New()
+	t.SetHeader(tr("Code"), tr("Name"), tr("Meaning"))
+	for _, desc := range er.ErrorCodes {
+		t.AddRow(strconv.Itoa(desc.Code), desc.Name, desc.Meaning)
+	}
+	return t.Render()
+}
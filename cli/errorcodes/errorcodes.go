@@ -29,3 +29,23 @@ const (
 	ErrCoreConfig
 	ErrBadArgument
 )
+
+// Description describes the meaning of an exit code
+type Description struct {
+	Name    string `json:"name"`
+	Code    int    `json:"code"`
+	Meaning string `json:"meaning"`
+}
+
+// Descriptions returns the list of the exit codes used by the CLI, with
+// a short explanation of their meaning.
+func Descriptions() []*Description {
+	return []*Description{
+		{Name: "ErrGeneric", Code: ErrGeneric, Meaning: "Generic error, the command failed for a reason not covered by the other exit codes"},
+		{Name: "ErrNoConfigFile", Code: ErrNoConfigFile, Meaning: "The configuration file could not be found or read"},
+		{Name: "ErrBadCall", Code: ErrBadCall, Meaning: "The command has been called with invalid flags or an invalid combination of them"},
+		{Name: "ErrNetwork", Code: ErrNetwork, Meaning: "A network operation failed, for example the download of an index or of a package"},
+		{Name: "ErrCoreConfig", Code: ErrCoreConfig, Meaning: "The CLI core configuration is broken, for example some files shipped with the installation are missing or a vital directory cannot be created"},
+		{Name: "ErrBadArgument", Code: ErrBadArgument, Meaning: "One of the arguments of the command is invalid, for example a malformed FQBN or library reference"},
+	}
+}
@@ -0,0 +1,32 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package feedback
+
+import "github.com/fatih/color"
+
+// The colors used to highlight the different kind of messages in the text
+// output, they can be changed to customize the theme of the CLI. The colors
+// are not used if color.NoColor is set.
+var (
+	// ErrorColor is used for the error messages
+	ErrorColor = color.New(color.FgRed)
+	// WarningColor is used for the warning messages
+	WarningColor = color.New(color.FgYellow)
+	// SuccessColor is used for the messages reporting a successful operation
+	SuccessColor = color.New(color.FgGreen)
+	// ProgressColor is used for the progress reports
+	ProgressColor = color.New(color.FgCyan)
+)
@@ -49,6 +49,18 @@ func GetFormat() OutputFormat {
 	return fb.GetFormat()
 }
 
+// SetQuiet can be used to enable the quiet mode at runtime: in quiet mode only
+// the errors and the results are printed, the informational messages are
+// suppressed
+func SetQuiet(quiet bool) {
+	fb.SetQuiet(quiet)
+}
+
+// IsQuiet returns true if the quiet mode is enabled
+func IsQuiet() bool {
+	return fb.IsQuiet()
+}
+
 // OutputWriter returns the underlying io.Writer to be used when the Print*
 // api is not enough
 func OutputWriter() io.Writer {
@@ -71,6 +83,18 @@ func Print(v interface{}) {
 	fb.Print(v)
 }
 
+// Infof behaves like Printf but the message is considered informational: it's
+// not printed in quiet mode.
+func Infof(format string, v ...interface{}) {
+	fb.Infof(format, v...)
+}
+
+// Info behaves like Print but the message is considered informational: it's
+// not printed in quiet mode.
+func Info(v interface{}) {
+	fb.Info(v)
+}
+
 // Errorf behaves like fmt.Printf but writes on the error writer and adds a
 // newline. It also logs the error.
 func Errorf(format string, v ...interface{}) {
@@ -83,6 +107,30 @@ func Error(v ...interface{}) {
 	fb.Error(v...)
 }
 
+// Warningf behaves like fmt.Printf but writes on the error writer and adds a
+// newline. It also logs the warning.
+func Warningf(format string, v ...interface{}) {
+	fb.Warningf(format, v...)
+}
+
+// Warning behaves like fmt.Print but writes on the error writer and adds a
+// newline. It also logs the warning.
+func Warning(v ...interface{}) {
+	fb.Warning(v...)
+}
+
+// Successf behaves like Printf but the message highlights the successful
+// completion of an operation.
+func Successf(format string, v ...interface{}) {
+	fb.Successf(format, v...)
+}
+
+// Success behaves like Print but the message highlights the successful
+// completion of an operation.
+func Success(v interface{}) {
+	fb.Success(v)
+}
+
 // PrintResult is a convenient wrapper to provide feedback for complex data,
 // where the contents can't be just serialized to JSON but requires more
 // structure.
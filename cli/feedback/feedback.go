@@ -21,10 +21,12 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 
 	"gopkg.in/yaml.v2"
 
 	"github.com/arduino/arduino-cli/i18n"
+	"github.com/fatih/color"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/status"
 )
@@ -56,6 +58,7 @@ type Feedback struct {
 	out    io.Writer
 	err    io.Writer
 	format OutputFormat
+	quiet  bool
 }
 
 var tr = i18n.Tr
@@ -94,6 +97,18 @@ func (fb *Feedback) GetFormat() OutputFormat {
 	return fb.format
 }
 
+// SetQuiet can be used to enable the quiet mode at runtime: in quiet mode only
+// the errors and the results are printed, the informational messages are
+// suppressed
+func (fb *Feedback) SetQuiet(quiet bool) {
+	fb.quiet = quiet
+}
+
+// IsQuiet returns true if the quiet mode is enabled
+func (fb *Feedback) IsQuiet() bool {
+	return fb.quiet
+}
+
 // OutputWriter returns the underlying io.Writer to be used when the Print*
 // api is not enough.
 func (fb *Feedback) OutputWriter() io.Writer {
@@ -123,6 +138,21 @@ func (fb *Feedback) Print(v interface{}) {
 	}
 }
 
+// Infof behaves like Printf but the message is considered informational: it's
+// not printed in quiet mode.
+func (fb *Feedback) Infof(format string, v ...interface{}) {
+	fb.Info(fmt.Sprintf(format, v...))
+}
+
+// Info behaves like Print but the message is considered informational: it's
+// not printed in quiet mode.
+func (fb *Feedback) Info(v interface{}) {
+	if fb.quiet {
+		return
+	}
+	fb.Print(v)
+}
+
 // Errorf behaves like fmt.Printf but writes on the error writer and adds a
 // newline. It also logs the error.
 func (fb *Feedback) Errorf(format string, v ...interface{}) {
@@ -142,10 +172,49 @@ func (fb *Feedback) Errorf(format string, v ...interface{}) {
 // Error behaves like fmt.Print but writes on the error writer and adds a
 // newline. It also logs the error.
 func (fb *Feedback) Error(v ...interface{}) {
-	fmt.Fprintln(fb.err, v...)
+	fmt.Fprintln(fb.err, fb.colorize(ErrorColor, v...))
 	logrus.Error(fmt.Sprint(v...))
 }
 
+// Warningf behaves like fmt.Printf but writes on the error writer and adds a
+// newline. It also logs the warning.
+func (fb *Feedback) Warningf(format string, v ...interface{}) {
+	fb.Warning(fmt.Sprintf(format, v...))
+}
+
+// Warning behaves like fmt.Print but writes on the error writer and adds a
+// newline. It also logs the warning.
+func (fb *Feedback) Warning(v ...interface{}) {
+	fmt.Fprintln(fb.err, fb.colorize(WarningColor, v...))
+	logrus.Warn(fmt.Sprint(v...))
+}
+
+// Successf behaves like Printf but the message highlights the successful
+// completion of an operation.
+func (fb *Feedback) Successf(format string, v ...interface{}) {
+	fb.Success(fmt.Sprintf(format, v...))
+}
+
+// Success behaves like Print but the message highlights the successful
+// completion of an operation.
+func (fb *Feedback) Success(v interface{}) {
+	if fb.format != Text {
+		fb.Print(v)
+		return
+	}
+	fmt.Fprintln(fb.out, fb.colorize(SuccessColor, v))
+}
+
+// colorize formats v like fmt.Sprintln (without the trailing newline) and
+// applies the color c if the text output format is selected.
+func (fb *Feedback) colorize(c *color.Color, v ...interface{}) string {
+	msg := strings.TrimSuffix(fmt.Sprintln(v...), "\n")
+	if fb.format != Text {
+		return msg
+	}
+	return c.Sprint(msg)
+}
+
 // printJSON is a convenient wrapper to provide feedback by printing the
 // desired output in a pretty JSON format. It adds a newline to the output.
 func (fb *Feedback) printJSON(v interface{}) {
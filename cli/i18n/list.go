@@ -0,0 +1,81 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package i18n
+
+import (
+	"os"
+	"sort"
+
+	"github.com/arduino/arduino-cli/cli/feedback"
+	translations "github.com/arduino/arduino-cli/i18n"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initListCommand() *cobra.Command {
+	listCommand := &cobra.Command{
+		Use:   "list",
+		Short: tr("Lists the available translations."),
+		Long: tr("Lists the locales of the translations bundled with Arduino CLI, marking the one currently selected. " +
+			"The locale is selected with the 'locale' setting or, if not set, from the LC_ALL and LANG environment variables."),
+		Example: "  " + os.Args[0] + " i18n list",
+		Args:    cobra.NoArgs,
+		Run:     runListCommand,
+	}
+	return listCommand
+}
+
+func runListCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino-cli i18n list`")
+
+	current := translations.CurrentLocale()
+	res := listResult{Locales: []*localeInfo{}}
+	for _, locale := range translations.SupportedLocales() {
+		res.Locales = append(res.Locales, &localeInfo{
+			Locale:   locale,
+			Selected: locale == current,
+		})
+	}
+	sort.Slice(res.Locales, func(i, j int) bool { return res.Locales[i].Locale < res.Locales[j].Locale })
+	feedback.PrintResult(res)
+}
+
+type localeInfo struct {
+	Locale   string `json:"locale"`
+	Selected bool   `json:"selected"`
+}
+
+type listResult struct {
+	Locales []*localeInfo `json:"locales"`
+}
+
+func (lr listResult) Data() interface{} {
+	return lr
+}
+
+func (lr listResult) String() string {
+	t := table.New()
+	t.SetHeader(tr("Locale"), tr("Selected"))
+	for _, locale := range lr.Locales {
+		selected := ""
+		if locale.Selected {
+			selected = "*"
+		}
+		t.AddRow(locale.Locale, selected)
+	}
+	return t.Render()
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/arduino/arduino-cli/i18n"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
 )
 
 var tr = i18n.Tr
@@ -109,10 +110,17 @@ func FirstUpdate(instance *rpc.Instance) error {
 		return nil
 	}
 
+	// The indexes can't be downloaded in offline mode, go on with the
+	// already installed platforms and libraries
+	if configuration.NetworkOffline(configuration.Settings) {
+		logrus.Warn("Offline mode: skipping the download of the missing indexes")
+		return nil
+	}
+
 	// The library_index.json file doesn't exists, that means the CLI is run for the first time
 	// so we proceed with the first update that downloads the file
 	if libraryIndex.NotExist() {
-		err := commands.UpdateLibrariesIndex(context.Background(),
+		_, err := commands.UpdateLibrariesIndex(context.Background(),
 			&rpc.UpdateLibrariesIndexRequest{
 				Instance: instance,
 			},
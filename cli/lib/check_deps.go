@@ -95,7 +95,7 @@ func outputDep(dep *rpc.LibraryDependencyStatus) string {
 	if dep.GetVersionInstalled() == "" {
 		res += tr("%s must be installed.",
 			red.Sprintf("✕ %s %s", dep.GetName(), dep.GetVersionRequired()))
-	} else if dep.GetVersionInstalled() == dep.GetVersionRequired() {
+	} else if dep.GetAlreadyInstalled() {
 		res += tr("%s is already installed.",
 			green.Sprintf("✓ %s %s", dep.GetName(), dep.GetVersionRequired()))
 	} else {
@@ -39,7 +39,9 @@ import (
 var (
 	noDeps  bool
 	gitURL  bool
+	gitRef  string
 	zipPath bool
+	local   bool
 )
 
 func initInstallCommand() *cobra.Command {
@@ -51,7 +53,9 @@ func initInstallCommand() *cobra.Command {
 			"  " + os.Args[0] + " lib install AudioZero       # " + tr("for the latest version.") + "\n" +
 			"  " + os.Args[0] + " lib install AudioZero@1.0.0 # " + tr("for the specific version.") + "\n" +
 			"  " + os.Args[0] + " lib install --git-url https://github.com/arduino-libraries/WiFi101.git https://github.com/arduino-libraries/ArduinoBLE.git\n" +
-			"  " + os.Args[0] + " lib install --zip-path /path/to/WiFi101.zip /path/to/ArduinoBLE.zip\n",
+			"  " + os.Args[0] + " lib install --git-url https://github.com/arduino-libraries/WiFi101.git#0.16.0\n" +
+			"  " + os.Args[0] + " lib install --zip-path /path/to/WiFi101.zip /path/to/ArduinoBLE.zip\n" +
+			"  " + os.Args[0] + " lib install --local /path/to/MyLibrary\n",
 		Args: cobra.MinimumNArgs(1),
 		Run:  runInstallCommand,
 		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -60,7 +64,9 @@ func initInstallCommand() *cobra.Command {
 	}
 	installCommand.Flags().BoolVar(&noDeps, "no-deps", false, tr("Do not install dependencies."))
 	installCommand.Flags().BoolVar(&gitURL, "git-url", false, tr("Enter git url for libraries hosted on repositories"))
+	installCommand.Flags().StringVar(&gitRef, "git-ref", "", tr("The git ref (branch, tag or commit hash) to install, used with --git-url"))
 	installCommand.Flags().BoolVar(&zipPath, "zip-path", false, tr("Enter a path to zip file"))
+	installCommand.Flags().BoolVar(&local, "local", false, tr("Link the libraries in the specified local directories instead of copying them, useful for library development"))
 	return installCommand
 }
 
@@ -97,6 +103,21 @@ func runInstallCommand(cmd *cobra.Command, args []string) {
 		return
 	}
 
+	if local {
+		for _, path := range args {
+			err := lib.LocalLibraryInstall(context.Background(), &rpc.LocalLibraryInstallRequest{
+				Instance:  instance,
+				Path:      path,
+				Overwrite: true,
+			}, output.TaskProgress())
+			if err != nil {
+				feedback.Errorf(tr("Error installing local library: %v"), err)
+				os.Exit(errorcodes.ErrGeneric)
+			}
+		}
+		return
+	}
+
 	if gitURL {
 		for _, url := range args {
 			if url == "." {
@@ -110,6 +131,7 @@ func runInstallCommand(cmd *cobra.Command, args []string) {
 			err := lib.GitLibraryInstall(context.Background(), &rpc.GitLibraryInstallRequest{
 				Instance:  instance,
 				Url:       url,
+				Ref:       gitRef,
 				Overwrite: true,
 			}, output.TaskProgress())
 			if err != nil {
@@ -140,6 +140,9 @@ func (ir installedResult) String() string {
 		if lib.ContainerPlatform != "" {
 			location = lib.GetContainerPlatform()
 		}
+		if lib.GetDevelopmentLink() {
+			location += " " + tr("(development link)")
+		}
 
 		available := ""
 		sentence := ""
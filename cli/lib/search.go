@@ -35,19 +35,27 @@ import (
 )
 
 var (
-	namesOnly bool // if true outputs lib names only.
+	namesOnly bool   // if true outputs lib names only.
+	sortBy    string // how the results are sorted, by relevance or by name.
 )
 
 func initSearchCommand() *cobra.Command {
 	searchCommand := &cobra.Command{
 		Use:     fmt.Sprintf("search [%s]", tr("LIBRARY_NAME")),
 		Short:   tr("Searches for one or more libraries data."),
-		Long:    tr("Search for one or more libraries data (case insensitive search)."),
-		Example: "  " + os.Args[0] + " lib search audio",
+		Long: tr("Search for one or more libraries data (case insensitive search).") + "\n" +
+			tr("Near misses of the searched words are found too. The results are sorted by relevance, matches in the library name come first, then matches in the author and last matches in the description."),
+		Example: "" +
+			"  " + os.Args[0] + " lib search audio\n" +
+			"  " + os.Args[0] + " lib search --sort name audio",
 		Args:    cobra.ArbitraryArgs,
 		Run:     runSearchCommand,
 	}
 	searchCommand.Flags().BoolVar(&namesOnly, "names", false, tr("Show library names only."))
+	searchCommand.Flags().StringVar(&sortBy, "sort", "relevance", tr("Sort the results by %s or %s.", "relevance", "name"))
+	searchCommand.RegisterFlagCompletionFunc("sort", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{"relevance", "name"}, cobra.ShellCompDirectiveDefault
+	})
 	return searchCommand
 }
 
@@ -55,12 +63,17 @@ func runSearchCommand(cmd *cobra.Command, args []string) {
 	inst, status := instance.Create()
 	logrus.Info("Executing `arduino-cli lib search`")
 
+	if sortBy != "relevance" && sortBy != "name" {
+		feedback.Errorf(tr("Invalid value %[1]s for %[2]s, expected %[3]s or %[4]s"), sortBy, "--sort", "relevance", "name")
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+
 	if status != nil {
 		feedback.Errorf(tr("Error creating instance: %v"), status)
 		os.Exit(errorcodes.ErrGeneric)
 	}
 
-	if err := commands.UpdateLibrariesIndex(
+	if _, err := commands.UpdateLibrariesIndex(
 		context.Background(),
 		&rpc.UpdateLibrariesIndexRequest{Instance: inst},
 		output.ProgressBar(),
@@ -82,6 +95,14 @@ func runSearchCommand(cmd *cobra.Command, args []string) {
 		os.Exit(errorcodes.ErrGeneric)
 	}
 
+	// The results are already sorted by relevance
+	if sortBy == "name" {
+		libs := searchResp.GetLibraries()
+		sort.SliceStable(libs, func(i, j int) bool {
+			return libs[i].Name < libs[j].Name
+		})
+	}
+
 	feedback.PrintResult(result{
 		results:   searchResp,
 		namesOnly: namesOnly,
@@ -127,11 +148,6 @@ func (res result) String() string {
 		return tr("No libraries matching your search.")
 	}
 
-	// get a sorted slice of results
-	sort.Slice(results, func(i, j int) bool {
-		return results[i].Name < results[j].Name
-	})
-
 	var out strings.Builder
 
 	if res.results.GetStatus() == rpc.LibrarySearchStatus_LIBRARY_SEARCH_STATUS_FAILED {
@@ -0,0 +1,53 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package output
+
+import (
+	"fmt"
+	"strings"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// IndexUpdateResult is a feedback.Result reporting the changes of the
+// updated indexes
+type IndexUpdateResult struct {
+	Reports []*rpc.IndexUpdateReport `json:"index_update_reports"`
+}
+
+// Data implements feedback.Result interface
+func (r IndexUpdateResult) Data() interface{} {
+	return r
+}
+
+// String implements feedback.Result interface
+func (r IndexUpdateResult) String() string {
+	res := []string{}
+	for _, report := range r.Reports {
+		if report.GetSignatureStatus() == rpc.IndexSignatureStatus_INDEX_SIGNATURE_STATUS_UNSIGNED {
+			res = append(res, tr("Warning: the signature of index %[1]s has not been verified, add its public key to %[2]s to verify it",
+				report.GetIndexUrl(), "board_manager.index_signature_keys"))
+		}
+		if !report.GetModified() {
+			res = append(res, tr("Index %s is already up to date", report.GetIndexUrl()))
+			continue
+		}
+		res = append(res, fmt.Sprintf("%s: %s", report.GetIndexUrl(),
+			tr("%[1]d new, %[2]d updated, %[3]d removed",
+				report.GetNewPackages(), report.GetUpdatedPackages(), report.GetRemovedPackages())))
+	}
+	return strings.Join(res, "\n")
+}
@@ -0,0 +1,96 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// downloadProgressEvent is the NDJSON event reporting the progress of a download
+type downloadProgressEvent struct {
+	Type         string `json:"type"`
+	URL          string `json:"url,omitempty"`
+	File         string `json:"file,omitempty"`
+	TotalSize    int64  `json:"total_size,omitempty"`
+	Downloaded   int64  `json:"downloaded,omitempty"`
+	Completed    bool   `json:"completed,omitempty"`
+	RetryAttempt int32  `json:"retry_attempt,omitempty"`
+	RetryMax     int32  `json:"retry_max,omitempty"`
+}
+
+// taskProgressEvent is the NDJSON event reporting the progress of a task
+type taskProgressEvent struct {
+	Type      string  `json:"type"`
+	Name      string  `json:"name,omitempty"`
+	Message   string  `json:"message,omitempty"`
+	Completed bool    `json:"completed,omitempty"`
+	Percent   float32 `json:"percent,omitempty"`
+}
+
+// NewNDJSONDownloadProgressCB returns a DownloadProgressCB that writes each
+// progress update on out as a JSON object on a single line. The file (and URL)
+// being downloaded is reported in every event.
+func NewNDJSONDownloadProgressCB(out io.Writer) rpc.DownloadProgressCB {
+	var url, file string
+	var totalSize int64
+	return func(curr *rpc.DownloadProgress) {
+		if curr.GetFile() != "" {
+			url, file, totalSize = curr.GetUrl(), curr.GetFile(), curr.GetTotalSize()
+		}
+		writeNDJSONEvent(out, &downloadProgressEvent{
+			Type:         "download",
+			URL:          url,
+			File:         file,
+			TotalSize:    totalSize,
+			Downloaded:   curr.GetDownloaded(),
+			Completed:    curr.GetCompleted(),
+			RetryAttempt: curr.GetRetryAttempt(),
+			RetryMax:     curr.GetRetryMax(),
+		})
+	}
+}
+
+// NewNDJSONTaskProgressCB returns a TaskProgressCB that writes each progress
+// update on out as a JSON object on a single line. The name of the running
+// task is reported in every event.
+func NewNDJSONTaskProgressCB(out io.Writer) rpc.TaskProgressCB {
+	var name string
+	return func(curr *rpc.TaskProgress) {
+		if curr.GetName() != "" {
+			name = curr.GetName()
+		}
+		writeNDJSONEvent(out, &taskProgressEvent{
+			Type:      "task",
+			Name:      name,
+			Message:   curr.GetMessage(),
+			Completed: curr.GetCompleted(),
+			Percent:   curr.GetPercent(),
+		})
+	}
+}
+
+func writeNDJSONEvent(out io.Writer, event interface{}) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		// Should never happen, the events contain only basic types
+		panic(err)
+	}
+	fmt.Fprintln(out, string(data))
+}
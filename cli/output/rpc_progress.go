@@ -18,21 +18,29 @@ package output
 import (
 	"fmt"
 
+	"github.com/arduino/arduino-cli/cli/feedback"
 	"github.com/arduino/arduino-cli/i18n"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	"github.com/cmaglie/pb"
 )
 
 var (
-	// OutputFormat can be "text" or "json"
+	// OutputFormat can be "text", "json", "jsonmini" or "yaml"
 	OutputFormat string
-	tr           = i18n.Tr
+	// ProgressFormat can be "bar" or "ndjson"
+	ProgressFormat = "bar"
+	tr             = i18n.Tr
 )
 
-// ProgressBar returns a DownloadProgressCB that prints a progress bar.
-// If JSON output format has been selected, the callback outputs nothing.
+// ProgressBar returns a DownloadProgressCB that prints a progress bar, or the
+// NDJSON progress events if the "ndjson" ProgressFormat has been selected.
+// If a machine readable output format, or the quiet mode, has been selected,
+// the progress bar is not printed.
 func ProgressBar() rpc.DownloadProgressCB {
-	if OutputFormat != "json" {
+	if ProgressFormat == "ndjson" && !feedback.IsQuiet() {
+		return NewNDJSONDownloadProgressCB(feedback.OutputWriter())
+	}
+	if OutputFormat == "text" && !feedback.IsQuiet() {
 		return NewDownloadProgressBarCB()
 	}
 	return func(curr *rpc.DownloadProgress) {
@@ -40,10 +48,15 @@ func ProgressBar() rpc.DownloadProgressCB {
 	}
 }
 
-// TaskProgress returns a TaskProgressCB that prints the task progress.
-// If JSON output format has been selected, the callback outputs nothing.
+// TaskProgress returns a TaskProgressCB that prints the task progress, or the
+// NDJSON progress events if the "ndjson" ProgressFormat has been selected.
+// If a machine readable output format, or the quiet mode, has been selected,
+// the task progress is not printed.
 func TaskProgress() rpc.TaskProgressCB {
-	if OutputFormat != "json" {
+	if ProgressFormat == "ndjson" && !feedback.IsQuiet() {
+		return NewNDJSONTaskProgressCB(feedback.OutputWriter())
+	}
+	if OutputFormat == "text" && !feedback.IsQuiet() {
 		return NewTaskProgressCB()
 	}
 	return func(curr *rpc.TaskProgress) {
@@ -58,6 +71,16 @@ func NewDownloadProgressBarCB() func(*rpc.DownloadProgress) {
 	var prefix string
 	return func(curr *rpc.DownloadProgress) {
 		// fmt.Printf(">>> %v\n", curr)
+		if retry := curr.GetRetryAttempt(); retry != 0 {
+			msg := feedback.WarningColor.Sprint(tr("%[1]s failed, retrying %[2]d/%[3]d", prefix, retry, curr.GetRetryMax()))
+			if bar != nil {
+				bar.FinishPrintOver(msg)
+				bar = nil
+			} else {
+				fmt.Println(msg)
+			}
+			return
+		}
 		if filename := curr.GetFile(); filename != "" {
 			if curr.GetCompleted() {
 				fmt.Println(tr("%s already downloaded", filename))
@@ -68,11 +91,14 @@ func NewDownloadProgressBarCB() func(*rpc.DownloadProgress) {
 			bar.Prefix(prefix)
 			bar.SetUnits(pb.U_BYTES)
 		}
+		if bar == nil {
+			return
+		}
 		if curr.GetDownloaded() != 0 {
 			bar.Set(int(curr.GetDownloaded()))
 		}
 		if curr.GetCompleted() {
-			bar.FinishPrintOver(tr("%s downloaded", prefix))
+			bar.FinishPrintOver(feedback.SuccessColor.Sprint(tr("%s downloaded", prefix)))
 		}
 	}
 }
@@ -96,7 +122,7 @@ func NewTaskProgressCB() func(curr *rpc.TaskProgress) {
 			}
 		}
 		if msg != "" {
-			fmt.Print(msg)
+			feedback.ProgressColor.Print(msg)
 			if curr.GetCompleted() {
 				fmt.Println()
 			} else {
@@ -23,6 +23,7 @@ import (
 	"github.com/arduino/arduino-cli/cli/arguments"
 	"github.com/arduino/arduino-cli/cli/errorcodes"
 	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
 	sk "github.com/arduino/arduino-cli/commands/sketch"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	"github.com/arduino/go-paths-helper"
@@ -30,7 +31,11 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var includeBuildDir bool
+var (
+	includeBuildDir  bool
+	includeLibraries bool
+	archiveFqbn      arguments.Fqbn
+)
 
 // initArchiveCommand creates a new `archive` command
 func initArchiveCommand() *cobra.Command {
@@ -43,12 +48,15 @@ func initArchiveCommand() *cobra.Command {
 			"  " + os.Args[0] + " archive .\n" +
 			"  " + os.Args[0] + " archive . MySketchArchive.zip\n" +
 			"  " + os.Args[0] + " archive /home/user/Arduino/MySketch\n" +
-			"  " + os.Args[0] + " archive /home/user/Arduino/MySketch /home/user/MySketchArchive.zip",
+			"  " + os.Args[0] + " archive /home/user/Arduino/MySketch /home/user/MySketchArchive.zip\n" +
+			"  " + os.Args[0] + " archive --with-libraries -b arduino:avr:uno /home/user/Arduino/MySketch",
 		Args: cobra.MaximumNArgs(2),
 		Run:  runArchiveCommand,
 	}
 
 	archiveCommand.Flags().BoolVar(&includeBuildDir, "include-build-dir", false, tr("Includes %s directory in the archive.", "build"))
+	archiveCommand.Flags().BoolVar(&includeLibraries, "with-libraries", false, tr("Includes the libraries used by the sketch, and a manifest recording their versions, in the %s directory of the archive.", "libraries"))
+	archiveFqbn.AddToCommand(archiveCommand)
 
 	return archiveCommand
 }
@@ -68,15 +76,27 @@ func runArchiveCommand(cmd *cobra.Command, args []string) {
 		archivePath = args[1]
 	}
 
-	_, err := sk.ArchiveSketch(context.Background(),
+	var inst *rpc.Instance
+	if includeLibraries {
+		// An instance is needed to resolve the libraries
+		inst = instance.CreateAndInit()
+	}
+
+	resp, err := sk.ArchiveSketch(context.Background(),
 		&rpc.ArchiveSketchRequest{
-			SketchPath:      sketchPath.String(),
-			ArchivePath:     archivePath,
-			IncludeBuildDir: includeBuildDir,
+			SketchPath:       sketchPath.String(),
+			ArchivePath:      archivePath,
+			IncludeBuildDir:  includeBuildDir,
+			IncludeLibraries: includeLibraries,
+			Instance:         inst,
+			Fqbn:             archiveFqbn.String(),
 		})
 
 	if err != nil {
 		feedback.Errorf(tr("Error archiving: %v"), err)
 		os.Exit(errorcodes.ErrGeneric)
 	}
+	for _, lib := range resp.GetArchivedLibraries() {
+		feedback.Info(tr("Included library %[1]s@%[2]s", lib.GetName(), lib.GetVersion()))
+	}
 }
@@ -0,0 +1,73 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
+	sk "github.com/arduino/arduino-cli/commands/sketch"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// initExtractCommand creates a new `extract` command
+func initExtractCommand() *cobra.Command {
+	extractCommand := &cobra.Command{
+		Use:   fmt.Sprintf("extract <%s> [<%s>]", tr("archivePath"), tr("destinationPath")),
+		Short: tr("Extracts a sketch archive."),
+		Long:  tr("Extracts a sketch archive, the libraries bundled in the archive with the --with-libraries flag are installed."),
+		Example: "" +
+			"  " + os.Args[0] + " sketch extract MySketch.zip\n" +
+			"  " + os.Args[0] + " sketch extract MySketch.zip /home/user/Arduino",
+		Args: cobra.RangeArgs(1, 2),
+		Run:  runExtractCommand,
+	}
+	return extractCommand
+}
+
+func runExtractCommand(cmd *cobra.Command, args []string) {
+	inst := instance.CreateAndInit()
+	logrus.Info("Executing `arduino-cli sketch extract`")
+
+	destinationPath := ""
+	if len(args) == 2 {
+		destinationPath = args[1]
+	}
+
+	resp, err := sk.ExtractSketch(context.Background(),
+		&rpc.ExtractSketchRequest{
+			Instance:        inst,
+			ArchivePath:     args[0],
+			DestinationPath: destinationPath,
+		})
+	if err != nil {
+		feedback.Errorf(tr("Error extracting the sketch archive: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+	for _, lib := range resp.GetInstalledLibraries() {
+		feedback.Info(tr("Installed library %[1]s@%[2]s", lib.GetName(), lib.GetVersion()))
+	}
+	for _, lib := range resp.GetSkippedLibraries() {
+		feedback.Info(tr("Library %[1]s@%[2]s already installed", lib.GetName(), lib.GetVersion()))
+	}
+	feedback.Success(tr("Sketch extracted in %s", resp.GetSketchPath()))
+}
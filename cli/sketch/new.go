@@ -62,5 +62,5 @@ func runNewCommand(cmd *cobra.Command, args []string) {
 		os.Exit(errorcodes.ErrGeneric)
 	}
 
-	feedback.Print(tr("Sketch created in: %s", sketchDirPath))
+	feedback.Success(tr("Sketch created in: %s", sketchDirPath))
 }
@@ -0,0 +1,103 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/arduino/arduino-cli/cli/arguments"
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
+	sk "github.com/arduino/arduino-cli/commands/sketch"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var (
+	profileFqbn arguments.Fqbn
+	profileName string
+)
+
+// initProfileCommand creates a new `profile` command
+func initProfileCommand() *cobra.Command {
+	profileCommand := &cobra.Command{
+		Use:     "profile",
+		Short:   tr("Sketch profiles commands."),
+		Long:    tr("Commands to manage the profiles of the sketch project file (sketch.yaml)."),
+		Example: "  " + os.Args[0] + " sketch profile dump -b arduino:avr:uno /home/user/Arduino/MySketch",
+	}
+	profileCommand.AddCommand(initProfileDumpCommand())
+	return profileCommand
+}
+
+func initProfileDumpCommand() *cobra.Command {
+	dumpCommand := &cobra.Command{
+		Use:   fmt.Sprintf("dump [<%s>]", tr("sketchPath")),
+		Short: tr("Generates a profile pinning the installed platforms and libraries used by a sketch."),
+		Long:  tr("Resolves the platforms and the libraries used to build a sketch and prints a profile, pinning the installed versions, ready to be added to the sketch project file (sketch.yaml)."),
+		Example: "" +
+			"  " + os.Args[0] + " sketch profile dump -b arduino:avr:uno\n" +
+			"  " + os.Args[0] + " sketch profile dump -b arduino:avr:uno --name release /home/user/Arduino/MySketch",
+		Args: cobra.MaximumNArgs(1),
+		Run:  runProfileDumpCommand,
+	}
+	profileFqbn.AddToCommand(dumpCommand)
+	dumpCommand.Flags().StringVar(&profileName, "name", "", tr("Name of the generated profile, if omitted the ID of the board is used."))
+	return dumpCommand
+}
+
+func runProfileDumpCommand(cmd *cobra.Command, args []string) {
+	inst := instance.CreateAndInit()
+	logrus.Info("Executing `arduino-cli sketch profile dump`")
+
+	sketchPath := paths.New(".")
+	if len(args) == 1 {
+		sketchPath = paths.New(args[0])
+	}
+
+	resp, err := sk.DumpSketchProfile(context.Background(), &rpc.DumpSketchProfileRequest{
+		Instance:    inst,
+		SketchPath:  sketchPath.String(),
+		Fqbn:        profileFqbn.String(),
+		ProfileName: profileName,
+	})
+	if err != nil {
+		feedback.Errorf(tr("Error generating the sketch profile: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+	if libs := resp.GetUnversionedLibraries(); len(libs) > 0 {
+		feedback.Errorf(tr("The following libraries have no version and can't be added to the profile: %s"), strings.Join(libs, ", "))
+	}
+	feedback.PrintResult(profileDumpResult{resp})
+}
+
+type profileDumpResult struct {
+	resp *rpc.DumpSketchProfileResponse
+}
+
+func (r profileDumpResult) Data() interface{} {
+	return r.resp
+}
+
+func (r profileDumpResult) String() string {
+	return strings.TrimSuffix(r.resp.GetProfileYaml(), "\n")
+}
@@ -35,6 +35,8 @@ func NewCommand() *cobra.Command {
 
 	sketchCommand.AddCommand(initNewCommand())
 	sketchCommand.AddCommand(initArchiveCommand())
+	sketchCommand.AddCommand(initExtractCommand())
+	sketchCommand.AddCommand(initProfileCommand())
 
 	return sketchCommand
 }
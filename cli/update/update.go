@@ -55,13 +55,14 @@ func runUpdateCommand(cmd *cobra.Command, args []string) {
 	inst := instance.CreateInstanceAndRunFirstUpdate()
 	logrus.Info("Executing `arduino-cli update`")
 
-	err := commands.UpdateCoreLibrariesIndex(context.Background(), &rpc.UpdateCoreLibrariesIndexRequest{
+	resp, err := commands.UpdateCoreLibrariesIndex(context.Background(), &rpc.UpdateCoreLibrariesIndexRequest{
 		Instance: inst,
 	}, output.ProgressBar())
 	if err != nil {
 		feedback.Errorf(tr("Error updating core and libraries index: %v"), err)
 		os.Exit(errorcodes.ErrGeneric)
 	}
+	feedback.PrintResult(output.IndexUpdateResult{Reports: resp.GetIndexUpdateReports()})
 
 	if updateFlags.showOutdated {
 		// To show outdated platforms and libraries we need to initialize our instance
@@ -81,6 +81,11 @@ func shouldCheckForUpdate(currentVersion *semver.Version) bool {
 		return false
 	}
 
+	if configuration.NetworkOffline(configuration.Settings) {
+		// No network access in offline mode
+		return false
+	}
+
 	if !configuration.Settings.GetBool("updater.enable_notification") {
 		// Don't check if the user disabled the notification
 		return false
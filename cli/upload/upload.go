@@ -19,6 +19,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
 	"strings"
 
@@ -47,6 +49,7 @@ var (
 	importFile string
 	programmer arguments.Programmer
 	dryRun     bool
+	profile    string
 	tr         = i18n.Tr
 )
 
@@ -56,7 +59,8 @@ func NewCommand() *cobra.Command {
 		Use:     "upload",
 		Short:   tr("Upload Arduino sketches."),
 		Long:    tr("Upload Arduino sketches. This does NOT compile the sketch prior to upload."),
-		Example: "  " + os.Args[0] + " upload /home/user/Arduino/MySketch",
+		Example: "  " + os.Args[0] + " upload /home/user/Arduino/MySketch\n" +
+			"  " + os.Args[0] + " upload -b arduino:avr:uno -p /dev/ttyACM0 --input-file /home/user/build/MySketch.ino.hex",
 		Args:    cobra.MaximumNArgs(1),
 		PreRun: func(cmd *cobra.Command, args []string) {
 			arguments.CheckFlagsConflicts(cmd, "input-file", "input-dir")
@@ -67,10 +71,11 @@ func NewCommand() *cobra.Command {
 	fqbn.AddToCommand(uploadCommand)
 	port.AddToCommand(uploadCommand)
 	uploadCommand.Flags().StringVarP(&importDir, "input-dir", "", "", tr("Directory containing binaries to upload."))
-	uploadCommand.Flags().StringVarP(&importFile, "input-file", "i", "", tr("Binary file to upload."))
+	uploadCommand.Flags().StringVarP(&importFile, "input-file", "i", "", tr("Binary file to upload, the sketch is not required."))
 	uploadCommand.Flags().BoolVarP(&verify, "verify", "t", false, tr("Verify uploaded binary after the upload."))
 	uploadCommand.Flags().BoolVarP(&verbose, "verbose", "v", false, tr("Optional, turns on verbose mode."))
 	programmer.AddToCommand(uploadCommand)
+	uploadCommand.Flags().StringVarP(&profile, "profile", "m", "", tr("Sketch profile to use, as defined in the sketch project file."))
 	uploadCommand.Flags().BoolVar(&dryRun, "dry-run", false, tr("Do not perform the actual upload, just log out actions"))
 	uploadCommand.Flags().MarkHidden("dry-run")
 	return uploadCommand
@@ -151,6 +156,10 @@ func runUploadCommand(command *cobra.Command, args []string) {
 		path = sketchPath.String()
 	}
 
+	var uploadOut io.Writer = os.Stdout
+	if feedback.IsQuiet() {
+		uploadOut = ioutil.Discard
+	}
 	if _, err := upload.Upload(context.Background(), &rpc.UploadRequest{
 		Instance:   instance,
 		Fqbn:       fqbn.String(),
@@ -163,7 +172,8 @@ func runUploadCommand(command *cobra.Command, args []string) {
 		Programmer: programmer.String(),
 		DryRun:     dryRun,
 		UserFields: fields,
-	}, os.Stdout, os.Stderr); err != nil {
+		Profile:    profile,
+	}, uploadOut, os.Stderr, nil); err != nil {
 		feedback.Errorf(tr("Error during Upload: %v"), err)
 		os.Exit(errorcodes.ErrGeneric)
 	}
@@ -68,7 +68,7 @@ func runVersionCommand(cmd *cobra.Command, args []string) {
 
 	feedback.Print(versionInfo)
 
-	if feedback.GetFormat() == feedback.Text && latestVersion != nil {
+	if feedback.GetFormat() == feedback.Text && latestVersion != nil && !feedback.IsQuiet() {
 		updater.NotifyNewVersionIsAvailable(latestVersion.String())
 	}
 }
@@ -17,11 +17,15 @@ package board
 
 import (
 	"context"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/commands"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	properties "github.com/arduino/go-properties-orderedmap"
 )
 
 // Details returns all details for a board including tools and HW identifiers.
@@ -55,6 +59,8 @@ func Details(ctx context.Context, req *rpc.BoardDetailsRequest) (*rpc.BoardDetai
 		})
 	}
 
+	details.Memory = boardMemory(boardProperties)
+
 	details.DebuggingSupported = boardProperties.ContainsKey("debug.executable") ||
 		boardPlatform.Properties.ContainsKey("debug.executable") ||
 		(boardRefPlatform != nil && boardRefPlatform.Properties.ContainsKey("debug.executable")) ||
@@ -142,3 +148,50 @@ func Details(ctx context.Context, req *rpc.BoardDetailsRequest) (*rpc.BoardDetai
 
 	return details, nil
 }
+
+// boardMemory returns the memory layout defined by the upload.maximum_*size
+// properties of the board. The flash and data regions come first, followed
+// by the other regions sorted by name.
+func boardMemory(boardProperties *properties.Map) *rpc.BoardMemory {
+	memory := &rpc.BoardMemory{Regions: []*rpc.MemoryRegion{}}
+	upload := boardProperties.SubTree("upload")
+	for _, key := range upload.Keys() {
+		if !strings.HasPrefix(key, "maximum_") || !strings.HasSuffix(key, "size") || strings.Contains(key, ".") {
+			continue
+		}
+		size, err := strconv.ParseInt(strings.TrimSpace(upload.Get(key)), 10, 64)
+		if err != nil {
+			continue
+		}
+		name := strings.TrimSuffix(strings.TrimSuffix(strings.TrimPrefix(key, "maximum_"), "size"), "_")
+		switch key {
+		case "maximum_size":
+			name = "flash"
+			memory.MaximumSize = size
+		case "maximum_data_size":
+			memory.MaximumDataSize = size
+		}
+		memory.Regions = append(memory.Regions, &rpc.MemoryRegion{
+			Name:     name,
+			Size:     size,
+			Property: "upload." + key,
+		})
+	}
+	order := func(name string) int {
+		switch name {
+		case "flash":
+			return 0
+		case "data":
+			return 1
+		}
+		return 2
+	}
+	sort.SliceStable(memory.Regions, func(i, j int) bool {
+		a, b := memory.Regions[i], memory.Regions[j]
+		if order(a.Name) != order(b.Name) {
+			return order(a.Name) < order(b.Name)
+		}
+		return a.Name < b.Name
+	})
+	return memory
+}
@@ -0,0 +1,47 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package board
+
+import (
+	"testing"
+
+	properties "github.com/arduino/go-properties-orderedmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoardMemory(t *testing.T) {
+	props := properties.NewFromHashmap(map[string]string{
+		"name":                       "Arduino Uno",
+		"upload.maximum_eeprom_size": "1024",
+		"upload.maximum_data_size":   "2048",
+		"upload.maximum_size":        "32256",
+		"upload.maximum_bad_size":    "unknown",
+		"upload.speed":               "115200",
+	})
+	memory := boardMemory(props)
+	require.Equal(t, int64(32256), memory.MaximumSize)
+	require.Equal(t, int64(2048), memory.MaximumDataSize)
+	require.Len(t, memory.Regions, 3)
+	require.Equal(t, "flash", memory.Regions[0].Name)
+	require.Equal(t, "upload.maximum_size", memory.Regions[0].Property)
+	require.Equal(t, "data", memory.Regions[1].Name)
+	require.Equal(t, "eeprom", memory.Regions[2].Name)
+	require.Equal(t, int64(1024), memory.Regions[2].Size)
+
+	memory = boardMemory(properties.NewMap())
+	require.Zero(t, memory.MaximumSize)
+	require.Empty(t, memory.Regions)
+}
@@ -0,0 +1,97 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package board
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/commands"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-properties-orderedmap"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Scores of the BoardIdentify matches
+const (
+	identifyScoreInstalledArduino = 100
+	identifyScoreInstalled        = 90
+	identifyScoreCloudAPI         = 50
+)
+
+// Identify returns all the boards matching the given identification
+// properties, ranked by score
+func Identify(ctx context.Context, req *rpc.BoardIdentifyRequest) (*rpc.BoardIdentifyResponse, error) {
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	boards, err := identifyRanked(pm, properties.NewFromHashmap(req.GetProperties()), req.GetUseCloudApi())
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.BoardIdentifyResponse{Boards: boards}, nil
+}
+
+func identifyRanked(pm *packagemanager.PackageManager, idProps *properties.Map, useCloudAPI bool) ([]*rpc.BoardIdentifyMatch, error) {
+	matches := map[string]*rpc.BoardIdentifyMatch{}
+	for _, board := range pm.IdentifyBoard(idProps) {
+		score := int32(identifyScoreInstalled)
+		if board.PlatformRelease.Platform.Package.Maintainer == "Arduino" {
+			score = identifyScoreInstalledArduino
+		}
+		matches[board.FQBN()] = &rpc.BoardIdentifyMatch{
+			Name:      board.Name(),
+			Fqbn:      board.FQBN(),
+			Installed: true,
+			Score:     score,
+		}
+	}
+
+	if useCloudAPI && idProps.ContainsKey("vid") && idProps.ContainsKey("pid") {
+		logrus.Debug("Querying builder API for board identification...")
+		items, err := apiByVidPid(idProps.Get("vid"), idProps.Get("pid"))
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			return nil, &arduino.UnavailableError{Message: tr("Error getting board info from Arduino Cloud"), Cause: err}
+		}
+		for _, item := range items {
+			if _, ok := matches[item.Fqbn]; ok {
+				continue
+			}
+			matches[item.Fqbn] = &rpc.BoardIdentifyMatch{
+				Name:  item.Name,
+				Fqbn:  item.Fqbn,
+				Score: identifyScoreCloudAPI,
+			}
+		}
+	}
+
+	res := []*rpc.BoardIdentifyMatch{}
+	for _, match := range matches {
+		res = append(res, match)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Score != res[j].Score {
+			return res[i].Score > res[j].Score
+		}
+		return strings.ToLower(res[i].Fqbn) < strings.ToLower(res[j].Fqbn)
+	})
+	return res, nil
+}
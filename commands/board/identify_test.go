@@ -0,0 +1,77 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package board
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
+	"github.com/stretchr/testify/require"
+	semver "go.bug.st/relaxed-semver"
+)
+
+func TestIdentifyRanked(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"fqbn": "cloud:avr:board", "name": "Cloud Board"}`)
+	}))
+	defer ts.Close()
+	vidPidURL = ts.URL
+
+	dataDir, err := paths.MkTempDir("", "test_identify")
+	require.NoError(t, err)
+	defer dataDir.RemoveAll()
+	pm := packagemanager.NewPackageManager(dataDir, dataDir, dataDir, dataDir, "test")
+
+	pack := pm.Packages.GetOrCreatePackage("packager")
+	pack.Maintainer = "NotArduino"
+	platformRelease := pack.GetOrCreatePlatform("platform").GetOrCreateRelease(semver.MustParse("0.0.0"))
+	platformRelease.InstallDir = dataDir
+	board := platformRelease.GetOrCreateBoard("boardA")
+	board.Properties.Set("upload_port.vid", "0x0001")
+	board.Properties.Set("upload_port.pid", "0x0002")
+
+	pack = pm.Packages.GetOrCreatePackage("arduino")
+	pack.Maintainer = "Arduino"
+	platformRelease = pack.GetOrCreatePlatform("avr").GetOrCreateRelease(semver.MustParse("0.0.0"))
+	platformRelease.InstallDir = dataDir
+	board = platformRelease.GetOrCreateBoard("uno")
+	board.Properties.Set("upload_port.vid", "0x0001")
+	board.Properties.Set("upload_port.pid", "0x0002")
+
+	idProps := properties.NewMap()
+	idProps.Set("vid", "0x0001")
+	idProps.Set("pid", "0x0002")
+
+	res, err := identifyRanked(pm, idProps, false)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	require.Equal(t, "arduino:avr:uno", res[0].GetFqbn())
+	require.True(t, res[0].GetInstalled())
+	require.Equal(t, "packager:platform:boardA", res[1].GetFqbn())
+	require.Greater(t, res[0].GetScore(), res[1].GetScore())
+
+	res, err = identifyRanked(pm, idProps, true)
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+	require.Equal(t, "cloud:avr:board", res[2].GetFqbn())
+	require.False(t, res[2].GetInstalled())
+	require.Greater(t, res[1].GetScore(), res[2].GetScore())
+}
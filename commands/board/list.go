@@ -251,6 +251,8 @@ func Watch(instanceID int32, interrupt <-chan bool) (<-chan *rpc.BoardListWatchR
 
 	go func() {
 		defer close(outChan)
+		// the boards identified on each port, by protocol and address
+		identifiedBoards := map[string][]*rpc.BoardListItem{}
 		for _, err := range errs {
 			outChan <- &rpc.BoardListWatchResponse{
 				EventType: "error",
@@ -284,12 +286,20 @@ func Watch(instanceID int32, interrupt <-chan bool) (<-chan *rpc.BoardListWatchR
 				}
 
 				boardsError := ""
+				portKey := event.Port.Protocol + "://" + event.Port.Address
 				if event.Type == "add" {
 					boards, err := identify(pm, event.Port)
 					if err != nil {
 						boardsError = err.Error()
 					}
 					port.MatchingBoards = boards
+					identifiedBoards[portKey] = boards
+				} else if event.Type == "remove" {
+					// Report the boards identified when the port has been
+					// added, so the clients have the full metadata of the
+					// removed port
+					port.MatchingBoards = identifiedBoards[portKey]
+					delete(identifiedBoards, portKey)
 				}
 				outChan <- &rpc.BoardListWatchResponse{
 					EventType: event.Type,
@@ -0,0 +1,55 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package board
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// ParseFQBN returns the components of the given FQBN and its canonical form
+func ParseFQBN(ctx context.Context, req *rpc.ParseFQBNRequest) (*rpc.ParseFQBNResponse, error) {
+	if req.GetFqbn() == "" {
+		return nil, &arduino.MissingFQBNError{}
+	}
+	fqbn, err := cores.ParseFQBN(strings.TrimSpace(req.GetFqbn()))
+	if err != nil {
+		return nil, &arduino.InvalidFQBNError{Cause: err}
+	}
+
+	options := fqbn.Configs.AsMap()
+	keys := fqbn.Configs.Keys()
+	sort.Strings(keys)
+	normalized := fqbn.StringWithoutConfig()
+	sep := ":"
+	for _, k := range keys {
+		normalized += sep + k + "=" + options[k]
+		sep = ","
+	}
+
+	return &rpc.ParseFQBNResponse{
+		Packager:       fqbn.Package,
+		Architecture:   fqbn.PlatformArch,
+		BoardId:        fqbn.BoardID,
+		Options:        options,
+		NormalizedFqbn: normalized,
+	}, nil
+}
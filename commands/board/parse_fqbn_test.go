@@ -0,0 +1,44 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package board
+
+import (
+	"context"
+	"testing"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFQBN(t *testing.T) {
+	res, err := ParseFQBN(context.Background(), &rpc.ParseFQBNRequest{Fqbn: "arduino:avr:nano:cpu=atmega328old,baud = 57600"})
+	require.NoError(t, err)
+	require.Equal(t, "arduino", res.GetPackager())
+	require.Equal(t, "avr", res.GetArchitecture())
+	require.Equal(t, "nano", res.GetBoardId())
+	require.Equal(t, map[string]string{"cpu": "atmega328old", "baud": "57600"}, res.GetOptions())
+	require.Equal(t, "arduino:avr:nano:baud=57600,cpu=atmega328old", res.GetNormalizedFqbn())
+
+	res, err = ParseFQBN(context.Background(), &rpc.ParseFQBNRequest{Fqbn: "arduino:avr:uno"})
+	require.NoError(t, err)
+	require.Empty(t, res.GetOptions())
+	require.Equal(t, "arduino:avr:uno", res.GetNormalizedFqbn())
+
+	for _, fqbn := range []string{"", "arduino:avr", "arduino:avr:", "arduino:avr:uno:cpu", "arduino:avr:uno:=x"} {
+		_, err := ParseFQBN(context.Background(), &rpc.ParseFQBNRequest{Fqbn: fqbn})
+		require.Error(t, err, fqbn)
+	}
+}
@@ -28,7 +28,8 @@ import (
 
 // Search returns all boards that match the search arg.
 // Boards are searched in all platforms, including those in the index that are not yet
-// installed. Note that platforms that are not installed don't include boards' FQBNs.
+// installed and the newer releases of the installed ones. Note that the boards of
+// platforms that are not installed don't include boards' FQBNs.
 // If no search argument is used all boards are returned.
 func Search(ctx context.Context, req *rpc.BoardSearchRequest) (*rpc.BoardSearchResponse, error) {
 	pm := commands.GetPackageManager(req.GetInstance().GetId())
@@ -67,8 +68,10 @@ func Search(ctx context.Context, req *rpc.BoardSearchRequest) (*rpc.BoardSearchR
 			// ways of reading board data.
 			// The only boards information for platforms that are not installed
 			// is that found in the index, usually that's only a board name.
+			installedBoards := map[string]bool{}
 			if installedPlatformRelease != nil {
 				for _, board := range installedPlatformRelease.Boards {
+					installedBoards[board.Name()] = true
 					if !req.GetIncludeHiddenBoards() && board.IsHidden() {
 						continue
 					}
@@ -79,22 +82,32 @@ func Search(ctx context.Context, req *rpc.BoardSearchRequest) (*rpc.BoardSearchR
 					}
 
 					res.Boards = append(res.Boards, &rpc.BoardListItem{
-						Name:     board.Name(),
-						Fqbn:     board.FQBN(),
-						IsHidden: board.IsHidden(),
-						Platform: rpcPlatform,
+						Name:            board.Name(),
+						Fqbn:            board.FQBN(),
+						IsHidden:        board.IsHidden(),
+						Platform:        rpcPlatform,
+						PlatformVersion: installedPlatformRelease.Version.String(),
+						Installed:       true,
 					})
 				}
-			} else if latestPlatformRelease != nil {
+			}
+			// The boards added by a newer release of an installed platform are
+			// reported too, they are available by upgrading the platform
+			if latestPlatformRelease != nil && latestPlatformRelease != installedPlatformRelease {
 				for _, board := range latestPlatformRelease.BoardsManifest {
+					name := strings.Trim(board.Name, " \n")
+					if installedBoards[name] {
+						continue
+					}
 					toTest := append(strings.Split(board.Name, " "), board.Name)
 					if !utils.MatchAny(req.GetSearchArgs(), toTest) {
 						continue
 					}
 
 					res.Boards = append(res.Boards, &rpc.BoardListItem{
-						Name:     strings.Trim(board.Name, " \n"),
-						Platform: rpcPlatform,
+						Name:            name,
+						Platform:        rpcPlatform,
+						PlatformVersion: latestPlatformRelease.Version.String(),
 					})
 				}
 			}
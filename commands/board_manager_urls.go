@@ -0,0 +1,124 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"errors"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores/packageindex"
+	"github.com/arduino/arduino-cli/arduino/resources"
+	"github.com/arduino/arduino-cli/arduino/utils"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	settings "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/settings/v1"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// ListBoardManagerURLs returns the additional board manager URLs
+func ListBoardManagerURLs(req *settings.ListBoardManagerURLsRequest) *settings.ListBoardManagerURLsResponse {
+	return &settings.ListBoardManagerURLsResponse{
+		Urls: configuration.Settings.GetStringSlice("board_manager.additional_urls"),
+	}
+}
+
+// AddBoardManagerURL adds an URL to the additional board manager URLs, the
+// package index is downloaded and checked unless the validation is skipped
+func AddBoardManagerURL(req *settings.AddBoardManagerURLRequest) (*settings.AddBoardManagerURLResponse, error) {
+	u := strings.TrimSpace(req.GetUrl())
+	URL, err := utils.URLParse(u)
+	if err != nil {
+		return nil, &arduino.InvalidURLError{Cause: err}
+	}
+	switch URL.Scheme {
+	case "http", "https", "file":
+	default:
+		return nil, &arduino.InvalidURLError{Cause: errors.New(tr("unsupported scheme '%s', use http, https or file", URL.Scheme))}
+	}
+
+	urls := configuration.Settings.GetStringSlice("board_manager.additional_urls")
+	for _, existing := range urls {
+		if existing == u {
+			return &settings.AddBoardManagerURLResponse{AlreadyPresent: true}, nil
+		}
+	}
+
+	res := &settings.AddBoardManagerURLResponse{}
+	if !req.GetSkipValidation() {
+		packages, err := validatePackageIndexURL(URL)
+		if err != nil {
+			return nil, err
+		}
+		res.Packages = packages
+	}
+
+	configuration.Settings.Set("board_manager.additional_urls", append(urls, u))
+	return res, nil
+}
+
+// RemoveBoardManagerURL removes an URL from the additional board manager URLs
+func RemoveBoardManagerURL(req *settings.RemoveBoardManagerURLRequest) (*settings.RemoveBoardManagerURLResponse, error) {
+	u := strings.TrimSpace(req.GetUrl())
+	urls := []string{}
+	found := false
+	for _, existing := range configuration.Settings.GetStringSlice("board_manager.additional_urls") {
+		if existing == u {
+			found = true
+			continue
+		}
+		urls = append(urls, existing)
+	}
+	if !found {
+		return nil, &arduino.NotFoundError{Message: tr("URL %s is not in the additional board manager URLs", u)}
+	}
+	configuration.Settings.Set("board_manager.additional_urls", urls)
+	return &settings.RemoveBoardManagerURLResponse{}, nil
+}
+
+// validatePackageIndexURL loads the package index from the given URL, that is
+// downloaded in a temporary directory, and returns the names of the packages
+// defined in it
+func validatePackageIndexURL(URL *url.URL) ([]string, error) {
+	indexPath := paths.New(URL.Path)
+	if URL.Scheme != "file" {
+		tmp, err := paths.MkTempDir("", "board_manager_url")
+		if err != nil {
+			return nil, &arduino.TempDirCreationFailedError{Cause: err}
+		}
+		defer tmp.RemoveAll()
+		indexResource := resources.IndexResource{URL: URL}
+		if err := indexResource.Download(tmp, func(*rpc.DownloadProgress) {}); err != nil {
+			return nil, err
+		}
+		indexPath = tmp.Join(strings.TrimSuffix(path.Base(URL.Path), ".gz"))
+	}
+
+	index, err := packageindex.LoadIndexNoSign(indexPath)
+	if err != nil {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Invalid package index in %s", URL), Cause: err}
+	}
+	if len(index.Packages) == 0 {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Invalid package index in %s", URL), Cause: errors.New(tr("no packages defined"))}
+	}
+	packages := []string{}
+	for _, pkg := range index.Packages {
+		packages = append(packages, pkg.Name)
+	}
+	return packages, nil
+}
@@ -0,0 +1,161 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/builder"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+)
+
+// coreBuildCachePath returns the folder where the precompiled cores are cached
+func coreBuildCachePath() *paths.Path {
+	return paths.TempDir().Join("arduino-core-cache")
+}
+
+// sketchBuildPaths returns the build folders of the sketches compiled in the
+// default build path
+func sketchBuildPaths() (paths.PathList, error) {
+	dirs, err := paths.TempDir().ReadDir()
+	if err != nil {
+		return nil, err
+	}
+	dirs.FilterDirs()
+	dirs.FilterPrefix("arduino-sketch-")
+	return dirs, nil
+}
+
+// GetCacheStats returns the size of the build cache and the number of sketches
+// with a cached build folder. The hit/miss counters are not filled since they
+// are tracked by the caller.
+func GetCacheStats(ctx context.Context, req *rpc.GetCacheStatsRequest) (*rpc.GetCacheStatsResponse, error) {
+	sketchDirs, err := sketchBuildPaths()
+	if err != nil {
+		return nil, &arduino.PermissionDeniedError{Message: tr("Error reading build cache directory"), Cause: err}
+	}
+	size := int64(0)
+	for _, dir := range append(sketchDirs, coreBuildCachePath()) {
+		s, err := dirSize(dir)
+		if err != nil {
+			return nil, &arduino.PermissionDeniedError{Message: tr("Error reading build cache directory"), Cause: err}
+		}
+		size += s
+	}
+	return &rpc.GetCacheStatsResponse{
+		Size:           size,
+		CachedSketches: int32(len(sketchDirs)),
+	}, nil
+}
+
+// ClearCache removes the cached cores and the sketch build folders and
+// returns the number of bytes freed. The build folders in use by a running
+// compilation are skipped and reported in the response.
+func ClearCache(ctx context.Context, req *rpc.ClearCacheRequest) (*rpc.ClearCacheResponse, error) {
+	sketchDirs, err := sketchBuildPaths()
+	if err != nil {
+		return nil, &arduino.PermissionDeniedError{Message: tr("Error reading build cache directory"), Cause: err}
+	}
+	res := &rpc.ClearCacheResponse{}
+	for _, dir := range sketchDirs {
+		lock, err := builder.TryLockBuildPath(dir)
+		if err != nil {
+			return res, &arduino.PermissionDeniedError{Message: tr("Error cleaning caches"), Cause: err}
+		}
+		if lock == nil {
+			logrus.Warnf("Build path %s is in use, not removed", dir)
+			res.SkippedBuildPaths = append(res.SkippedBuildPaths, dir.String())
+			continue
+		}
+		freed, err := removeLockedBuildPath(dir, lock)
+		res.Freed += freed
+		if err != nil {
+			return res, &arduino.PermissionDeniedError{Message: tr("Error cleaning caches"), Cause: err}
+		}
+	}
+
+	coreCache := coreBuildCachePath()
+	size, err := dirSize(coreCache)
+	if err != nil {
+		return res, &arduino.PermissionDeniedError{Message: tr("Error reading build cache directory"), Cause: err}
+	}
+	if err := coreCache.RemoveAll(); err != nil {
+		return res, &arduino.PermissionDeniedError{Message: tr("Error cleaning caches"), Cause: err}
+	}
+	res.Freed += size
+	return res, nil
+}
+
+// removeLockedBuildPath removes a build path while holding its lock, so that a
+// compilation can't start using it in the meantime, and returns the number of
+// bytes freed. Some OS don't allow to remove the lock file while it's open, in
+// this case it's removed after the unlock. The (then empty) build path is
+// removed last, unless a compilation started to use it in the meantime.
+func removeLockedBuildPath(dir *paths.Path, lock *builder.BuildPathLock) (int64, error) {
+	size, err := dirSize(dir)
+	if err != nil {
+		lock.Unlock()
+		return 0, err
+	}
+	files, err := dir.ReadDir()
+	if err != nil {
+		lock.Unlock()
+		return 0, err
+	}
+	for _, file := range files {
+		if file.Base() == builder.BuildPathLockFileName {
+			continue
+		}
+		if err := file.RemoveAll(); err != nil {
+			lock.Unlock()
+			return 0, err
+		}
+	}
+	lockFile := dir.Join(builder.BuildPathLockFileName)
+	lockFileRemoved := lockFile.Remove() == nil
+	if err := lock.Unlock(); err != nil {
+		logrus.WithError(err).Warnf("Unlocking build path %s", dir)
+	}
+	if !lockFileRemoved {
+		_ = lockFile.Remove()
+	}
+	_ = dir.Remove()
+	return size, nil
+}
+
+// dirSize returns the total size of the regular files contained in dir,
+// a missing dir has size 0
+func dirSize(dir *paths.Path) (int64, error) {
+	size := int64(0)
+	err := filepath.Walk(dir.String(), func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
@@ -0,0 +1,72 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/builder"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheStatsAndClear(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "test_build_cache")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	t.Setenv("TMPDIR", tmp.String())
+
+	require.NoError(t, tmp.Join("arduino-core-cache").MkdirAll())
+	require.NoError(t, tmp.Join("arduino-core-cache", "core.a").WriteFile(make([]byte, 100)))
+	require.NoError(t, tmp.Join("arduino-sketch-AAAA", "sketch").MkdirAll())
+	require.NoError(t, tmp.Join("arduino-sketch-AAAA", "sketch", "sketch.ino.o").WriteFile(make([]byte, 20)))
+	require.NoError(t, tmp.Join("arduino-sketch-BBBB").MkdirAll())
+	require.NoError(t, tmp.Join("unrelated").WriteFile(make([]byte, 1000)))
+
+	stats, err := GetCacheStats(context.Background(), &rpc.GetCacheStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(120), stats.GetSize())
+	require.Equal(t, int32(2), stats.GetCachedSketches())
+
+	// The build paths in use by a compilation are not removed
+	require.NoError(t, tmp.Join("arduino-sketch-CCCC").MkdirAll())
+	require.NoError(t, tmp.Join("arduino-sketch-CCCC", "sketch.ino.o").WriteFile(make([]byte, 10)))
+	lock, err := builder.TryLockBuildPath(tmp.Join("arduino-sketch-CCCC"))
+	require.NoError(t, err)
+	require.NotNil(t, lock)
+	res, err := ClearCache(context.Background(), &rpc.ClearCacheRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(120), res.GetFreed())
+	require.Equal(t, []string{tmp.Join("arduino-sketch-CCCC").String()}, res.GetSkippedBuildPaths())
+	require.False(t, tmp.Join("arduino-core-cache").Exist())
+	require.False(t, tmp.Join("arduino-sketch-AAAA").Exist())
+	require.True(t, tmp.Join("arduino-sketch-CCCC", "sketch.ino.o").Exist())
+	require.True(t, tmp.Join("unrelated").Exist())
+
+	require.NoError(t, lock.Unlock())
+	res, err = ClearCache(context.Background(), &rpc.ClearCacheRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(10), res.GetFreed())
+	require.Empty(t, res.GetSkippedBuildPaths())
+	require.False(t, tmp.Join("arduino-sketch-CCCC").Exist())
+
+	stats, err = GetCacheStats(context.Background(), &rpc.GetCacheStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), stats.GetSize())
+	require.Equal(t, int32(0), stats.GetCachedSketches())
+}
@@ -17,12 +17,15 @@ package compile
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"os"
 	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/arduino/arduino-cli/arduino"
 	bldr "github.com/arduino/arduino-cli/arduino/builder"
@@ -79,12 +82,14 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 
 	// Use defer func() to evaluate tags map when function returns
 	// and set success flag inspecting the error named return parameter
+	start := time.Now()
 	defer func() {
 		tags["success"] = "true"
 		if e != nil {
 			tags["success"] = "false"
 		}
 		stats.Incr("compile", stats.M(tags)...)
+		metrics.ObserveCommandDuration("compile", start, e)
 	}()
 
 	pm := commands.GetPackageManager(req.GetInstance().GetId())
@@ -102,7 +107,15 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 		return nil, &arduino.CantOpenSketchError{Cause: err}
 	}
 
+	profile, err := commands.ResolveSketchProfile(req.GetInstance(), sk, req.GetProfile())
+	if err != nil {
+		return nil, err
+	}
+
 	fqbnIn := req.GetFqbn()
+	if fqbnIn == "" && profile != nil {
+		fqbnIn = profile.GetFqbn()
+	}
 	if fqbnIn == "" && sk != nil && sk.Metadata != nil {
 		fqbnIn = sk.Metadata.CPU.Fqbn
 	}
@@ -124,6 +137,13 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 			Cause:    fmt.Errorf(tr("platform not installed")),
 		}
 	}
+	// Validate the custom board options before starting the build
+	if _, _, _, _, _, err := pm.ResolveFQBN(fqbn); err != nil {
+		var optionErr *arduino.InvalidBoardOptionError
+		if errors.As(err, &optionErr) {
+			return nil, optionErr
+		}
+	}
 
 	// At the current time we do not have a way of knowing if a board supports the secure boot or not,
 	// so, if the flags to override the default keys are used, we try override the corresponding platform property nonetheless.
@@ -145,9 +165,15 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 	builderCtx.BuiltInToolsDirs = configuration.BundleToolsDirectories(configuration.Settings)
 
 	builderCtx.OtherLibrariesDirs = paths.NewPathList(req.GetLibraries()...)
-	builderCtx.OtherLibrariesDirs.Add(configuration.LibrariesDir(configuration.Settings))
-
 	builderCtx.LibraryDirs = paths.NewPathList(req.Library...)
+	if profile == nil {
+		builderCtx.OtherLibrariesDirs.Add(configuration.LibrariesDir(configuration.Settings))
+	} else {
+		// Only the libraries pinned by the profile are used
+		for _, lib := range profile.GetLibraries() {
+			builderCtx.LibraryDirs.Add(paths.New(lib.GetInstallDir()))
+		}
+	}
 
 	if req.GetBuildPath() == "" {
 		builderCtx.BuildPath = sk.BuildPath
@@ -157,6 +183,21 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 	if err = builderCtx.BuildPath.MkdirAll(); err != nil {
 		return nil, &arduino.PermissionDeniedError{Message: tr("Cannot create build directory"), Cause: err}
 	}
+	if errStream == nil {
+		errStream = os.Stderr
+	}
+	// Prevent concurrent compiles from using the same build path
+	buildPathLock, err := bldr.LockBuildPath(ctx, builderCtx.BuildPath, func() {
+		// Not in outStream, that may be the output of the preprocessor
+		fmt.Fprintln(errStream, tr("Waiting for another compile using the build path %s...", builderCtx.BuildPath))
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, &arduino.CanceledError{Message: tr("Compile canceled"), Cause: ctx.Err()}
+		}
+		return nil, &arduino.PermissionDeniedError{Message: tr("Cannot lock build directory"), Cause: err}
+	}
+	defer buildPathLock.Unlock()
 	builderCtx.CompilationDatabase = bldr.NewCompilationDatabase(
 		builderCtx.BuildPath.Join("compile_commands.json"),
 	)
@@ -166,8 +207,15 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 	// Optimize for debug
 	builderCtx.OptimizeForDebug = req.GetOptimizeForDebug()
 
-	builderCtx.CoreBuildCachePath = paths.TempDir().Join("arduino-core-cache")
+	builderCtx.CoreBuildCachePath = coreBuildCachePath()
 
+	if len(req.GetExpectedArtifactsSha256()) > 0 && (req.GetDryRun() || req.GetCreateCompilationDatabaseOnly()) {
+		return nil, &arduino.InvalidArgumentError{Message: tr("The hashes of the build artifacts can't be checked without a full build")}
+	}
+
+	if req.GetJobs() < 0 {
+		return nil, &arduino.InvalidArgumentError{Message: tr("The number of parallel jobs must not be negative")}
+	}
 	builderCtx.Jobs = int(req.GetJobs())
 
 	builderCtx.USBVidPid = req.GetVidPid()
@@ -176,6 +224,12 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 	builderCtx.CustomBuildProperties = append(req.GetBuildProperties(), "build.warn_data_percentage=75")
 	builderCtx.CustomBuildProperties = append(req.GetBuildProperties(), securityKeysOverride...)
 
+	if definesFile := req.GetDefinesFile(); definesFile != "" {
+		if builderCtx.Defines, err = loadDefinesFile(paths.New(definesFile)); err != nil {
+			return nil, err
+		}
+	}
+
 	if req.GetBuildCachePath() != "" {
 		builderCtx.BuildCachePath = paths.New(req.GetBuildCachePath())
 		err = builderCtx.BuildCachePath.MkdirAll()
@@ -207,13 +261,17 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 	}
 
 	builderCtx.Stdout = outStream
-	builderCtx.Stderr = errStream
+	diagnostics := &bldr.DiagnosticsCollector{}
+	builderCtx.Stderr = io.MultiWriter(errStream, diagnostics)
 	builderCtx.Clean = req.GetClean()
 	builderCtx.OnlyUpdateCompilationDatabase = req.GetCreateCompilationDatabaseOnly()
+	builderCtx.DryRun = req.GetDryRun()
+	builderCtx.CancelContext = ctx
 
 	builderCtx.SourceOverride = req.GetSourceOverride()
 
 	r = &rpc.CompileResponse{}
+	r.Profile = profile
 	defer func() {
 		if p := builderCtx.BuildPath; p != nil {
 			r.BuildPath = p.String()
@@ -224,25 +282,45 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 		if pl := builderCtx.ActualPlatform; pl != nil {
 			r.BuildPlatform = pl.ToRPCPlatformReference()
 		}
+		for _, d := range diagnostics.Diagnostics() {
+			r.Diagnostics = append(r.Diagnostics, d.ToRPC())
+		}
+		if db := builderCtx.CompilationDatabase; db != nil && db.File.Exist() {
+			r.CompilationDatabasePath = db.File.String()
+			if req.GetIncludeCompilationDatabase() {
+				if data, err := db.File.ReadFile(); err != nil {
+					logrus.WithError(err).Warn("Error reading compilation database")
+				} else {
+					r.CompilationDatabase = data
+				}
+			}
+		}
 	}()
 
 	// if --preprocess or --show-properties were passed, we can stop here
 	if req.GetShowProperties() {
-		compileErr := builder.RunParseHardwareAndDumpBuildProperties(builderCtx)
-		if compileErr != nil {
-			compileErr = &arduino.CompileFailedError{Message: err.Error()}
+		if compileErr := builder.RunParseHardwareAndDumpBuildProperties(builderCtx); compileErr != nil {
+			return r, &arduino.CompileFailedError{Message: compileErr.Error()}
 		}
-		return r, compileErr
+		r.BuildProperties = builder.ExpandedBuildProperties(builderCtx.BuildProperties)
+		return r, nil
 	} else if req.GetPreprocess() {
-		compileErr := builder.RunPreprocess(builderCtx)
-		if compileErr != nil {
-			compileErr = &arduino.CompileFailedError{Message: err.Error()}
+		if compileErr := builder.RunPreprocess(builderCtx); compileErr != nil {
+			return r, &arduino.CompileFailedError{Message: compileErr.Error()}
+		}
+		usedLibs, err := usedLibraries(builderCtx)
+		if err != nil {
+			return r, err
 		}
-		return r, compileErr
+		r.UsedLibraries = usedLibs
+		return r, nil
 	}
 
 	// if it's a regular build, go on...
 	if err := builder.RunBuilder(builderCtx); err != nil {
+		if ctx.Err() != nil {
+			return r, &arduino.CanceledError{Message: tr("Compile canceled"), Cause: ctx.Err()}
+		}
 		return r, &arduino.CompileFailedError{Message: err.Error()}
 	}
 
@@ -250,8 +328,8 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 	if req.GetExportDir() != "" {
 		exportBinaries = true
 	}
-	// If CreateCompilationDatabaseOnly is set, we do not need to export anything
-	if req.GetCreateCompilationDatabaseOnly() {
+	// If CreateCompilationDatabaseOnly or DryRun are set, we do not need to export anything
+	if req.GetCreateCompilationDatabaseOnly() || req.GetDryRun() {
 		exportBinaries = false
 	}
 	if exportBinaries {
@@ -290,19 +368,80 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 		}
 	}
 
-	importedLibs := []*rpc.Library{}
-	for _, lib := range builderCtx.ImportedLibraries {
-		rpcLib, err := lib.ToRPCLibrary()
-		if err != nil {
-			return r, &arduino.PermissionDeniedError{Message: tr("Error getting information for library %s", lib.Name), Cause: err}
-		}
-		importedLibs = append(importedLibs, rpcLib)
+	usedLibs, err := usedLibraries(builderCtx)
+	if err != nil {
+		return r, err
 	}
-	r.UsedLibraries = importedLibs
+	r.UsedLibraries = usedLibs
 
 	r.ExecutableSectionsSize = builderCtx.ExecutableSectionsSize.ToRPCExecutableSectionSizeArray()
+	r.MemoryUsage = memoryUsage(r.ExecutableSectionsSize)
+	r.UsedCachedCore = builderCtx.UsedCachedCore
+	r.CompileStats = &rpc.CompileStats{
+		CompiledFiles: builderCtx.CompiledFiles,
+		ReusedFiles:   builderCtx.ReusedFiles,
+	}
+
+	// The artifacts are not produced without a full build
+	if !req.GetCreateCompilationDatabaseOnly() && !req.GetDryRun() {
+		projectName, ok := builderCtx.BuildProperties.GetOk("build.project_name")
+		if !ok {
+			return r, &arduino.MissingPlatformPropertyError{Property: "build.project_name"}
+		}
+		artifacts, err := artifactsHashes(builderCtx.BuildPath, projectName)
+		if err != nil {
+			return r, err
+		}
+		r.BuildHashes = &rpc.BuildHashes{
+			Artifacts:      artifacts,
+			CommandsSha256: builderCtx.BuildCommandsSHA256(),
+		}
+		if err := checkExpectedHashes(artifacts, req.GetExpectedArtifactsSha256()); err != nil {
+			return r, err
+		}
+	}
 
 	logrus.Tracef("Compile %s for %s successful", sk.Name, fqbnIn)
 
 	return r, nil
 }
+
+// memoryUsage returns the flash and RAM used by the sketch, taken from the
+// "text" and "data" sections. It returns nil if the "text" section is missing.
+func memoryUsage(sections []*rpc.ExecutableSectionSize) *rpc.MemoryUsage {
+	usage := &rpc.MemoryUsage{}
+	hasText := false
+	for _, section := range sections {
+		size := section.GetSize()
+		if size < 0 {
+			// The size is unknown
+			size = 0
+		}
+		switch section.GetName() {
+		case "text":
+			hasText = true
+			usage.FlashUsed = size
+			usage.FlashTotal = section.GetMaxSize()
+		case "data":
+			usage.RamUsed = size
+			usage.RamTotal = section.GetMaxSize()
+		}
+	}
+	if !hasText {
+		return nil
+	}
+	return usage
+}
+
+// usedLibraries returns the libraries detected by the builder
+func usedLibraries(builderCtx *types.Context) ([]*rpc.Library, error) {
+	importedLibs := []*rpc.Library{}
+	for _, lib := range builderCtx.ImportedLibraries {
+		rpcLib, err := lib.ToRPCLibrary()
+		if err != nil {
+			return nil, &arduino.PermissionDeniedError{Message: tr("Error getting information for library %s", lib.Name), Cause: err}
+		}
+		importedLibs = append(importedLibs, rpcLib)
+	}
+	return importedLibs, nil
+}
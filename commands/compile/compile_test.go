@@ -0,0 +1,45 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"testing"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryUsage(t *testing.T) {
+	usage := memoryUsage([]*rpc.ExecutableSectionSize{
+		{Name: "text", Size: 924, MaxSize: 32256},
+		{Name: "data", Size: 9, MaxSize: 2048},
+	})
+	require.Equal(t, &rpc.MemoryUsage{FlashUsed: 924, FlashTotal: 32256, RamUsed: 9, RamTotal: 2048}, usage)
+
+	// The data section is missing if the maximum data size is not defined
+	usage = memoryUsage([]*rpc.ExecutableSectionSize{{Name: "text", Size: 924, MaxSize: 32256}})
+	require.Equal(t, &rpc.MemoryUsage{FlashUsed: 924, FlashTotal: 32256}, usage)
+
+	// The unknown sizes are reported as 0
+	usage = memoryUsage([]*rpc.ExecutableSectionSize{
+		{Name: "text", Size: 924, MaxSize: 32256},
+		{Name: "data", Size: -1, MaxSize: 2048},
+	})
+	require.Equal(t, int64(0), usage.RamUsed)
+
+	require.Nil(t, memoryUsage(nil))
+	require.Nil(t, memoryUsage([]*rpc.ExecutableSectionSize{{Name: "data", Size: 9, MaxSize: 2048}}))
+}
@@ -0,0 +1,47 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"regexp"
+
+	"github.com/arduino/arduino-cli/arduino"
+	paths "github.com/arduino/go-paths-helper"
+	properties "github.com/arduino/go-properties-orderedmap"
+)
+
+var validMacroName = regexp.MustCompile("^[A-Za-z_][A-Za-z0-9_]*$")
+
+// loadDefinesFile reads the NAME=VALUE lines of the file and returns the
+// corresponding -DNAME=VALUE compiler flags.
+func loadDefinesFile(definesFile *paths.Path) ([]string, error) {
+	defines, err := properties.LoadFromPath(definesFile)
+	if err != nil {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Error reading defines file %s", definesFile), Cause: err}
+	}
+	flags := []string{}
+	for _, name := range defines.Keys() {
+		if !validMacroName.MatchString(name) {
+			return nil, &arduino.InvalidArgumentError{Message: tr("Invalid macro name '%[1]s' in %[2]s", name, definesFile)}
+		}
+		flag := "-D" + name
+		if value := defines.Get(name); value != "" {
+			flag += "=" + value
+		}
+		flags = append(flags, flag)
+	}
+	return flags, nil
+}
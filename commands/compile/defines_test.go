@@ -0,0 +1,42 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"testing"
+
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadDefinesFile(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "test_defines_file")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	definesFile := tmp.Join("secrets.txt")
+	require.NoError(t, definesFile.WriteFile([]byte("# WiFi credentials\nSSID=\"My WiFi\"\nPIN=2\nDEBUG=\n")))
+	flags, err := loadDefinesFile(definesFile)
+	require.NoError(t, err)
+	require.Equal(t, []string{`-DSSID="My WiFi"`, "-DPIN=2", "-DDEBUG"}, flags)
+
+	require.NoError(t, definesFile.WriteFile([]byte("NOT-VALID=1\n")))
+	_, err = loadDefinesFile(definesFile)
+	require.Error(t, err)
+
+	_, err = loadDefinesFile(tmp.Join("missing.txt"))
+	require.Error(t, err)
+}
@@ -0,0 +1,85 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// artifactsHashes returns the SHA-256 of the files in the build path named
+// after the project (e.g. "sketch.ino.elf", "sketch.ino.hex"), sorted by name.
+func artifactsHashes(buildPath *paths.Path, projectName string) ([]*rpc.ArtifactHash, error) {
+	buildFiles, err := buildPath.ReadDir()
+	if err != nil {
+		return nil, &arduino.PermissionDeniedError{Message: tr("Error reading build directory"), Cause: err}
+	}
+	buildFiles.FilterPrefix(projectName)
+	buildFiles.FilterOutDirs()
+	buildFiles.Sort()
+	res := []*rpc.ArtifactHash{}
+	for _, buildFile := range buildFiles {
+		hash, err := fileSHA256(buildFile)
+		if err != nil {
+			return nil, &arduino.PermissionDeniedError{Message: tr("Error reading build artifact %s", buildFile), Cause: err}
+		}
+		res = append(res, &rpc.ArtifactHash{Name: buildFile.Base(), Sha256: hash})
+	}
+	return res, nil
+}
+
+func fileSHA256(file *paths.Path) (string, error) {
+	f, err := file.Open()
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// checkExpectedHashes returns a BuildHashMismatchError if one of the expected
+// artifacts is missing or has a different SHA-256. The hashes are compared
+// case-insensitively.
+func checkExpectedHashes(artifacts []*rpc.ArtifactHash, expected map[string]string) error {
+	actual := map[string]string{}
+	for _, artifact := range artifacts {
+		actual[artifact.GetName()] = artifact.GetSha256()
+	}
+	names := []string{}
+	for name := range expected {
+		names = append(names, name)
+	}
+	// Report always the same error if more artifacts don't match
+	sort.Strings(names)
+	for _, name := range names {
+		hash, ok := actual[name]
+		if !ok || !strings.EqualFold(hash, strings.TrimSpace(expected[name])) {
+			return &arduino.BuildHashMismatchError{Artifact: name, Expected: expected[name], Actual: hash}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArtifactsHashes(t *testing.T) {
+	buildPath, err := paths.MkTempDir("", "test_artifacts_hashes")
+	require.NoError(t, err)
+	defer buildPath.RemoveAll()
+
+	require.NoError(t, buildPath.Join("Blink.ino.hex").WriteFile([]byte("hex")))
+	require.NoError(t, buildPath.Join("Blink.ino.elf").WriteFile([]byte("elf")))
+	require.NoError(t, buildPath.Join("Blink.ino.cpp.dir").MkdirAll())
+	require.NoError(t, buildPath.Join("build.options.json").WriteFile([]byte("{}")))
+
+	artifacts, err := artifactsHashes(buildPath, "Blink.ino")
+	require.NoError(t, err)
+	require.Len(t, artifacts, 2)
+	require.Equal(t, "Blink.ino.elf", artifacts[0].GetName())
+	require.Equal(t, "780d84b20d7ae7e6292919399348bdbf96025270136198083fc8a4da398b5ca9", artifacts[0].GetSha256())
+	require.Equal(t, "Blink.ino.hex", artifacts[1].GetName())
+	require.Equal(t, "128df13c1e54ffaaafcc9d07ec7427d61f764214e6ae0321de23c94d261d0860", artifacts[1].GetSha256())
+}
+
+func TestCheckExpectedHashes(t *testing.T) {
+	artifacts := []*rpc.ArtifactHash{
+		{Name: "Blink.ino.elf", Sha256: "aa11"},
+		{Name: "Blink.ino.hex", Sha256: "bb22"},
+	}
+	require.NoError(t, checkExpectedHashes(artifacts, nil))
+	require.NoError(t, checkExpectedHashes(artifacts, map[string]string{"Blink.ino.hex": "BB22"}))
+
+	err := checkExpectedHashes(artifacts, map[string]string{"Blink.ino.hex": "bb22", "Blink.ino.elf": "cc33"})
+	var mismatch *arduino.BuildHashMismatchError
+	require.ErrorAs(t, err, &mismatch)
+	require.Equal(t, "Blink.ino.elf", mismatch.Artifact)
+	require.Equal(t, "aa11", mismatch.Actual)
+
+	err = checkExpectedHashes(artifacts, map[string]string{"Blink.ino.bin": ""})
+	require.ErrorAs(t, err, &mismatch)
+	require.Equal(t, "Blink.ino.bin", mismatch.Artifact)
+	require.Equal(t, "", mismatch.Actual)
+}
@@ -0,0 +1,48 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// Preprocess runs only the preprocessing stage of the build and returns the
+// generated sketch source
+func Preprocess(ctx context.Context, req *rpc.PreprocessRequest) (*rpc.PreprocessResponse, error) {
+	source := &bytes.Buffer{}
+	compileResp, err := Compile(ctx, &rpc.CompileRequest{
+		Instance:        req.GetInstance(),
+		Fqbn:            req.GetFqbn(),
+		SketchPath:      req.GetSketchPath(),
+		BuildPath:       req.GetBuildPath(),
+		BuildProperties: req.GetBuildProperties(),
+		Libraries:       req.GetLibraries(),
+		Library:         req.GetLibrary(),
+		SourceOverride:  req.GetSourceOverride(),
+		Preprocess:      true,
+	}, source, ioutil.Discard, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.PreprocessResponse{
+		Source:    source.String(),
+		BuildPath: compileResp.GetBuildPath(),
+	}, nil
+}
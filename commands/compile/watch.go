@@ -0,0 +1,190 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"context"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/globals"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/fsnotify/fsnotify"
+	"github.com/sirupsen/logrus"
+)
+
+// defaultWatchDebounce is the time waited for other changes to the sketch
+// before starting a new compile
+const defaultWatchDebounce = 500 * time.Millisecond
+
+// Watch compiles the sketch and compiles it again every time a file of the
+// sketch changes, until the context is canceled. The output of each compile is
+// sent to outStream and errStream, and the beginning and the result of each
+// compile are sent to eventCB.
+func Watch(ctx context.Context, req *rpc.CompileWatchRequest, outStream, errStream io.Writer, progressCB rpc.TaskProgressCB, eventCB func(*rpc.CompileWatchResponse)) error {
+	compileReq := req.GetCompileRequest()
+	if compileReq.GetSketchPath() == "" {
+		return &arduino.MissingSketchPathError{}
+	}
+	if req.GetDebounceMs() < 0 {
+		return &arduino.InvalidArgumentError{Message: tr("The debounce time must not be negative")}
+	}
+	debounce := defaultWatchDebounce
+	if req.GetDebounceMs() > 0 {
+		debounce = time.Duration(req.GetDebounceMs()) * time.Millisecond
+	}
+	sk, err := sketch.New(paths.New(compileReq.GetSketchPath()))
+	if err != nil {
+		return &arduino.CantOpenSketchError{Cause: err}
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return &arduino.UnavailableError{Message: tr("Cannot watch the sketch for changes"), Cause: err}
+	}
+	defer watcher.Close()
+	filter := &sketchChangesFilter{sketch: sk}
+	if compileReq.GetBuildPath() != "" {
+		filter.buildPath = paths.New(compileReq.GetBuildPath()).Canonical()
+	}
+	if err := filter.watchDir(watcher, sk.FullPath); err != nil {
+		return &arduino.UnavailableError{Message: tr("Cannot watch the sketch for changes"), Cause: err}
+	}
+
+	runCompile := func(changedFiles []string) {
+		eventCB(&rpc.CompileWatchResponse{CompileStarted: true, ChangedFiles: changedFiles})
+		resp, err := Compile(ctx, compileReq, outStream, errStream, progressCB, false)
+		res := &rpc.CompileWatchResponse{CompileResponse: resp, CompileFinished: true}
+		if err != nil {
+			res.Error = err.Error()
+		}
+		eventCB(res)
+	}
+	runCompile(nil)
+
+	// Editors usually generate a burst of events when saving a file,
+	// compile again only once they settle down
+	changes := map[string]bool{}
+	var recompile <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			changed := paths.New(event.Name)
+			if event.Op&fsnotify.Create != 0 && changed.IsDir() {
+				if err := filter.watchDir(watcher, changed); err != nil {
+					logrus.Errorf("Error watching %s: %v", changed, err)
+				}
+				continue
+			}
+			if !filter.isSketchFile(changed) {
+				continue
+			}
+			changes[event.Name] = true
+			recompile = time.After(debounce)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			logrus.Errorf("Error watching sketch: %v", err)
+		case <-recompile:
+			recompile = nil
+			changedFiles := []string{}
+			for file := range changes {
+				changedFiles = append(changedFiles, file)
+			}
+			sort.Strings(changedFiles)
+			changes = map[string]bool{}
+			runCompile(changedFiles)
+		}
+	}
+}
+
+// sketchChangesFilter selects the file changes that require a new compile
+type sketchChangesFilter struct {
+	sketch    *sketch.Sketch
+	buildPath *paths.Path
+}
+
+// isExcluded returns true if the given path is in a folder written by the
+// compile itself: the build path, if inside the sketch, and the build folder
+// where the binaries are exported
+func (f *sketchChangesFilter) isExcluded(path *paths.Path) bool {
+	if inside, _ := path.IsInsideDir(f.sketch.FullPath.Join("build")); inside {
+		return true
+	}
+	if f.buildPath != nil {
+		if inside, _ := path.IsInsideDir(f.buildPath); inside || path.EquivalentTo(f.buildPath) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSketchFile returns true if the given path is one of the files considered
+// by the builder as part of the sketch
+func (f *sketchChangesFilter) isSketchFile(path *paths.Path) bool {
+	if f.isExcluded(path) {
+		return false
+	}
+	if path.EquivalentTo(f.sketch.FullPath.Join("sketch.yaml")) || path.EquivalentTo(f.sketch.FullPath.Join("sketch.yml")) {
+		return true
+	}
+	if inside, _ := path.IsInsideDir(f.sketch.FullPath); !inside || strings.HasPrefix(path.Base(), ".") {
+		return false
+	}
+	ext := path.Ext()
+	if _, ok := globals.MainFileValidExtensions[ext]; ok {
+		return true
+	}
+	_, ok := globals.AdditionalFileValidExtensions[ext]
+	return ok
+}
+
+// watchDir adds the given directory, and all its subdirectories, to the
+// watcher, skipping the hidden and the excluded ones
+func (f *sketchChangesFilter) watchDir(watcher *fsnotify.Watcher, dir *paths.Path) error {
+	if f.isExcluded(dir) || (strings.HasPrefix(dir.Base(), ".") && !dir.EquivalentTo(f.sketch.FullPath)) {
+		return nil
+	}
+	if err := watcher.Add(dir.String()); err != nil {
+		return err
+	}
+	subDirs, err := dir.ReadDir()
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	subDirs.FilterDirs()
+	for _, subDir := range subDirs {
+		if err := f.watchDir(watcher, subDir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSketchChangesFilter(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "watch_test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	sketchPath := tmp.Join("MySketch")
+	require.NoError(t, sketchPath.MkdirAll())
+	require.NoError(t, sketchPath.Join("MySketch.ino").WriteFile([]byte("void setup() {}\nvoid loop() {}\n")))
+	sk, err := sketch.New(sketchPath)
+	require.NoError(t, err)
+
+	filter := &sketchChangesFilter{sketch: sk, buildPath: sk.FullPath.Join("out")}
+	require.True(t, filter.isSketchFile(sk.FullPath.Join("MySketch.ino")))
+	require.True(t, filter.isSketchFile(sk.FullPath.Join("other.cpp")))
+	require.True(t, filter.isSketchFile(sk.FullPath.Join("src", "lib", "lib.h")))
+	require.True(t, filter.isSketchFile(sk.FullPath.Join("sketch.yaml")))
+	require.False(t, filter.isSketchFile(sk.FullPath.Join("notes.txt")))
+	require.False(t, filter.isSketchFile(sk.FullPath.Join(".MySketch.ino.swp")))
+	require.False(t, filter.isSketchFile(sk.FullPath.Join(".#MySketch.ino")))
+	// The files written by the compile itself are ignored
+	require.False(t, filter.isSketchFile(sk.FullPath.Join("out", "sketch", "MySketch.ino.cpp")))
+	require.False(t, filter.isSketchFile(sk.FullPath.Join("build", "arduino.avr.uno", "MySketch.ino.hex")))
+	require.False(t, filter.isSketchFile(tmp.Join("Other.ino")))
+}
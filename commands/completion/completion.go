@@ -0,0 +1,98 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package completion
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/commands/board"
+	"github.com/arduino/arduino-cli/commands/lib"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// CompletionData returns the candidates for the dynamic shell completion: the
+// FQBNs of the installed boards, the addresses of the attached ports and the
+// names of the installed libraries. If the ports can't be listed only the other
+// candidates are returned.
+func CompletionData(ctx context.Context, req *rpc.CompletionDataRequest) (*rpc.CompletionDataResponse, error) {
+	if commands.GetPackageManager(req.GetInstance().GetId()) == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	prefix := req.GetPrefix()
+	res := &rpc.CompletionDataResponse{
+		Fqbns:     []*rpc.CompletionCandidate{},
+		Ports:     []*rpc.CompletionCandidate{},
+		Libraries: []*rpc.CompletionCandidate{},
+	}
+
+	boards, err := board.ListAll(ctx, &rpc.BoardListAllRequest{Instance: req.GetInstance()})
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range boards.GetBoards() {
+		res.Fqbns = appendCandidate(res.Fqbns, prefix, b.GetFqbn(), b.GetName())
+	}
+
+	// The discovery of the ports may fail (for example if a discovery tool is not
+	// installed), the other candidates are returned anyway
+	ports, err := board.List(&rpc.BoardListRequest{Instance: req.GetInstance()})
+	if err != nil {
+		logrus.WithError(err).Warn("Error listing the ports for the completion")
+	}
+	for _, p := range ports {
+		description := p.GetPort().GetProtocolLabel()
+		if boards := p.GetMatchingBoards(); len(boards) > 0 {
+			description = boards[0].GetName()
+		}
+		res.Ports = appendCandidate(res.Ports, prefix, p.GetPort().GetAddress(), description)
+	}
+
+	libs, err := lib.LibraryList(ctx, &rpc.LibraryListRequest{Instance: req.GetInstance(), All: true})
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range libs.GetInstalledLibraries() {
+		res.Libraries = appendCandidate(res.Libraries, prefix, l.GetLibrary().GetName(), l.GetLibrary().GetSentence())
+	}
+
+	sortCandidates(res.Fqbns)
+	sortCandidates(res.Ports)
+	sortCandidates(res.Libraries)
+	return res, nil
+}
+
+// appendCandidate appends the candidate value to candidates, unless it doesn't
+// match prefix or it's already present
+func appendCandidate(candidates []*rpc.CompletionCandidate, prefix, value, description string) []*rpc.CompletionCandidate {
+	if value == "" || !strings.HasPrefix(value, prefix) {
+		return candidates
+	}
+	for _, c := range candidates {
+		if c.Value == value {
+			return candidates
+		}
+	}
+	return append(candidates, &rpc.CompletionCandidate{Value: value, Description: description})
+}
+
+func sortCandidates(candidates []*rpc.CompletionCandidate) {
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].Value < candidates[j].Value })
+}
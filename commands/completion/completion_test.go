@@ -0,0 +1,41 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package completion
+
+import (
+	"testing"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppendCandidate(t *testing.T) {
+	var candidates []*rpc.CompletionCandidate
+	candidates = appendCandidate(candidates, "arduino:", "arduino:avr:uno", "Arduino Uno")
+	candidates = appendCandidate(candidates, "arduino:", "esp8266:esp8266:generic", "Generic ESP8266 Module")
+	candidates = appendCandidate(candidates, "arduino:", "arduino:avr:uno", "Arduino Uno")
+	candidates = appendCandidate(candidates, "arduino:", "", "")
+	candidates = appendCandidate(candidates, "arduino:", "arduino:avr:mega", "Arduino Mega or Mega 2560")
+	sortCandidates(candidates)
+
+	require.Len(t, candidates, 2)
+	require.Equal(t, "arduino:avr:mega", candidates[0].Value)
+	require.Equal(t, "arduino:avr:uno", candidates[1].Value)
+	require.Equal(t, "Arduino Uno", candidates[1].Description)
+
+	// All the candidates match an empty prefix
+	require.Len(t, appendCandidate(nil, "", "Servo", "Allows Arduino boards to control servo motors."), 1)
+}
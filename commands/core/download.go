@@ -18,12 +18,16 @@ package core
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync"
 
 	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
 	"github.com/arduino/arduino-cli/arduino/httpclient"
+	"github.com/arduino/arduino-cli/arduino/resources"
 	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/configuration"
 	"github.com/arduino/arduino-cli/i18n"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 )
@@ -56,10 +60,8 @@ func PlatformDownload(ctx context.Context, req *rpc.PlatformDownloadRequest, dow
 		return nil, err
 	}
 
-	for _, tool := range tools {
-		if err := downloadTool(pm, tool, downloadCB); err != nil {
-			return nil, err
-		}
+	if err := downloadTools(pm, tools, downloadCB); err != nil {
+		return nil, err
 	}
 
 	return &rpc.PlatformDownloadResponse{}, nil
@@ -88,3 +90,144 @@ func downloadTool(pm *packagemanager.PackageManager, tool *cores.ToolRelease, do
 
 	return nil
 }
+
+// downloadTools downloads the given tools using a pool of parallel workers (the
+// size of the pool is set by the `network.parallel_downloads` setting). When the
+// downloads run in parallel their progress is aggregated into a single download.
+func downloadTools(pm *packagemanager.PackageManager, tools []*cores.ToolRelease, downloadCB rpc.DownloadProgressCB) error {
+	workers := configuration.NetworkParallelDownloads(configuration.Settings)
+	if workers > len(tools) {
+		workers = len(tools)
+	}
+	if workers <= 1 {
+		for _, tool := range tools {
+			if err := downloadTool(pm, tool, downloadCB); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	progress := newAggregatedDownloadProgress(tr("%d tools", len(tools)), downloadCB)
+	for _, tool := range tools {
+		if resource := tool.GetCompatibleFlavour(); resource != nil {
+			progress.totalSize += resource.Size
+		}
+	}
+	progress.start()
+
+	queue := make(chan *cores.ToolRelease)
+	var errMux sync.Mutex
+	var firstErr error
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for tool := range queue {
+				if err := downloadTool(pm, tool, progress.fileCB(tool)); err != nil {
+					errMux.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					errMux.Unlock()
+				}
+			}
+		}()
+	}
+	for _, tool := range tools {
+		queue <- tool
+	}
+	close(queue)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	progress.complete()
+	return nil
+}
+
+// verifyDownloadedArchive checks the integrity (size and checksum) of a downloaded
+// archive. A corrupted archive is removed, so it will be downloaded again on the next
+// attempt, unless keepOnFailure is set: in this case it's kept for inspection.
+func verifyDownloadedArchive(pm *packagemanager.PackageManager, resource *resources.DownloadResource, label string, keepOnFailure bool) error {
+	ok, err := resource.TestLocalArchiveIntegrity(pm.DownloadDir)
+	if err == nil && ok {
+		return nil
+	}
+	if err == nil {
+		err = errors.New(tr("archive not found"))
+	}
+	archivePath, pathErr := resource.ArchivePath(pm.DownloadDir)
+	if pathErr != nil {
+		return &arduino.FailedDownloadError{Message: tr("Error verifying the download of %s", label), Cause: err}
+	}
+	if keepOnFailure {
+		err = fmt.Errorf(tr("%[1]s, the corrupted download has been kept in %[2]s"), err, archivePath)
+	} else if removeErr := archivePath.Remove(); removeErr == nil {
+		err = fmt.Errorf(tr("%[1]s, the corrupted download has been removed"), err)
+	}
+	return &arduino.FailedDownloadError{Message: tr("Error verifying the download of %s", label), Cause: err}
+}
+
+// aggregatedDownloadProgress merges the progress of many parallel downloads
+// into the progress of a single download
+type aggregatedDownloadProgress struct {
+	mux        sync.Mutex
+	label      string
+	totalSize  int64
+	downloaded map[string]int64
+	downloadCB rpc.DownloadProgressCB
+}
+
+func newAggregatedDownloadProgress(label string, downloadCB rpc.DownloadProgressCB) *aggregatedDownloadProgress {
+	return &aggregatedDownloadProgress{
+		label:      label,
+		downloaded: map[string]int64{},
+		downloadCB: downloadCB,
+	}
+}
+
+func (p *aggregatedDownloadProgress) start() {
+	p.downloadCB(&rpc.DownloadProgress{File: p.label, TotalSize: p.totalSize})
+}
+
+func (p *aggregatedDownloadProgress) complete() {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	p.downloadCB(&rpc.DownloadProgress{Downloaded: p.totalSize, Completed: true})
+}
+
+// fileCB returns the DownloadProgressCB for the download of a single tool
+func (p *aggregatedDownloadProgress) fileCB(tool *cores.ToolRelease) rpc.DownloadProgressCB {
+	file := tool.String()
+	return func(curr *rpc.DownloadProgress) {
+		p.mux.Lock()
+		defer p.mux.Unlock()
+		if curr.GetRetryAttempt() != 0 {
+			// Forward the retry and start again the aggregated progress
+			p.downloadCB(curr)
+			p.downloadCB(&rpc.DownloadProgress{File: p.label, TotalSize: p.totalSize})
+			return
+		}
+		if curr.GetDownloaded() != 0 {
+			p.downloaded[file] = curr.GetDownloaded()
+		}
+		if curr.GetCompleted() && curr.GetDownloaded() == 0 {
+			// The file is already downloaded: the only message sent is the
+			// completion message
+			if resource := tool.GetCompatibleFlavour(); resource != nil {
+				p.downloaded[file] = resource.Size
+			}
+		}
+		downloaded := int64(0)
+		for _, d := range p.downloaded {
+			downloaded += d
+		}
+		if downloaded == 0 {
+			return
+		}
+		p.downloadCB(&rpc.DownloadProgress{Downloaded: downloaded})
+	}
+}
@@ -0,0 +1,52 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/stretchr/testify/require"
+	semver "go.bug.st/relaxed-semver"
+)
+
+func TestAggregatedDownloadProgress(t *testing.T) {
+	res := []*rpc.DownloadProgress{}
+	progress := newAggregatedDownloadProgress("2 tools", func(curr *rpc.DownloadProgress) { res = append(res, curr) })
+	progress.totalSize = 300
+	progress.start()
+
+	newTool := func(name string) *cores.ToolRelease {
+		return &cores.ToolRelease{Version: semver.ParseRelaxed("1.0.0"), Tool: &cores.Tool{Name: name, Package: &cores.Package{Name: "test"}}}
+	}
+	cb1 := progress.fileCB(newTool("tool1"))
+	cb2 := progress.fileCB(newTool("tool2"))
+	cb1(&rpc.DownloadProgress{File: "tool1", TotalSize: 100})
+	cb2(&rpc.DownloadProgress{File: "tool2", TotalSize: 200})
+	cb1(&rpc.DownloadProgress{Downloaded: 50})
+	cb2(&rpc.DownloadProgress{Downloaded: 150})
+	cb1(&rpc.DownloadProgress{Downloaded: 100, Completed: true})
+	progress.complete()
+
+	require.Equal(t, []*rpc.DownloadProgress{
+		{File: "2 tools", TotalSize: 300},
+		{Downloaded: 50},
+		{Downloaded: 200},
+		{Downloaded: 250},
+		{Downloaded: 300, Completed: true},
+	}, res)
+}
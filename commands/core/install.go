@@ -17,17 +17,21 @@ package core
 
 import (
 	"context"
+	"time"
 
 	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
 	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/metrics"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 )
 
 // PlatformInstall FIXMEDOC
 func PlatformInstall(ctx context.Context, req *rpc.PlatformInstallRequest,
-	downloadCB rpc.DownloadProgressCB, taskCB rpc.TaskProgressCB) (*rpc.PlatformInstallResponse, error) {
+	downloadCB rpc.DownloadProgressCB, taskCB rpc.TaskProgressCB) (_ *rpc.PlatformInstallResponse, e error) {
+	start := time.Now()
+	defer func() { metrics.ObserveCommandDuration("core_install", start, e) }()
 
 	pm := commands.GetPackageManager(req.GetInstance().GetId())
 	if pm == nil {
@@ -49,22 +53,23 @@ func PlatformInstall(ctx context.Context, req *rpc.PlatformInstallRequest,
 		return nil, &arduino.PlatformNotFoundError{Platform: ref.String(), Cause: err}
 	}
 
-	err = installPlatform(pm, platform, tools, downloadCB, taskCB, req.GetSkipPostInstall())
+	err = installPlatform(ctx, pm, platform, tools, downloadCB, taskCB, req.GetSkipPostInstall(), req.GetNoCleanupOnFailure())
 	if err != nil {
 		return nil, err
 	}
+	postInstallSkipped := req.GetSkipPostInstall() && packagemanager.GetPostInstallScript(platform) != nil
 
 	if err := commands.Init(&rpc.InitRequest{Instance: req.Instance}, nil); err != nil {
 		return nil, err
 	}
 
-	return &rpc.PlatformInstallResponse{}, nil
+	return &rpc.PlatformInstallResponse{PostInstallSkipped: postInstallSkipped}, nil
 }
 
-func installPlatform(pm *packagemanager.PackageManager,
+func installPlatform(ctx context.Context, pm *packagemanager.PackageManager,
 	platformRelease *cores.PlatformRelease, requiredTools []*cores.ToolRelease,
 	downloadCB rpc.DownloadProgressCB, taskCB rpc.TaskProgressCB,
-	skipPostInstall bool) error {
+	skipPostInstall bool, noCleanupOnFailure bool) error {
 	log := pm.Log.WithField("platform", platformRelease)
 
 	// Prerequisite checks before install
@@ -85,18 +90,35 @@ func installPlatform(pm *packagemanager.PackageManager,
 
 	// Package download
 	taskCB(&rpc.TaskProgress{Name: tr("Downloading packages")})
+	if err := checkInstallCanceled(ctx); err != nil {
+		return err
+	}
+	if err := downloadTools(pm, toolsToInstall, downloadCB); err != nil {
+		return err
+	}
+	if err := checkInstallCanceled(ctx); err != nil {
+		return err
+	}
+	if err := downloadPlatform(pm, platformRelease, downloadCB); err != nil {
+		return err
+	}
+	taskCB(&rpc.TaskProgress{Completed: true})
+
+	// Verify the downloads
 	for _, tool := range toolsToInstall {
-		if err := downloadTool(pm, tool, downloadCB); err != nil {
+		if err := verifyDownloadedArchive(pm, tool.GetCompatibleFlavour(), tool.String(), noCleanupOnFailure); err != nil {
 			return err
 		}
 	}
-	if err := downloadPlatform(pm, platformRelease, downloadCB); err != nil {
+	if err := verifyDownloadedArchive(pm, platformRelease.Resource, platformRelease.String(), noCleanupOnFailure); err != nil {
 		return err
 	}
-	taskCB(&rpc.TaskProgress{Completed: true})
 
 	// Install tools first
 	for _, tool := range toolsToInstall {
+		if err := checkInstallCanceled(ctx); err != nil {
+			return err
+		}
 		if err := commands.InstallToolRelease(pm, tool, taskCB); err != nil {
 			return err
 		}
@@ -128,15 +150,18 @@ func installPlatform(pm *packagemanager.PackageManager,
 		}
 	}
 
-	// Install
+	// Install, the install can't be canceled after this point
+	if err := checkInstallCanceled(ctx); err != nil {
+		return err
+	}
 	if err := pm.InstallPlatform(platformRelease); err != nil {
 		log.WithError(err).Error("Cannot install platform")
 		return &arduino.FailedInstallError{Message: tr("Cannot install platform"), Cause: err}
 	}
 
-	// If upgrading remove previous release
+	// If upgrading move the previous release aside, to allow a rollback
 	if installed != nil {
-		uninstallErr := pm.UninstallPlatform(installed)
+		uninstallErr := pm.KeepPlatformForRollback(installed)
 
 		// In case of error try to rollback
 		if uninstallErr != nil {
@@ -168,6 +193,9 @@ func installPlatform(pm *packagemanager.PackageManager,
 		if err := pm.RunPostInstallScript(platformRelease); err != nil {
 			taskCB(&rpc.TaskProgress{Message: tr("WARNING cannot configure platform: %s", err)})
 		}
+	} else if postInstall := packagemanager.GetPostInstallScript(platformRelease); postInstall != nil {
+		log.WithField("script", postInstall).Info("Skipping post_install script")
+		taskCB(&rpc.TaskProgress{Message: tr("Skipping the post-install script %s.", postInstall)})
 	} else {
 		log.Info("Skipping platform configuration.")
 		taskCB(&rpc.TaskProgress{Message: tr("Skipping platform configuration.")})
@@ -177,3 +205,13 @@ func installPlatform(pm *packagemanager.PackageManager,
 	taskCB(&rpc.TaskProgress{Message: tr("Platform %s installed", platformRelease), Completed: true})
 	return nil
 }
+
+// checkInstallCanceled returns an error if the install has been canceled by
+// the caller. It's checked between the install steps, so a canceled install
+// stops before the next download or the next tool install.
+func checkInstallCanceled(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return &arduino.CanceledError{Message: tr("Install canceled"), Cause: err}
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package core
+
+import (
+	"context"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/commands"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// PlatformRollback restores the release of a platform replaced by the last
+// install or upgrade. The replaced release is kept in turn, so rolling back
+// twice goes back to where it started.
+func PlatformRollback(ctx context.Context, req *rpc.PlatformRollbackRequest,
+	downloadCB rpc.DownloadProgressCB, taskCB rpc.TaskProgressCB) (*rpc.PlatformRollbackResponse, error) {
+
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+
+	ref := &packagemanager.PlatformReference{
+		Package:              req.PlatformPackage,
+		PlatformArchitecture: req.Architecture,
+	}
+	platform := pm.FindPlatform(ref)
+	if platform == nil {
+		return nil, &arduino.PlatformNotFoundError{Platform: ref.String()}
+	}
+	installed := pm.GetInstalledPlatformRelease(platform)
+	if installed == nil {
+		return nil, &arduino.PlatformNotFoundError{Platform: ref.String()}
+	}
+	version := pm.GetRollbackPlatformVersion(platform)
+	if version == nil {
+		return nil, &arduino.NotFoundError{Message: tr("No previous version of platform %s to roll back to", ref)}
+	}
+
+	ref.PlatformVersion = version
+	previous, tools, err := pm.FindPlatformReleaseDependencies(ref)
+	if err != nil {
+		return nil, &arduino.NotFoundError{Message: tr("Can't find dependencies for platform %s", ref), Cause: err}
+	}
+	if err := rollbackPlatform(ctx, pm, installed, previous, tools, downloadCB, taskCB); err != nil {
+		return nil, err
+	}
+
+	if err := commands.Init(&rpc.InitRequest{Instance: req.Instance}, nil); err != nil {
+		return nil, err
+	}
+
+	return &rpc.PlatformRollbackResponse{
+		RestoredVersion: previous.Version.String(),
+		ReplacedVersion: installed.Version.String(),
+	}, nil
+}
+
+func rollbackPlatform(ctx context.Context, pm *packagemanager.PackageManager,
+	installed, previous *cores.PlatformRelease, requiredTools []*cores.ToolRelease,
+	downloadCB rpc.DownloadProgressCB, taskCB rpc.TaskProgressCB) error {
+	log := pm.Log.WithField("platform", previous)
+
+	// The tools required only by the previous release may have been
+	// removed by the upgrade, download them again
+	toolsToInstall := []*cores.ToolRelease{}
+	for _, tool := range requiredTools {
+		if !tool.IsInstalled() {
+			toolsToInstall = append(toolsToInstall, tool)
+		}
+	}
+	if len(toolsToInstall) > 0 {
+		taskCB(&rpc.TaskProgress{Name: tr("Downloading packages")})
+		if err := checkInstallCanceled(ctx); err != nil {
+			return err
+		}
+		if err := downloadTools(pm, toolsToInstall, downloadCB); err != nil {
+			return err
+		}
+		taskCB(&rpc.TaskProgress{Completed: true})
+	}
+	for _, tool := range toolsToInstall {
+		if err := verifyDownloadedArchive(pm, tool.GetCompatibleFlavour(), tool.String(), false); err != nil {
+			return err
+		}
+	}
+	for _, tool := range toolsToInstall {
+		if err := checkInstallCanceled(ctx); err != nil {
+			return err
+		}
+		if err := commands.InstallToolRelease(pm, tool, taskCB); err != nil {
+			return err
+		}
+	}
+
+	// Get the tools used by the currently installed release, to remove the
+	// ones not needed anymore
+	_, installedTools, err := pm.FindPlatformReleaseDependencies(&packagemanager.PlatformReference{
+		Package:              installed.Platform.Package.Name,
+		PlatformArchitecture: installed.Platform.Architecture,
+		PlatformVersion:      installed.Version,
+	})
+	if err != nil {
+		return &arduino.NotFoundError{Message: tr("Can't find dependencies for platform %s", installed), Cause: err}
+	}
+
+	// Swap the releases, the rollback can't be canceled after this point
+	if err := checkInstallCanceled(ctx); err != nil {
+		return err
+	}
+	log.Info("Rolling back platform " + installed.String())
+	taskCB(&rpc.TaskProgress{Name: tr("Rolling back platform %[1]s to %[2]s", installed, previous)})
+	if err := pm.RestorePlatformFromRollback(previous); err != nil {
+		log.WithError(err).Error("Cannot restore platform")
+		return &arduino.FailedInstallError{Message: tr("Cannot restore platform %s", previous), Cause: err}
+	}
+	if err := pm.KeepPlatformForRollback(installed); err != nil {
+		log.WithError(err).Error("Error rolling back platform.")
+
+		// Put the previous release back aside, leaving the installed one in place
+		if err := pm.KeepPlatformForRollback(previous); err != nil {
+			log.WithError(err).Error("Error reverting changes.")
+			taskCB(&rpc.TaskProgress{Message: tr("Error reverting changes: %s", err)})
+		}
+
+		return &arduino.FailedInstallError{Message: tr("Cannot roll back platform %s", installed), Cause: err}
+	}
+
+	for _, tool := range installedTools {
+		if !pm.IsToolRequired(tool) {
+			uninstallToolRelease(pm, tool, taskCB)
+		}
+	}
+
+	log.Info("Platform rolled back")
+	taskCB(&rpc.TaskProgress{Message: tr("Platform %[1]s rolled back to %[2]s", installed.Platform, previous.Version), Completed: true})
+	return nil
+}
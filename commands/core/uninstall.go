@@ -56,6 +56,9 @@ func PlatformUninstall(ctx context.Context, req *rpc.PlatformUninstallRequest, t
 	if err := uninstallPlatformRelease(pm, platform, taskCB); err != nil {
 		return nil, err
 	}
+	if err := pm.DiscardPlatformRollback(platform.Platform); err != nil {
+		return nil, &arduino.FailedUninstallError{Message: tr("Error uninstalling platform %s", platform), Cause: err}
+	}
 
 	for _, tool := range tools {
 		if !pm.IsToolRequired(tool) {
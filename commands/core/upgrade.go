@@ -21,6 +21,7 @@ import (
 	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
 	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/configuration"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 )
 
@@ -38,18 +39,25 @@ func PlatformUpgrade(ctx context.Context, req *rpc.PlatformUpgradeRequest,
 		Package:              req.PlatformPackage,
 		PlatformArchitecture: req.Architecture,
 	}
-	if err := upgradePlatform(pm, ref, downloadCB, taskCB, req.GetSkipPostInstall()); err != nil {
+	if err := upgradePlatform(ctx, pm, ref, downloadCB, taskCB, req.GetSkipPostInstall()); err != nil {
 		return nil, err
 	}
+	postInstallSkipped := false
+	if req.GetSkipPostInstall() {
+		// upgradePlatform sets the version of the upgraded platform in the reference
+		if upgraded := pm.FindPlatformRelease(ref); upgraded != nil {
+			postInstallSkipped = packagemanager.GetPostInstallScript(upgraded) != nil
+		}
+	}
 
 	if err := commands.Init(&rpc.InitRequest{Instance: req.Instance}, nil); err != nil {
 		return nil, err
 	}
 
-	return &rpc.PlatformUpgradeResponse{}, nil
+	return &rpc.PlatformUpgradeResponse{PostInstallSkipped: postInstallSkipped}, nil
 }
 
-func upgradePlatform(pm *packagemanager.PackageManager, platformRef *packagemanager.PlatformReference,
+func upgradePlatform(ctx context.Context, pm *packagemanager.PackageManager, platformRef *packagemanager.PlatformReference,
 	downloadCB rpc.DownloadProgressCB, taskCB rpc.TaskProgressCB, skipPostInstall bool) error {
 	if platformRef.PlatformVersion != nil {
 		return &arduino.InvalidArgumentError{Message: tr("Upgrade doesn't accept parameters with version")}
@@ -64,17 +72,36 @@ func upgradePlatform(pm *packagemanager.PackageManager, platformRef *packagemana
 	if installed == nil {
 		return &arduino.PlatformNotFoundError{Platform: platformRef.String()}
 	}
-	latest := platform.GetLatestRelease()
-	if !latest.Version.GreaterThan(installed.Version) {
-		return &arduino.PlatformAlreadyAtTheLatestVersionError{}
+	target := platform.GetLatestRelease()
+	pinned, err := configuration.PinnedPlatformVersion(configuration.Settings, platformRef.Package, platformRef.PlatformArchitecture)
+	if err != nil {
+		return &arduino.InvalidArgumentError{Message: tr("Invalid pinned platforms configuration"), Cause: err}
+	}
+	if pinned != nil {
+		// A pinned platform is never upgraded past the pinned version
+		if !pinned.GreaterThan(installed.Version) {
+			return &arduino.PlatformPinnedError{Platform: platformRef.String(), Version: pinned.String()}
+		}
+		target = platform.FindReleaseWithVersion(pinned)
+		if target == nil {
+			ref := &packagemanager.PlatformReference{
+				Package:              platformRef.Package,
+				PlatformArchitecture: platformRef.PlatformArchitecture,
+				PlatformVersion:      pinned,
+			}
+			return &arduino.PlatformNotFoundError{Platform: ref.String()}
+		}
+	}
+	if !target.Version.GreaterThan(installed.Version) {
+		return &arduino.PlatformAlreadyAtTheLatestVersionError{Platform: platformRef.String()}
 	}
-	platformRef.PlatformVersion = latest.Version
+	platformRef.PlatformVersion = target.Version
 
 	platformRelease, tools, err := pm.FindPlatformReleaseDependencies(platformRef)
 	if err != nil {
 		return &arduino.PlatformNotFoundError{Platform: platformRef.String()}
 	}
-	if err := installPlatform(pm, platformRelease, tools, downloadCB, taskCB, skipPostInstall); err != nil {
+	if err := installPlatform(ctx, pm, platformRelease, tools, downloadCB, taskCB, skipPostInstall, false); err != nil {
 		return err
 	}
 
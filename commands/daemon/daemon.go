@@ -20,12 +20,14 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sync/atomic"
 
 	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/arduino-cli/arduino/utils"
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/commands/board"
 	"github.com/arduino/arduino-cli/commands/compile"
+	"github.com/arduino/arduino-cli/commands/completion"
 	"github.com/arduino/arduino-cli/commands/core"
 	"github.com/arduino/arduino-cli/commands/lib"
 	"github.com/arduino/arduino-cli/commands/monitor"
@@ -45,6 +47,10 @@ type ArduinoCoreServerImpl struct {
 	rpc.UnsafeArduinoCoreServiceServer
 
 	VersionString string
+
+	// Core cache counters, accumulated since daemon start
+	coreCacheHits   int64
+	coreCacheMisses int64
 }
 
 var tr = i18n.Tr
@@ -82,6 +88,18 @@ func (s *ArduinoCoreServerImpl) BoardListAll(ctx context.Context, req *rpc.Board
 	return resp, convertErrorToRPCStatus(err)
 }
 
+// ParseFQBN exposes to the gRPC interface the parsing of FQBNs
+func (s *ArduinoCoreServerImpl) ParseFQBN(ctx context.Context, req *rpc.ParseFQBNRequest) (*rpc.ParseFQBNResponse, error) {
+	resp, err := board.ParseFQBN(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
+// BoardIdentify exposes to the gRPC interface the board identification
+func (s *ArduinoCoreServerImpl) BoardIdentify(ctx context.Context, req *rpc.BoardIdentifyRequest) (*rpc.BoardIdentifyResponse, error) {
+	resp, err := board.Identify(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
 // BoardSearch exposes to the gRPC interface the board search command
 func (s *ArduinoCoreServerImpl) BoardSearch(ctx context.Context, req *rpc.BoardSearchRequest) (*rpc.BoardSearchResponse, error) {
 	resp, err := board.Search(ctx, req)
@@ -180,24 +198,24 @@ func (s *ArduinoCoreServerImpl) UpdateIndex(req *rpc.UpdateIndexRequest, stream
 
 // UpdateLibrariesIndex FIXMEDOC
 func (s *ArduinoCoreServerImpl) UpdateLibrariesIndex(req *rpc.UpdateLibrariesIndexRequest, stream rpc.ArduinoCoreService_UpdateLibrariesIndexServer) error {
-	err := commands.UpdateLibrariesIndex(stream.Context(), req,
+	resp, err := commands.UpdateLibrariesIndex(stream.Context(), req,
 		func(p *rpc.DownloadProgress) { stream.Send(&rpc.UpdateLibrariesIndexResponse{DownloadProgress: p}) },
 	)
 	if err != nil {
 		return convertErrorToRPCStatus(err)
 	}
-	return stream.Send(&rpc.UpdateLibrariesIndexResponse{})
+	return stream.Send(resp)
 }
 
 // UpdateCoreLibrariesIndex FIXMEDOC
 func (s *ArduinoCoreServerImpl) UpdateCoreLibrariesIndex(req *rpc.UpdateCoreLibrariesIndexRequest, stream rpc.ArduinoCoreService_UpdateCoreLibrariesIndexServer) error {
-	err := commands.UpdateCoreLibrariesIndex(stream.Context(), req,
+	resp, err := commands.UpdateCoreLibrariesIndex(stream.Context(), req,
 		func(p *rpc.DownloadProgress) { stream.Send(&rpc.UpdateCoreLibrariesIndexResponse{DownloadProgress: p}) },
 	)
 	if err != nil {
 		return convertErrorToRPCStatus(err)
 	}
-	return stream.Send(&rpc.UpdateCoreLibrariesIndexResponse{})
+	return stream.Send(resp)
 }
 
 // Outdated FIXMEDOC
@@ -247,9 +265,10 @@ func (s *ArduinoCoreServerImpl) Init(req *rpc.InitRequest, stream rpc.ArduinoCor
 	return convertErrorToRPCStatus(err)
 }
 
-// Version FIXMEDOC
+// Version returns the version of Arduino CLI and a summary of the environment in use
 func (s *ArduinoCoreServerImpl) Version(ctx context.Context, req *rpc.VersionRequest) (*rpc.VersionResponse, error) {
-	return &rpc.VersionResponse{Version: s.VersionString}, nil
+	resp, err := commands.Version(ctx, req, s.VersionString)
+	return resp, convertErrorToRPCStatus(err)
 }
 
 // NewSketch FIXMEDOC
@@ -273,11 +292,80 @@ func (s *ArduinoCoreServerImpl) Compile(req *rpc.CompileRequest, stream rpc.Ardu
 		func(p *rpc.TaskProgress) { stream.Send(&rpc.CompileResponse{Progress: p}) },
 		false) // Set debug to false
 	if err != nil {
+		// The diagnostics are useful especially when the compile fails
+		if len(resp.GetDiagnostics()) > 0 {
+			stream.Send(&rpc.CompileResponse{Diagnostics: resp.GetDiagnostics()})
+		}
 		return convertErrorToRPCStatus(err)
 	}
+	if usesCoreCache(req) {
+		if resp.GetUsedCachedCore() {
+			atomic.AddInt64(&s.coreCacheHits, 1)
+		} else {
+			atomic.AddInt64(&s.coreCacheMisses, 1)
+		}
+	}
 	return stream.Send(resp)
 }
 
+// usesCoreCache returns true if the compilation may use the cached core: the
+// others (for example a dry run) are not counted in the core cache stats.
+func usesCoreCache(req *rpc.CompileRequest) bool {
+	return !req.GetShowProperties() && !req.GetPreprocess() && !req.GetDryRun() && !req.GetCreateCompilationDatabaseOnly()
+}
+
+// CompileWatch compiles a sketch every time it changes
+func (s *ArduinoCoreServerImpl) CompileWatch(req *rpc.CompileWatchRequest, stream rpc.ArduinoCoreService_CompileWatchServer) error {
+	err := compile.Watch(
+		stream.Context(), req,
+		utils.FeedStreamTo(func(data []byte) {
+			stream.Send(&rpc.CompileWatchResponse{CompileResponse: &rpc.CompileResponse{OutStream: data}})
+		}),
+		utils.FeedStreamTo(func(data []byte) {
+			stream.Send(&rpc.CompileWatchResponse{CompileResponse: &rpc.CompileResponse{ErrStream: data}})
+		}),
+		func(p *rpc.TaskProgress) {
+			stream.Send(&rpc.CompileWatchResponse{CompileResponse: &rpc.CompileResponse{Progress: p}})
+		},
+		func(resp *rpc.CompileWatchResponse) { stream.Send(resp) })
+	return convertErrorToRPCStatus(err)
+}
+
+// Preprocess runs only the preprocessing stage of the build
+func (s *ArduinoCoreServerImpl) Preprocess(ctx context.Context, req *rpc.PreprocessRequest) (*rpc.PreprocessResponse, error) {
+	resp, err := compile.Preprocess(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
+// GetCacheStats returns the build cache statistics
+func (s *ArduinoCoreServerImpl) GetCacheStats(ctx context.Context, req *rpc.GetCacheStatsRequest) (*rpc.GetCacheStatsResponse, error) {
+	resp, err := compile.GetCacheStats(ctx, req)
+	if err != nil {
+		return nil, convertErrorToRPCStatus(err)
+	}
+	resp.CoreCacheHits = atomic.LoadInt64(&s.coreCacheHits)
+	resp.CoreCacheMisses = atomic.LoadInt64(&s.coreCacheMisses)
+	return resp, nil
+}
+
+// ClearCache removes the build cache
+func (s *ArduinoCoreServerImpl) ClearCache(ctx context.Context, req *rpc.ClearCacheRequest) (*rpc.ClearCacheResponse, error) {
+	resp, err := compile.ClearCache(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
+// PruneDownloadsCache removes the orphaned downloaded archives
+func (s *ArduinoCoreServerImpl) PruneDownloadsCache(ctx context.Context, req *rpc.PruneDownloadsCacheRequest) (*rpc.PruneDownloadsCacheResponse, error) {
+	resp, err := commands.PruneDownloadsCache(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
+// CompletionData returns the candidates for the dynamic shell completion
+func (s *ArduinoCoreServerImpl) CompletionData(ctx context.Context, req *rpc.CompletionDataRequest) (*rpc.CompletionDataResponse, error) {
+	resp, err := completion.CompletionData(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
 // PlatformInstall FIXMEDOC
 func (s *ArduinoCoreServerImpl) PlatformInstall(req *rpc.PlatformInstallRequest, stream rpc.ArduinoCoreService_PlatformInstallServer) error {
 	resp, err := core.PlatformInstall(
@@ -328,6 +416,19 @@ func (s *ArduinoCoreServerImpl) PlatformUpgrade(req *rpc.PlatformUpgradeRequest,
 	return stream.Send(resp)
 }
 
+// PlatformRollback restores the release of a platform replaced by the last install or upgrade
+func (s *ArduinoCoreServerImpl) PlatformRollback(req *rpc.PlatformRollbackRequest, stream rpc.ArduinoCoreService_PlatformRollbackServer) error {
+	resp, err := core.PlatformRollback(
+		stream.Context(), req,
+		func(p *rpc.DownloadProgress) { stream.Send(&rpc.PlatformRollbackResponse{Progress: p}) },
+		func(p *rpc.TaskProgress) { stream.Send(&rpc.PlatformRollbackResponse{TaskProgress: p}) },
+	)
+	if err != nil {
+		return convertErrorToRPCStatus(err)
+	}
+	return stream.Send(resp)
+}
+
 // PlatformSearch FIXMEDOC
 func (s *ArduinoCoreServerImpl) PlatformSearch(ctx context.Context, req *rpc.PlatformSearchRequest) (*rpc.PlatformSearchResponse, error) {
 	resp, err := core.PlatformSearch(req)
@@ -349,8 +450,13 @@ func (s *ArduinoCoreServerImpl) Upload(req *rpc.UploadRequest, stream rpc.Arduin
 		stream.Context(), req,
 		utils.FeedStreamTo(func(data []byte) { stream.Send(&rpc.UploadResponse{OutStream: data}) }),
 		utils.FeedStreamTo(func(data []byte) { stream.Send(&rpc.UploadResponse{ErrStream: data}) }),
+		func(p *rpc.UploadProgress) { stream.Send(&rpc.UploadResponse{Progress: p}) },
 	)
 	if err != nil {
+		// Report the failed verification before the error
+		if resp != nil {
+			stream.Send(resp)
+		}
 		return convertErrorToRPCStatus(err)
 	}
 	return stream.Send(resp)
@@ -362,8 +468,13 @@ func (s *ArduinoCoreServerImpl) UploadUsingProgrammer(req *rpc.UploadUsingProgra
 		stream.Context(), req,
 		utils.FeedStreamTo(func(data []byte) { stream.Send(&rpc.UploadUsingProgrammerResponse{OutStream: data}) }),
 		utils.FeedStreamTo(func(data []byte) { stream.Send(&rpc.UploadUsingProgrammerResponse{ErrStream: data}) }),
+		func(p *rpc.UploadProgress) { stream.Send(&rpc.UploadUsingProgrammerResponse{Progress: p}) },
 	)
 	if err != nil {
+		// Report the failed verification before the error
+		if resp.GetVerifyStatus() == rpc.UploadVerifyStatus_UPLOAD_VERIFY_STATUS_FAILED {
+			stream.Send(resp)
+		}
 		return convertErrorToRPCStatus(err)
 	}
 	return stream.Send(resp)
@@ -381,8 +492,13 @@ func (s *ArduinoCoreServerImpl) BurnBootloader(req *rpc.BurnBootloaderRequest, s
 		stream.Context(), req,
 		utils.FeedStreamTo(func(data []byte) { stream.Send(&rpc.BurnBootloaderResponse{OutStream: data}) }),
 		utils.FeedStreamTo(func(data []byte) { stream.Send(&rpc.BurnBootloaderResponse{ErrStream: data}) }),
+		func(p *rpc.UploadProgress) { stream.Send(&rpc.BurnBootloaderResponse{Progress: p}) },
 	)
 	if err != nil {
+		// Report the result of the failed process before the error
+		if resp != nil {
+			stream.Send(resp)
+		}
 		return convertErrorToRPCStatus(err)
 	}
 	return stream.Send(resp)
@@ -460,12 +576,36 @@ func (s *ArduinoCoreServerImpl) LibraryList(ctx context.Context, req *rpc.Librar
 	return resp, convertErrorToRPCStatus(err)
 }
 
+// ListLibraryExamples returns the examples bundled with an installed library
+func (s *ArduinoCoreServerImpl) ListLibraryExamples(ctx context.Context, req *rpc.ListLibraryExamplesRequest) (*rpc.ListLibraryExamplesResponse, error) {
+	resp, err := lib.ListLibraryExamples(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
+// CopyLibraryExample copies an example of an installed library into a new sketch
+func (s *ArduinoCoreServerImpl) CopyLibraryExample(ctx context.Context, req *rpc.CopyLibraryExampleRequest) (*rpc.CopyLibraryExampleResponse, error) {
+	resp, err := lib.CopyLibraryExample(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
 // ArchiveSketch FIXMEDOC
 func (s *ArduinoCoreServerImpl) ArchiveSketch(ctx context.Context, req *rpc.ArchiveSketchRequest) (*rpc.ArchiveSketchResponse, error) {
 	resp, err := sketch.ArchiveSketch(ctx, req)
 	return resp, convertErrorToRPCStatus(err)
 }
 
+// ExtractSketch extracts a sketch archive
+func (s *ArduinoCoreServerImpl) ExtractSketch(ctx context.Context, req *rpc.ExtractSketchRequest) (*rpc.ExtractSketchResponse, error) {
+	resp, err := sketch.ExtractSketch(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
+// DumpSketchProfile generates a sketch profile from the installed platforms and libraries
+func (s *ArduinoCoreServerImpl) DumpSketchProfile(ctx context.Context, req *rpc.DumpSketchProfileRequest) (*rpc.DumpSketchProfileResponse, error) {
+	resp, err := sketch.DumpSketchProfile(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
 //ZipLibraryInstall FIXMEDOC
 func (s *ArduinoCoreServerImpl) ZipLibraryInstall(req *rpc.ZipLibraryInstallRequest, stream rpc.ArduinoCoreService_ZipLibraryInstallServer) error {
 	err := lib.ZipLibraryInstall(
@@ -490,6 +630,18 @@ func (s *ArduinoCoreServerImpl) GitLibraryInstall(req *rpc.GitLibraryInstallRequ
 	return stream.Send(&rpc.GitLibraryInstallResponse{})
 }
 
+// LocalLibraryInstall installs a library from a local directory as a development link
+func (s *ArduinoCoreServerImpl) LocalLibraryInstall(req *rpc.LocalLibraryInstallRequest, stream rpc.ArduinoCoreService_LocalLibraryInstallServer) error {
+	err := lib.LocalLibraryInstall(
+		stream.Context(), req,
+		func(p *rpc.TaskProgress) { stream.Send(&rpc.LocalLibraryInstallResponse{TaskProgress: p}) },
+	)
+	if err != nil {
+		return convertErrorToRPCStatus(err)
+	}
+	return stream.Send(&rpc.LocalLibraryInstallResponse{})
+}
+
 // EnumerateMonitorPortSettings FIXMEDOC
 func (s *ArduinoCoreServerImpl) EnumerateMonitorPortSettings(ctx context.Context, req *rpc.EnumerateMonitorPortSettingsRequest) (*rpc.EnumerateMonitorPortSettingsResponse, error) {
 	resp, err := monitor.EnumerateMonitorPortSettings(ctx, req)
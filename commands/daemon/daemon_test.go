@@ -0,0 +1,31 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"testing"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsesCoreCache(t *testing.T) {
+	require.True(t, usesCoreCache(&rpc.CompileRequest{}))
+	require.False(t, usesCoreCache(&rpc.CompileRequest{ShowProperties: true}))
+	require.False(t, usesCoreCache(&rpc.CompileRequest{Preprocess: true}))
+	require.False(t, usesCoreCache(&rpc.CompileRequest{DryRun: true}))
+	require.False(t, usesCoreCache(&rpc.CompileRequest{CreateCompilationDatabaseOnly: true}))
+}
@@ -18,7 +18,9 @@ package daemon
 import (
 	"errors"
 	"io"
+	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/arduino/arduino-cli/arduino/monitors"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/monitor/v1"
@@ -45,35 +47,34 @@ func (s *MonitorService) StreamingOpen(stream rpc.MonitorService_StreamingOpenSe
 		return errors.New(tr("first message must contain monitor configuration, not data"))
 	}
 
-	// select which type of monitor we need
-	var mon monitors.Monitor
-	switch config.GetType() {
-	case rpc.MonitorConfig_TARGET_TYPE_SERIAL:
-		// grab port speed from additional config data
-		var baudRate float64
-		addCfg := config.GetAdditionalConfig()
-		for k, v := range addCfg.GetFields() {
-			if k == "BaudRate" {
-				baudRate = v.GetNumberValue()
-				break
-			}
-		}
+	// open the target, or share it if it's already opened by another client
+	key := config.GetType().String() + ":" + config.GetTarget()
+	shared, err := monitors.OpenShared(key, !config.GetShared(), func() (monitors.Monitor, error) {
+		return openMonitor(config)
+	})
+	if err != nil {
+		return err
+	}
+	var mon monitors.Monitor = shared
 
-		// get the Monitor instance
-		var err error
-		if mon, err = monitors.OpenSerialMonitor(config.GetTarget(), int(baudRate)); err != nil {
+	// copy the incoming data into a file if requested
+	if mirrorFile := config.GetMirrorFile(); mirrorFile != "" {
+		mirror, err := monitors.NewMirrorMonitor(mon, mirrorFile)
+		if err != nil {
+			mon.Close()
 			return err
 		}
+		mon = mirror
+	}
 
-	case rpc.MonitorConfig_TARGET_TYPE_NULL:
-		if addCfg, ok := config.GetAdditionalConfig().AsMap()["OutputRate"]; !ok {
-			mon = monitors.OpenNullMonitor(100.0) // 100 bytes per second as default
-		} else if outputRate, ok := addCfg.(float64); !ok {
-			return errors.New(tr("OutputRate in Null monitor must be a float64"))
-		} else {
-			// get the Monitor instance
-			mon = monitors.OpenNullMonitor(outputRate)
-		}
+	// prefix each line with a timestamp if requested
+	if config.GetTimestamp() {
+		mon = monitors.NewTimestampMonitor(mon)
+	}
+
+	// coalesce the incoming data if requested
+	if flushInterval := config.GetFlushIntervalMs(); flushInterval > 0 {
+		mon = monitors.NewCoalescingMonitor(mon, int(config.GetReadBufferSize()), time.Duration(flushInterval)*time.Millisecond)
 	}
 
 	// we'll use these channels to communicate with the goroutines
@@ -85,13 +86,60 @@ func (s *MonitorService) StreamingOpen(stream rpc.MonitorService_StreamingOpenSe
 	bufferSize := int(config.GetRecvRateLimitBuffer())
 	rateLimitEnabled := (bufferSize > 0)
 	if !rateLimitEnabled {
-		bufferSize = 1024
+		bufferSize = int(config.GetReadBufferSize())
+		if bufferSize <= 0 {
+			bufferSize = 1024
+		}
 	}
 	buffer := make([]byte, bufferSize)
 	bufferUsed := 0
 
 	var writeSlots int32
 
+	// the responses may be sent by different goroutines
+	var sendLock sync.Mutex
+	send := func(resp *rpc.StreamingOpenResponse) error {
+		sendLock.Lock()
+		defer sendLock.Unlock()
+		return stream.Send(resp)
+	}
+
+	// notify the client when the target is reopened after a disconnection, the
+	// notifications are stopped before returning since the stream can't be used
+	// anymore after that
+	reconnectionsDone := make(chan struct{})
+	var reconnectionsWG sync.WaitGroup
+	reconnectionsWG.Add(1)
+	go func() {
+		defer reconnectionsWG.Done()
+		for {
+			select {
+			case <-reconnectionsDone:
+				return
+			case portName, ok := <-shared.Reconnections():
+				if !ok {
+					return
+				}
+				send(&rpc.StreamingOpenResponse{ReconnectedTarget: portName})
+			}
+		}
+	}()
+	defer func() {
+		close(reconnectionsDone)
+		reconnectionsWG.Wait()
+	}()
+
+	// terminator appended to each message sent to the target
+	var lineEnding []byte
+	switch config.GetLineEnding() {
+	case rpc.MonitorConfig_LINE_ENDING_CR:
+		lineEnding = []byte("\r")
+	case rpc.MonitorConfig_LINE_ENDING_LF:
+		lineEnding = []byte("\n")
+	case rpc.MonitorConfig_LINE_ENDING_CRLF:
+		lineEnding = []byte("\r\n")
+	}
+
 	// now we can read the other messages and re-route to the monitor...
 	go func() {
 		for {
@@ -113,7 +161,11 @@ func (s *MonitorService) StreamingOpen(stream rpc.MonitorService_StreamingOpenSe
 				atomic.AddInt32(&writeSlots, msg.GetRecvAcknowledge())
 			}
 
-			if _, err := mon.Write(msg.GetData()); err != nil {
+			data := msg.GetData()
+			if len(data) > 0 && len(lineEnding) > 0 {
+				data = append(append([]byte{}, data...), lineEnding...)
+			}
+			if _, err := mon.Write(data); err != nil {
 				// error writing to target
 				targetClosed <- err
 				break
@@ -158,9 +210,12 @@ func (s *MonitorService) StreamingOpen(stream rpc.MonitorService_StreamingOpenSe
 				}
 			}
 
+			// add the data the shared monitor dropped before reaching us
+			dropped += shared.Dropped()
+
 			slots := atomic.LoadInt32(&writeSlots)
 			if !rateLimitEnabled || slots > 0 {
-				if err = stream.Send(&rpc.StreamingOpenResponse{
+				if err = send(&rpc.StreamingOpenResponse{
 					Data:    buffer[:bufferUsed],
 					Dropped: int32(dropped),
 				}); err != nil {
@@ -188,7 +243,41 @@ func (s *MonitorService) StreamingOpen(stream rpc.MonitorService_StreamingOpenSe
 			mon.Close()
 			return err
 		case err := <-targetClosed:
+			mon.Close()
 			return err
 		}
 	}
 }
+
+// openMonitor opens the monitor target described by config
+func openMonitor(config *rpc.MonitorConfig) (monitors.Monitor, error) {
+	switch config.GetType() {
+	case rpc.MonitorConfig_TARGET_TYPE_SERIAL:
+		// grab port speed from additional config data
+		var baudRate float64
+		addCfg := config.GetAdditionalConfig()
+		for k, v := range addCfg.GetFields() {
+			if k == "BaudRate" {
+				baudRate = v.GetNumberValue()
+				break
+			}
+		}
+
+		// get the Monitor instance
+		if config.GetReconnect() {
+			return monitors.OpenReconnectingSerialMonitor(config.GetTarget(), int(baudRate))
+		}
+		return monitors.OpenSerialMonitor(config.GetTarget(), int(baudRate))
+
+	case rpc.MonitorConfig_TARGET_TYPE_NULL:
+		if addCfg, ok := config.GetAdditionalConfig().AsMap()["OutputRate"]; !ok {
+			return monitors.OpenNullMonitor(100.0), nil // 100 bytes per second as default
+		} else if outputRate, ok := addCfg.(float64); !ok {
+			return nil, errors.New(tr("OutputRate in Null monitor must be a float64"))
+		} else {
+			// get the Monitor instance
+			return monitors.OpenNullMonitor(outputRate), nil
+		}
+	}
+	return nil, errors.New(tr("unsupported monitor type: %s", config.GetType()))
+}
@@ -20,10 +20,17 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"reflect"
+	"sort"
 	"strings"
+	"sync"
 
+	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/configuration"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/settings/v1"
+	"github.com/arduino/go-paths-helper"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 // SettingsService implements the `Settings` service
@@ -83,6 +90,7 @@ func (s *SettingsService) Merge(ctx context.Context, req *rpc.MergeRequest) (*rp
 	// using the MergeConfigMap function.
 	for k, v := range mapped {
 		configuration.Settings.Set(k, v)
+		notifySettingChanged(k, v)
 	}
 
 	return &rpc.MergeResponse{}, nil
@@ -93,18 +101,7 @@ func (s *SettingsService) Merge(ctx context.Context, req *rpc.MergeRequest) (*rp
 // ones.
 func (s *SettingsService) GetValue(ctx context.Context, req *rpc.GetValueRequest) (*rpc.GetValueResponse, error) {
 	key := req.GetKey()
-
-	// Check if settings key actually existing, we don't use Viper.InConfig()
-	// since that doesn't check for keys formatted like daemon.port or those set
-	// with Viper.Set(). This way we check for all existing settings for sure.
-	keyExists := false
-	for _, k := range configuration.Settings.AllKeys() {
-		if k == key || strings.HasPrefix(k, key) {
-			keyExists = true
-			break
-		}
-	}
-	if !keyExists {
+	if !settingExists(key) {
 		return nil, errors.New(tr("key not found in settings"))
 	}
 
@@ -118,6 +115,44 @@ func (s *SettingsService) GetValue(ctx context.Context, req *rpc.GetValueRequest
 	return value, err
 }
 
+// settingExists checks if the settings key actually exists, we don't use
+// Viper.InConfig() since that doesn't check for keys formatted like
+// daemon.port or those set with Viper.Set(). This way we check for all
+// existing settings for sure.
+func settingExists(key string) bool {
+	for _, k := range configuration.Settings.AllKeys() {
+		if k == key || strings.HasPrefix(k, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetTypedValue returns a settings value given its key, preserving its type.
+// If the key is not present an error will be returned.
+func (s *SettingsService) GetTypedValue(ctx context.Context, req *rpc.GetTypedValueRequest) (*rpc.GetTypedValueResponse, error) {
+	key := req.GetKey()
+	if !settingExists(key) {
+		return nil, errors.New(tr("key not found in settings"))
+	}
+
+	// Viper may return types not supported by structpb (like []string), a JSON
+	// round trip converts the value to the generic types
+	b, err := json.Marshal(configuration.Settings.Get(key))
+	if err != nil {
+		return nil, err
+	}
+	var generic interface{}
+	if err := json.Unmarshal(b, &generic); err != nil {
+		return nil, err
+	}
+	value, err := structpb.NewValue(generic)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.GetTypedValueResponse{Key: key, Value: value}, nil
+}
+
 // SetValue updates or set a value for a certain key.
 func (s *SettingsService) SetValue(ctx context.Context, val *rpc.SetValueRequest) (*rpc.SetValueResponse, error) {
 	key := val.GetKey()
@@ -126,6 +161,7 @@ func (s *SettingsService) SetValue(ctx context.Context, val *rpc.SetValueRequest
 	err := json.Unmarshal([]byte(val.GetJsonData()), &value)
 	if err == nil {
 		configuration.Settings.Set(key, value)
+		notifySettingChanged(key, value)
 	}
 
 	return &rpc.SetValueResponse{}, err
@@ -135,9 +171,191 @@ func (s *SettingsService) SetValue(ctx context.Context, val *rpc.SetValueRequest
 // We don't have a Read() function, that's not necessary since we only want one config file to be used
 // and that's picked up when the CLI is run as daemon, either using the default path or a custom one
 // set with the --config-file flag.
+// The file is written atomically and the previous version, if any, is kept
+// in a ".bak" file.
 func (s *SettingsService) Write(ctx context.Context, req *rpc.WriteRequest) (*rpc.WriteResponse, error) {
-	if err := configuration.Settings.WriteConfigAs(req.FilePath); err != nil {
+	if err := writeSettingsFile(paths.New(req.FilePath)); err != nil {
 		return nil, err
 	}
 	return &rpc.WriteResponse{}, nil
 }
+
+// writeSettingsFile writes the settings to a temporary file and then renames
+// it to configFile, so that an interrupted write doesn't corrupt it.
+// The previous content of configFile is copied to configFile.bak.
+func writeSettingsFile(configFile *paths.Path) error {
+	// The temp file must have the same extension of the config file since
+	// it's used by Viper to select the format. Its name must be unique, since
+	// more settings may be written at the same time.
+	ext := configFile.Ext()
+	f, err := os.CreateTemp(configFile.Parent().String(), "."+strings.TrimSuffix(configFile.Base(), ext)+"-*"+ext)
+	if err != nil {
+		return err
+	}
+	f.Close()
+	tmpFile := paths.New(f.Name())
+	// CreateTemp makes the file readable only by the owner, keep the
+	// permissions of the settings file instead
+	mode := os.FileMode(0644)
+	if info, err := configFile.Stat(); err == nil {
+		mode = info.Mode().Perm()
+	}
+	if err := os.Chmod(tmpFile.String(), mode); err != nil {
+		tmpFile.Remove()
+		return err
+	}
+	fileSettings := configuration.SettingsForFile(configuration.Settings, configFile.String())
+	if err := configuration.WriteSettingsFile(fileSettings, tmpFile.String()); err != nil {
+		tmpFile.Remove()
+		return err
+	}
+	if configFile.Exist() {
+		if err := configFile.CopyTo(paths.New(configFile.String() + ".bak")); err != nil {
+			tmpFile.Remove()
+			return fmt.Errorf("%s: %w", tr("creating backup of settings file"), err)
+		}
+	}
+	if err := tmpFile.Rename(configFile); err != nil {
+		tmpFile.Remove()
+		return err
+	}
+	return nil
+}
+
+// Reload reads again the configuration file in use, and the additional
+// configuration files merged over it, and returns the resulting settings,
+// marshalled in JSON format. The values set with SetValue or Merge
+// take precedence over the ones read from the file.
+func (s *SettingsService) Reload(ctx context.Context, req *rpc.ReloadRequest) (*rpc.ReloadResponse, error) {
+	if configuration.Settings.ConfigFileUsed() == "" {
+		return nil, errors.New(tr("no configuration file in use"))
+	}
+	if err := ReloadSettings(); err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(configuration.Settings.AllSettings())
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.ReloadResponse{JsonData: string(b)}, nil
+}
+
+// ReloadSettings reads again the configuration files in use and notifies the
+// WatchSettings clients of the settings changed or deleted by the files
+func ReloadSettings() error {
+	previous := settingsSnapshot()
+	if err := configuration.Reload(configuration.Settings); err != nil {
+		return err
+	}
+	current := settingsSnapshot()
+
+	keys := []string{}
+	for key := range previous {
+		keys = append(keys, key)
+	}
+	for key := range current {
+		if _, ok := previous[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value, ok := current[key]
+		if !ok {
+			notifySettingChanged(key, nil)
+		} else if !reflect.DeepEqual(previous[key], value) {
+			notifySettingChanged(key, value)
+		}
+	}
+	return nil
+}
+
+// settingsSnapshot returns the current value of all the settings, by key
+func settingsSnapshot() map[string]interface{} {
+	res := map[string]interface{}{}
+	for _, key := range configuration.Settings.AllKeys() {
+		res[key] = configuration.Settings.Get(key)
+	}
+	return res
+}
+
+// settingsWatcherBufferSize is the number of events buffered for each
+// WatchSettings client, the events exceeding the buffer are dropped to avoid
+// a slow client blocking the writers.
+const settingsWatcherBufferSize = 32
+
+var settingsWatchers = map[chan *rpc.WatchSettingsResponse]bool{}
+var settingsWatchersLock sync.Mutex
+
+// notifySettingChanged sends an event to all the WatchSettings clients
+func notifySettingChanged(key string, value interface{}) {
+	event := &rpc.WatchSettingsResponse{Key: key, Deleted: value == nil}
+	if b, err := json.Marshal(value); err == nil {
+		event.JsonData = string(b)
+	}
+
+	settingsWatchersLock.Lock()
+	defer settingsWatchersLock.Unlock()
+	for watcher := range settingsWatchers {
+		select {
+		case watcher <- event:
+		default:
+		}
+	}
+}
+
+// WatchSettings streams an event each time a setting is changed, until the
+// client closes the stream.
+func (s *SettingsService) WatchSettings(req *rpc.WatchSettingsRequest, stream rpc.SettingsService_WatchSettingsServer) error {
+	events := make(chan *rpc.WatchSettingsResponse, settingsWatcherBufferSize)
+	settingsWatchersLock.Lock()
+	settingsWatchers[events] = true
+	settingsWatchersLock.Unlock()
+	defer func() {
+		settingsWatchersLock.Lock()
+		delete(settingsWatchers, events)
+		settingsWatchersLock.Unlock()
+	}()
+
+	for {
+		select {
+		case event := <-events:
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// ListBoardManagerURLs returns the additional board manager URLs
+func (s *SettingsService) ListBoardManagerURLs(ctx context.Context, req *rpc.ListBoardManagerURLsRequest) (*rpc.ListBoardManagerURLsResponse, error) {
+	return commands.ListBoardManagerURLs(req), nil
+}
+
+// AddBoardManagerURL adds a validated URL to the additional board manager URLs
+func (s *SettingsService) AddBoardManagerURL(ctx context.Context, req *rpc.AddBoardManagerURLRequest) (*rpc.AddBoardManagerURLResponse, error) {
+	resp, err := commands.AddBoardManagerURL(req)
+	if err != nil {
+		return nil, convertErrorToRPCStatus(err)
+	}
+	if !resp.GetAlreadyPresent() {
+		notifyBoardManagerURLsChanged()
+	}
+	return resp, nil
+}
+
+// RemoveBoardManagerURL removes an URL from the additional board manager URLs
+func (s *SettingsService) RemoveBoardManagerURL(ctx context.Context, req *rpc.RemoveBoardManagerURLRequest) (*rpc.RemoveBoardManagerURLResponse, error) {
+	resp, err := commands.RemoveBoardManagerURL(req)
+	if err != nil {
+		return nil, convertErrorToRPCStatus(err)
+	}
+	notifyBoardManagerURLsChanged()
+	return resp, nil
+}
+
+func notifyBoardManagerURLsChanged() {
+	notifySettingChanged("board_manager.additional_urls", configuration.Settings.GetStringSlice("board_manager.additional_urls"))
+}
@@ -19,12 +19,16 @@ import (
 	"context"
 	"encoding/json"
 	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/arduino/arduino-cli/configuration"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/settings/v1"
 	"github.com/arduino/go-paths-helper"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 )
 
 var svc = SettingsService{}
@@ -122,6 +126,32 @@ func TestGetValueNotFound(t *testing.T) {
 	require.Equal(t, `key not found in settings`, err.Error())
 }
 
+func TestGetTypedValue(t *testing.T) {
+	defer reset()
+
+	_, err := svc.Merge(context.Background(), &rpc.MergeRequest{JsonData: `{"typed": {"bool": false, "number": 42, "string": "false"}}`})
+	require.NoError(t, err)
+
+	res, err := svc.GetTypedValue(context.Background(), &rpc.GetTypedValueRequest{Key: "typed.bool"})
+	require.NoError(t, err)
+	require.Equal(t, false, res.GetValue().AsInterface())
+
+	res, err = svc.GetTypedValue(context.Background(), &rpc.GetTypedValueRequest{Key: "typed.number"})
+	require.NoError(t, err)
+	require.Equal(t, float64(42), res.GetValue().AsInterface())
+
+	res, err = svc.GetTypedValue(context.Background(), &rpc.GetTypedValueRequest{Key: "typed.string"})
+	require.NoError(t, err)
+	require.Equal(t, "false", res.GetValue().AsInterface())
+
+	res, err = svc.GetTypedValue(context.Background(), &rpc.GetTypedValueRequest{Key: "board_manager.additional_urls"})
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"http://foobar.com", "http://example.com"}, res.GetValue().AsInterface())
+
+	_, err = svc.GetTypedValue(context.Background(), &rpc.GetTypedValueRequest{Key: "DOESNTEXIST"})
+	require.Error(t, err)
+}
+
 func TestSetValue(t *testing.T) {
 	val := &rpc.SetValueRequest{
 		Key:      "foo",
@@ -157,3 +187,235 @@ func TestWrite(t *testing.T) {
 	// We don't verify the content since we expect config library, Viper, to work
 	require.True(t, configFile.Exist())
 }
+
+func TestWriteKeepsBackup(t *testing.T) {
+	defer reset()
+	testFolder, err := paths.TempDir().MkTempDir("testdata")
+	require.NoError(t, err)
+	defer testFolder.RemoveAll()
+	configFile := testFolder.Join("arduino-cli.yml")
+
+	_, err = svc.SetValue(context.Background(), &rpc.SetValueRequest{Key: "foo", JsonData: `"first"`})
+	require.NoError(t, err)
+	_, err = svc.Write(context.Background(), &rpc.WriteRequest{FilePath: configFile.String()})
+	require.NoError(t, err)
+	require.True(t, configFile.Exist())
+	require.True(t, paths.New(configFile.String()+".bak").NotExist())
+
+	_, err = svc.SetValue(context.Background(), &rpc.SetValueRequest{Key: "foo", JsonData: `"second"`})
+	require.NoError(t, err)
+	_, err = svc.Write(context.Background(), &rpc.WriteRequest{FilePath: configFile.String()})
+	require.NoError(t, err)
+
+	data, err := configFile.ReadFile()
+	require.NoError(t, err)
+	require.Contains(t, string(data), "second")
+	backup, err := paths.New(configFile.String() + ".bak").ReadFile()
+	require.NoError(t, err)
+	require.Contains(t, string(backup), "first")
+
+	// the temporary file is removed
+	files, err := testFolder.ReadDir()
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+}
+
+func TestConcurrentWrites(t *testing.T) {
+	defer reset()
+	testFolder, err := paths.TempDir().MkTempDir("testdata")
+	require.NoError(t, err)
+	defer testFolder.RemoveAll()
+	configFile := testFolder.Join("arduino-cli.yml")
+
+	_, err = svc.SetValue(context.Background(), &rpc.SetValueRequest{Key: "foo", JsonData: `"bar"`})
+	require.NoError(t, err)
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := svc.Write(context.Background(), &rpc.WriteRequest{FilePath: configFile.String()})
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	data, err := configFile.ReadFile()
+	require.NoError(t, err)
+	require.Contains(t, string(data), "foo: bar")
+	// only the settings file and its backup are left
+	files, err := testFolder.ReadDir()
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+}
+
+func TestReload(t *testing.T) {
+	defer reset()
+
+	testFolder, err := paths.TempDir().MkTempDir("testdata")
+	require.NoError(t, err)
+	defer testFolder.RemoveAll()
+	configFile := testFolder.Join("arduino-cli.yaml")
+	require.NoError(t, configFile.WriteFile([]byte("foo: bar\n")))
+	configuration.Settings = configuration.Init(configFile.String())
+
+	require.NoError(t, configFile.WriteFile([]byte("foo: baz\n")))
+	res, err := svc.Reload(context.Background(), &rpc.ReloadRequest{})
+	require.NoError(t, err)
+	require.Contains(t, res.GetJsonData(), `"foo":"baz"`)
+	require.Equal(t, "baz", configuration.Settings.GetString("foo"))
+}
+
+type watchSettingsStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *rpc.WatchSettingsResponse
+}
+
+func (s *watchSettingsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchSettingsStream) Send(event *rpc.WatchSettingsResponse) error {
+	s.events <- event
+	return nil
+}
+
+func TestWatchSettings(t *testing.T) {
+	defer reset()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &watchSettingsStream{ctx: ctx, events: make(chan *rpc.WatchSettingsResponse, 10)}
+	done := make(chan error)
+	go func() { done <- svc.WatchSettings(&rpc.WatchSettingsRequest{}, stream) }()
+
+	// wait for the watcher to be registered
+	require.Eventually(t, func() bool {
+		settingsWatchersLock.Lock()
+		defer settingsWatchersLock.Unlock()
+		return len(settingsWatchers) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	_, err := svc.SetValue(context.Background(), &rpc.SetValueRequest{Key: "foo", JsonData: `"bar"`})
+	require.NoError(t, err)
+	event := <-stream.events
+	require.Equal(t, "foo", event.GetKey())
+	require.Equal(t, `"bar"`, event.GetJsonData())
+	require.False(t, event.GetDeleted())
+
+	_, err = svc.SetValue(context.Background(), &rpc.SetValueRequest{Key: "foo", JsonData: `null`})
+	require.NoError(t, err)
+	event = <-stream.events
+	require.Equal(t, "foo", event.GetKey())
+	require.True(t, event.GetDeleted())
+
+	cancel()
+	require.NoError(t, <-done)
+	require.Empty(t, settingsWatchers)
+}
+
+func TestReloadNotifiesWatchers(t *testing.T) {
+	defer reset()
+
+	testFolder, err := paths.TempDir().MkTempDir("testdata")
+	require.NoError(t, err)
+	defer testFolder.RemoveAll()
+	configFile := testFolder.Join("arduino-cli.yaml")
+	require.NoError(t, configFile.WriteFile([]byte("foo: bar\nremoved: 1\nunchanged: 2\n")))
+	configuration.Settings = configuration.Init(configFile.String())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &watchSettingsStream{ctx: ctx, events: make(chan *rpc.WatchSettingsResponse, 10)}
+	done := make(chan error)
+	go func() { done <- svc.WatchSettings(&rpc.WatchSettingsRequest{}, stream) }()
+	require.Eventually(t, func() bool {
+		settingsWatchersLock.Lock()
+		defer settingsWatchersLock.Unlock()
+		return len(settingsWatchers) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	require.NoError(t, configFile.WriteFile([]byte("foo: baz\nunchanged: 2\nadded: 3\n")))
+	_, err = svc.Reload(context.Background(), &rpc.ReloadRequest{})
+	require.NoError(t, err)
+
+	// The events are sorted by key
+	event := <-stream.events
+	require.Equal(t, "added", event.GetKey())
+	require.Equal(t, "3", event.GetJsonData())
+	event = <-stream.events
+	require.Equal(t, "foo", event.GetKey())
+	require.Equal(t, `"baz"`, event.GetJsonData())
+	event = <-stream.events
+	require.Equal(t, "removed", event.GetKey())
+	require.True(t, event.GetDeleted())
+	select {
+	case event := <-stream.events:
+		t.Fatalf("unexpected event for %s", event.GetKey())
+	default:
+	}
+
+	cancel()
+	require.NoError(t, <-done)
+}
+
+func TestBoardManagerURLs(t *testing.T) {
+	reset()
+	configuration.Settings.Set("board_manager.additional_urls", []string{"http://foobar.com"})
+
+	tmp, err := paths.MkTempDir("", "board_manager_urls")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	fileURL := func(p *paths.Path) string {
+		return "file:///" + strings.TrimPrefix(filepath.ToSlash(p.String()), "/")
+	}
+	validIndex := tmp.Join("package_test_index.json")
+	require.NoError(t, validIndex.WriteFile([]byte(`{"packages": [{"name": "test", "platforms": [], "tools": []}]}`)))
+	emptyIndex := tmp.Join("package_empty_index.json")
+	require.NoError(t, emptyIndex.WriteFile([]byte(`{"packages": []}`)))
+	invalidIndex := tmp.Join("package_invalid_index.json")
+	require.NoError(t, invalidIndex.WriteFile([]byte(`not an index`)))
+
+	list, err := svc.ListBoardManagerURLs(context.Background(), &rpc.ListBoardManagerURLsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"http://foobar.com"}, list.GetUrls())
+
+	added, err := svc.AddBoardManagerURL(context.Background(), &rpc.AddBoardManagerURLRequest{Url: fileURL(validIndex)})
+	require.NoError(t, err)
+	require.Equal(t, []string{"test"}, added.GetPackages())
+	require.False(t, added.GetAlreadyPresent())
+
+	added, err = svc.AddBoardManagerURL(context.Background(), &rpc.AddBoardManagerURLRequest{Url: fileURL(validIndex)})
+	require.NoError(t, err)
+	require.True(t, added.GetAlreadyPresent())
+
+	// Invalid indexes and URLs are not added
+	_, err = svc.AddBoardManagerURL(context.Background(), &rpc.AddBoardManagerURLRequest{Url: fileURL(emptyIndex)})
+	require.Error(t, err)
+	_, err = svc.AddBoardManagerURL(context.Background(), &rpc.AddBoardManagerURLRequest{Url: fileURL(invalidIndex)})
+	require.Error(t, err)
+	_, err = svc.AddBoardManagerURL(context.Background(), &rpc.AddBoardManagerURLRequest{Url: "ftp://example.com/package_index.json"})
+	require.Error(t, err)
+
+	// The validation can be skipped
+	added, err = svc.AddBoardManagerURL(context.Background(), &rpc.AddBoardManagerURLRequest{Url: fileURL(invalidIndex), SkipValidation: true})
+	require.NoError(t, err)
+	require.Empty(t, added.GetPackages())
+
+	list, err = svc.ListBoardManagerURLs(context.Background(), &rpc.ListBoardManagerURLsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"http://foobar.com", fileURL(validIndex), fileURL(invalidIndex)}, list.GetUrls())
+
+	_, err = svc.RemoveBoardManagerURL(context.Background(), &rpc.RemoveBoardManagerURLRequest{Url: "http://foobar.com"})
+	require.NoError(t, err)
+	_, err = svc.RemoveBoardManagerURL(context.Background(), &rpc.RemoveBoardManagerURLRequest{Url: "http://foobar.com"})
+	require.Error(t, err)
+
+	list, err = svc.ListBoardManagerURLs(context.Background(), &rpc.ListBoardManagerURLsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{fileURL(validIndex), fileURL(invalidIndex)}, list.GetUrls())
+}
@@ -113,6 +113,9 @@ func Debug(ctx context.Context, req *dbg.DebugConfigRequest, inStream io.Reader,
 
 // getCommandLine compose a debug command represented by a core recipe
 func getCommandLine(req *dbg.DebugConfigRequest, pm *packagemanager.PackageManager) ([]string, error) {
+	if req.GetSketchPath() == "" {
+		return nil, &arduino.MissingSketchPathError{}
+	}
 	debugInfo, err := getDebugProperties(req, pm)
 	if err != nil {
 		return nil, err
@@ -165,6 +168,12 @@ func getCommandLine(req *dbg.DebugConfigRequest, pm *packagemanager.PackageManag
 
 		serverCmd += ` -c "gdb_port pipe"`
 		serverCmd += ` -c "telnet_port 0"`
+		if req.GetAttach() {
+			// By default OpenOCD halts the target when GDB connects,
+			// remove the event handler to leave the program running
+			serverCmd += ` -c "init"`
+			serverCmd += ` -c "[target current] configure -event gdb-attach {}"`
+		}
 
 		add("-ex")
 		add(serverCmd)
@@ -17,6 +17,7 @@ package debug
 
 import (
 	"context"
+	"errors"
 	"strings"
 
 	"github.com/arduino/arduino-cli/arduino"
@@ -30,7 +31,10 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-// GetDebugConfig returns metadata to start debugging with the specified board
+// GetDebugConfig returns metadata to start debugging with the specified board.
+// The sketch path is optional: if omitted the debug configuration of the board
+// and programmer is resolved without the executable, this allows clients to
+// check if debugging is supported before compiling.
 func GetDebugConfig(ctx context.Context, req *debug.DebugConfigRequest) (*debug.GetDebugConfigResponse, error) {
 	pm := commands.GetPackageManager(req.GetInstance().GetId())
 	return getDebugProperties(req, pm)
@@ -39,13 +43,13 @@ func GetDebugConfig(ctx context.Context, req *debug.DebugConfigRequest) (*debug.
 func getDebugProperties(req *debug.DebugConfigRequest, pm *packagemanager.PackageManager) (*debug.GetDebugConfigResponse, error) {
 	// TODO: make a generic function to extract sketch from request
 	// and remove duplication in commands/compile.go
-	if req.GetSketchPath() == "" {
-		return nil, &arduino.MissingSketchPathError{}
-	}
-	sketchPath := paths.New(req.GetSketchPath())
-	sk, err := sketch.New(sketchPath)
-	if err != nil {
-		return nil, &arduino.CantOpenSketchError{Cause: err}
+	var sk *sketch.Sketch
+	if req.GetSketchPath() != "" {
+		var err error
+		sk, err = sketch.New(paths.New(req.GetSketchPath()))
+		if err != nil {
+			return nil, &arduino.CantOpenSketchError{Cause: err}
+		}
 	}
 
 	// XXX Remove this code duplication!!
@@ -63,7 +67,10 @@ func getDebugProperties(req *debug.DebugConfigRequest, pm *packagemanager.Packag
 
 	// Find target board and board properties
 	_, platformRelease, board, boardProperties, referencedPlatformRelease, err := pm.ResolveFQBN(fqbn)
-	if err != nil {
+	var optionErr *arduino.InvalidBoardOptionError
+	if errors.As(err, &optionErr) {
+		return nil, optionErr
+	} else if err != nil {
 		return nil, &arduino.UnknownFQBNError{Cause: err}
 	}
 
@@ -111,18 +118,20 @@ func getDebugProperties(req *debug.DebugConfigRequest, pm *packagemanager.Packag
 		}
 	}
 
-	importPath := sk.BuildPath
-	if importDir := req.GetImportDir(); importDir != "" {
-		importPath = paths.New(importDir)
-	}
-	if !importPath.Exist() {
-		return nil, &arduino.NotFoundError{Message: tr("Compiled sketch not found in %s", importPath)}
-	}
-	if !importPath.IsDir() {
-		return nil, &arduino.NotFoundError{Message: tr("Expected compiled sketch in directory %s, but is a file instead", importPath)}
+	if sk != nil {
+		importPath := sk.BuildPath
+		if importDir := req.GetImportDir(); importDir != "" {
+			importPath = paths.New(importDir)
+		}
+		if !importPath.Exist() {
+			return nil, &arduino.NotFoundError{Message: tr("Compiled sketch not found in %s", importPath)}
+		}
+		if !importPath.IsDir() {
+			return nil, &arduino.NotFoundError{Message: tr("Expected compiled sketch in directory %s, but is a file instead", importPath)}
+		}
+		toolProperties.SetPath("build.path", importPath)
+		toolProperties.Set("build.project_name", sk.Name+".ino")
 	}
-	toolProperties.SetPath("build.path", importPath)
-	toolProperties.Set("build.project_name", sk.Name+".ino")
 
 	// Set debug port property
 	port := req.GetPort()
@@ -142,10 +151,14 @@ func getDebugProperties(req *debug.DebugConfigRequest, pm *packagemanager.Packag
 		return nil, &arduino.FailedDebugError{Message: tr("Debugging not supported for board %s", req.GetFqbn())}
 	}
 
+	executable := ""
+	if sk != nil {
+		executable = debugProperties.Get("executable")
+	}
 	server := debugProperties.Get("server")
 	toolchain := debugProperties.Get("toolchain")
 	return &debug.GetDebugConfigResponse{
-		Executable:             debugProperties.Get("executable"),
+		Executable:             executable,
 		Server:                 server,
 		ServerPath:             debugProperties.Get("server." + server + ".path"),
 		ServerConfiguration:    debugProperties.SubTree("server." + server).AsMap(),
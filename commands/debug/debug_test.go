@@ -88,3 +88,52 @@ func TestGetCommandLine(t *testing.T) {
 	commandToTest2 := strings.Join(command2[:], " ")
 	assert.Equal(t, filepath.FromSlash(goldCommand2), filepath.FromSlash(commandToTest2))
 }
+
+func TestGetDebugPropertiesWithoutSketch(t *testing.T) {
+	customHardware := paths.New("testdata", "custom_hardware")
+	dataDir := paths.New("testdata", "data_dir", "packages")
+
+	pm := packagemanager.NewPackageManager(nil, nil, nil, nil, "test")
+	pm.LoadHardwareFromDirectory(customHardware)
+	pm.LoadHardwareFromDirectory(dataDir)
+
+	req := &dbg.DebugConfigRequest{
+		Instance: &rpc.Instance{Id: 1},
+		Fqbn:     "arduino-test:samd:arduino_zero_edbg",
+	}
+	info, err := getDebugProperties(req, pm)
+	require.NoError(t, err)
+	require.Empty(t, info.GetExecutable())
+	require.Equal(t, "openocd", info.GetServer())
+	require.Equal(t, "gcc", info.GetToolchain())
+	require.Equal(t, "arm-none-eabi-", info.GetToolchainPrefix())
+
+	// a sketch is required to start a debug session
+	_, err = getCommandLine(req, pm)
+	require.Error(t, err)
+}
+
+func TestGetCommandLineAttach(t *testing.T) {
+	customHardware := paths.New("testdata", "custom_hardware")
+	dataDir := paths.New("testdata", "data_dir", "packages")
+	sketchPath := paths.New("testdata", "hello")
+	require.NoError(t, sketchPath.ToAbs())
+
+	pm := packagemanager.NewPackageManager(nil, nil, nil, nil, "test")
+	pm.LoadHardwareFromDirectory(customHardware)
+	pm.LoadHardwareFromDirectory(dataDir)
+
+	req := &dbg.DebugConfigRequest{
+		Instance:   &rpc.Instance{Id: 1},
+		Fqbn:       "arduino-test:samd:arduino_zero_edbg",
+		SketchPath: sketchPath.String(),
+		ImportDir:  sketchPath.Join("build", "arduino-test.samd.arduino_zero_edbg").String(),
+		Attach:     true,
+	}
+	command, err := getCommandLine(req, pm)
+	require.NoError(t, err)
+	commandToTest := strings.Join(command, " ")
+	require.Contains(t, commandToTest, ` -c "gdb_port pipe" -c "telnet_port 0" -c "init" -c "[target current] configure -event gdb-attach {}"`)
+	require.NotContains(t, commandToTest, "reset")
+	require.NotContains(t, commandToTest, "load")
+}
@@ -0,0 +1,118 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/resources"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+)
+
+// pruneDownloadsMinAge is the minimum age of the archives removed by
+// PruneDownloadsCache: the more recent ones may be still being downloaded, or
+// just downloaded but not yet installed, by a concurrent operation
+var pruneDownloadsMinAge = 10 * time.Minute
+
+// PruneDownloadsCache removes the archives in the downloads directory that are
+// not referenced by any installed platform, tool or library. The archives
+// modified in the last pruneDownloadsMinAge are kept.
+func PruneDownloadsCache(ctx context.Context, req *rpc.PruneDownloadsCacheRequest) (*rpc.PruneDownloadsCacheResponse, error) {
+	pm := GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	lm := GetLibraryManager(req.GetInstance().GetId())
+	if lm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+
+	referenced := map[string]bool{}
+	addReference := func(downloadDir *paths.Path, resource *resources.DownloadResource) {
+		if resource != nil {
+			referenced[downloadDir.Join(resource.CachePath, resource.ArchiveFileName).String()] = true
+		}
+	}
+	for _, pkg := range pm.Packages {
+		for _, platform := range pkg.Platforms {
+			for _, release := range platform.GetAllInstalled() {
+				addReference(pm.DownloadDir, release.Resource)
+			}
+		}
+		for _, tool := range pkg.Tools {
+			for _, release := range tool.Releases {
+				if release.IsInstalled() {
+					addReference(pm.DownloadDir, release.GetCompatibleFlavour())
+				}
+			}
+		}
+	}
+	for _, alternatives := range lm.Libraries {
+		for _, lib := range alternatives.Alternatives {
+			if lib.Version == nil {
+				continue
+			}
+			release := lm.Index.FindRelease(&librariesindex.Reference{Name: lib.Name, Version: lib.Version})
+			if release != nil {
+				addReference(lm.DownloadsDir, release.Resource)
+			}
+		}
+	}
+
+	downloadDirs := paths.PathList{pm.DownloadDir}
+	if !lm.DownloadsDir.EquivalentTo(pm.DownloadDir) {
+		downloadDirs.Add(lm.DownloadsDir)
+	}
+	resp := &rpc.PruneDownloadsCacheResponse{}
+	for _, downloadDir := range downloadDirs {
+		if downloadDir.NotExist() {
+			continue
+		}
+		files, err := downloadDir.ReadDirRecursive()
+		if err != nil {
+			return nil, &arduino.PermissionDeniedError{Message: tr("Error reading downloads directory"), Cause: err}
+		}
+		files.Sort()
+		for _, file := range files {
+			if file.IsDir() || referenced[file.String()] {
+				continue
+			}
+			info, err := file.Stat()
+			if os.IsNotExist(err) {
+				// removed in the meantime
+				continue
+			} else if err != nil {
+				return nil, &arduino.PermissionDeniedError{Message: tr("Error reading downloads directory"), Cause: err}
+			}
+			if time.Since(info.ModTime()) < pruneDownloadsMinAge {
+				continue
+			}
+			if !req.GetDryRun() {
+				if err := file.Remove(); err != nil {
+					return resp, &arduino.PermissionDeniedError{Message: tr("Error removing %s", file), Cause: err}
+				}
+			}
+			resp.RemovedFiles = append(resp.RemovedFiles, file.String())
+			resp.Freed += info.Size()
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,139 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/cores/packageindex"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+)
+
+// indexEntries maps each entry of an index (a platform for the packages indexes,
+// a library for the libraries index) to the list of its releases
+type indexEntries map[string]string
+
+func loadPackageIndexEntries(indexPath *paths.Path) indexEntries {
+	entries := indexEntries{}
+	index, err := packageindex.LoadIndexNoSign(indexPath)
+	if err != nil {
+		return entries
+	}
+	releases := map[string][]string{}
+	for _, pkg := range index.Packages {
+		for _, platform := range pkg.Platforms {
+			id := pkg.Name + ":" + platform.Architecture
+			releases[id] = append(releases[id], platform.Version.String())
+		}
+	}
+	for id, versions := range releases {
+		sort.Strings(versions)
+		entries[id] = strings.Join(versions, ",")
+	}
+	return entries
+}
+
+func loadLibrariesIndexEntries(indexPath *paths.Path) indexEntries {
+	entries := indexEntries{}
+	index, err := librariesindex.LoadIndex(indexPath)
+	if err != nil {
+		return entries
+	}
+	for name, lib := range index.Libraries {
+		versions := []string{}
+		for version := range lib.Releases {
+			versions = append(versions, version)
+		}
+		sort.Strings(versions)
+		entries[name] = strings.Join(versions, ",")
+	}
+	return entries
+}
+
+// indexUpdate keeps track of the status of an index before its update, to
+// report the changes once the update is completed
+type indexUpdate struct {
+	url         string
+	indexPath   *paths.Path
+	loadEntries func(*paths.Path) indexEntries
+	oldEntries  indexEntries
+	oldChecksum []byte
+	oldModTime  time.Time
+}
+
+func startIndexUpdate(url string, indexPath *paths.Path, loadEntries func(*paths.Path) indexEntries) *indexUpdate {
+	u := &indexUpdate{
+		url:         url,
+		indexPath:   indexPath,
+		loadEntries: loadEntries,
+		oldEntries:  indexEntries{},
+	}
+	if info, err := indexPath.Stat(); err == nil {
+		u.oldModTime = info.ModTime()
+		u.oldChecksum = fileChecksum(indexPath)
+		u.oldEntries = loadEntries(indexPath)
+	}
+	return u
+}
+
+// report compares the updated index with its previous version. If the
+// content didn't change the previous modification time is restored.
+func (u *indexUpdate) report() *rpc.IndexUpdateReport {
+	report := &rpc.IndexUpdateReport{IndexUrl: u.url}
+	if !u.oldModTime.IsZero() {
+		report.OldLastModified = u.oldModTime.Format(time.RFC3339)
+	}
+	if u.oldChecksum != nil && bytes.Equal(u.oldChecksum, fileChecksum(u.indexPath)) {
+		_ = os.Chtimes(u.indexPath.String(), u.oldModTime, u.oldModTime)
+		report.NewLastModified = report.OldLastModified
+		return report
+	}
+
+	report.Modified = true
+	if info, err := u.indexPath.Stat(); err == nil {
+		report.NewLastModified = info.ModTime().Format(time.RFC3339)
+	}
+	newEntries := u.loadEntries(u.indexPath)
+	for id, releases := range newEntries {
+		if oldReleases, ok := u.oldEntries[id]; !ok {
+			report.NewPackages++
+		} else if oldReleases != releases {
+			report.UpdatedPackages++
+		}
+	}
+	for id := range u.oldEntries {
+		if _, ok := newEntries[id]; !ok {
+			report.RemovedPackages++
+		}
+	}
+	return report
+}
+
+func fileChecksum(file *paths.Path) []byte {
+	data, err := file.ReadFile()
+	if err != nil {
+		return nil
+	}
+	checksum := sha256.Sum256(data)
+	return checksum[:]
+}
@@ -0,0 +1,66 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexUpdateReport(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	indexPath := tmp.Join("library_index.json")
+
+	// First download
+	update := startIndexUpdate("https://example.com/library_index.json", indexPath, loadLibrariesIndexEntries)
+	require.NoError(t, indexPath.WriteFile([]byte(`{"libraries":[
+		{"name":"Updated","version":"1.0.0"},
+		{"name":"Unchanged","version":"1.0.0"},
+		{"name":"Removed","version":"1.0.0"}]}`)))
+	report := update.report()
+	require.True(t, report.GetModified())
+	require.Empty(t, report.GetOldLastModified())
+	require.NotEmpty(t, report.GetNewLastModified())
+	require.Equal(t, int32(3), report.GetNewPackages())
+
+	// Update with changes
+	update = startIndexUpdate("https://example.com/library_index.json", indexPath, loadLibrariesIndexEntries)
+	require.NoError(t, indexPath.WriteFile([]byte(`{"libraries":[
+		{"name":"Updated","version":"1.0.0"},
+		{"name":"Updated","version":"1.1.0"},
+		{"name":"Unchanged","version":"1.0.0"},
+		{"name":"Added","version":"1.0.0"}]}`)))
+	report = update.report()
+	require.True(t, report.GetModified())
+	require.NotEmpty(t, report.GetOldLastModified())
+	require.Equal(t, int32(1), report.GetNewPackages())
+	require.Equal(t, int32(1), report.GetUpdatedPackages())
+	require.Equal(t, int32(1), report.GetRemovedPackages())
+
+	// Update without changes
+	update = startIndexUpdate("https://example.com/library_index.json", indexPath, loadLibrariesIndexEntries)
+	data, err := indexPath.ReadFile()
+	require.NoError(t, err)
+	require.NoError(t, indexPath.WriteFile(data))
+	report = update.report()
+	require.False(t, report.GetModified())
+	require.Equal(t, report.GetOldLastModified(), report.GetNewLastModified())
+	require.Zero(t, report.GetNewPackages()+report.GetUpdatedPackages()+report.GetRemovedPackages())
+}
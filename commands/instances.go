@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"path"
 	"strings"
 
 	"github.com/arduino/arduino-cli/arduino"
@@ -344,7 +345,7 @@ func Init(req *rpc.InitRequest, responseCallback func(r *rpc.InitResponse)) erro
 	// Refreshes the locale used, this will change the
 	// language of the CLI if the locale is different
 	// after started.
-	i18n.Init(configuration.Settings.GetString("locale"))
+	i18n.Init(configuration.Settings.GetString("locale"), configuration.Settings.GetString("i18n.extra_catalogs"))
 
 	return nil
 }
@@ -361,21 +362,21 @@ func Destroy(ctx context.Context, req *rpc.DestroyRequest) (*rpc.DestroyResponse
 }
 
 // UpdateLibrariesIndex updates the library_index.json
-func UpdateLibrariesIndex(ctx context.Context, req *rpc.UpdateLibrariesIndexRequest, downloadCB rpc.DownloadProgressCB) error {
+func UpdateLibrariesIndex(ctx context.Context, req *rpc.UpdateLibrariesIndexRequest, downloadCB rpc.DownloadProgressCB) (*rpc.UpdateLibrariesIndexResponse, error) {
 	logrus.Info("Updating libraries index")
 	lm := GetLibraryManager(req.GetInstance().GetId())
 	if lm == nil {
-		return &arduino.InvalidInstanceError{}
+		return nil, &arduino.InvalidInstanceError{}
 	}
 
 	if err := lm.IndexFile.Parent().MkdirAll(); err != nil {
-		return &arduino.PermissionDeniedError{Message: tr("Could not create index directory"), Cause: err}
+		return nil, &arduino.PermissionDeniedError{Message: tr("Could not create index directory"), Cause: err}
 	}
 
 	// Create a temp dir to stage all downloads
 	tmp, err := paths.MkTempDir("", "library_index_download")
 	if err != nil {
-		return &arduino.TempDirCreationFailedError{Cause: err}
+		return nil, &arduino.TempDirCreationFailedError{Cause: err}
 	}
 	defer tmp.RemoveAll()
 
@@ -383,11 +384,16 @@ func UpdateLibrariesIndex(ctx context.Context, req *rpc.UpdateLibrariesIndexRequ
 		URL:          librariesmanager.LibraryIndexGZURL,
 		SignatureURL: librariesmanager.LibraryIndexSignature,
 	}
+	update := startIndexUpdate(indexResource.URL.String(), lm.IndexFile, loadLibrariesIndexEntries)
 	if err := indexResource.Download(lm.IndexFile.Parent(), downloadCB); err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	report := update.report()
+	report.SignatureStatus = rpc.IndexSignatureStatus_INDEX_SIGNATURE_STATUS_VERIFIED
+	return &rpc.UpdateLibrariesIndexResponse{
+		IndexUpdateReports: []*rpc.IndexUpdateReport{report},
+	}, nil
 }
 
 // UpdateIndex FIXMEDOC
@@ -400,6 +406,7 @@ func UpdateIndex(ctx context.Context, req *rpc.UpdateIndexRequest, downloadCB rp
 
 	indexpath := paths.New(configuration.Settings.GetString("directories.Data"))
 
+	reports := []*rpc.IndexUpdateReport{}
 	urls := []string{globals.DefaultIndexURL}
 	urls = append(urls, configuration.Settings.GetStringSlice("board_manager.additional_urls")...)
 	for _, u := range urls {
@@ -433,32 +440,50 @@ func UpdateIndex(ctx context.Context, req *rpc.UpdateIndexRequest, downloadCB rp
 		if strings.HasSuffix(URL.Host, "arduino.cc") {
 			indexResource.SignatureURL, _ = url.Parse(u) // should not fail because we already parsed it
 			indexResource.SignatureURL.Path += ".sig"
+		} else if key, err := configuration.IndexSignatureKeyPath(configuration.Settings, u); err != nil {
+			return nil, &arduino.InvalidArgumentError{Cause: err}
+		} else if key != nil {
+			// The signature is of the uncompressed index
+			indexResource.SignatureURL, _ = url.Parse(u) // should not fail because we already parsed it
+			indexResource.SignatureURL.Path = strings.TrimSuffix(indexResource.SignatureURL.Path, ".gz") + ".sig"
+			indexResource.SignatureKey = key
 		}
+		indexFileName := strings.TrimSuffix(path.Base(URL.Path), ".gz")
+		update := startIndexUpdate(URL.String(), indexpath.Join(indexFileName), loadPackageIndexEntries)
 		if err := indexResource.Download(indexpath, downloadCB); err != nil {
 			return nil, err
 		}
+		report := update.report()
+		if indexResource.SignatureURL != nil {
+			report.SignatureStatus = rpc.IndexSignatureStatus_INDEX_SIGNATURE_STATUS_VERIFIED
+		} else {
+			logrus.WithField("url", URL).Warn("Index signature not verified")
+			report.SignatureStatus = rpc.IndexSignatureStatus_INDEX_SIGNATURE_STATUS_UNSIGNED
+		}
+		reports = append(reports, report)
 	}
 
-	return &rpc.UpdateIndexResponse{}, nil
+	return &rpc.UpdateIndexResponse{IndexUpdateReports: reports}, nil
 }
 
 // UpdateCoreLibrariesIndex updates both Cores and Libraries indexes
-func UpdateCoreLibrariesIndex(ctx context.Context, req *rpc.UpdateCoreLibrariesIndexRequest, downloadCB rpc.DownloadProgressCB) error {
-	_, err := UpdateIndex(ctx, &rpc.UpdateIndexRequest{
+func UpdateCoreLibrariesIndex(ctx context.Context, req *rpc.UpdateCoreLibrariesIndexRequest, downloadCB rpc.DownloadProgressCB) (*rpc.UpdateCoreLibrariesIndexResponse, error) {
+	coreResp, err := UpdateIndex(ctx, &rpc.UpdateIndexRequest{
 		Instance: req.Instance,
 	}, downloadCB)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	err = UpdateLibrariesIndex(ctx, &rpc.UpdateLibrariesIndexRequest{
+	libResp, err := UpdateLibrariesIndex(ctx, &rpc.UpdateLibrariesIndexRequest{
 		Instance: req.Instance,
 	}, downloadCB)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	reports := append(coreResp.GetIndexUpdateReports(), libResp.GetIndexUpdateReports()...)
+	return &rpc.UpdateCoreLibrariesIndexResponse{IndexUpdateReports: reports}, nil
 }
 
 // Outdated returns a list struct containing both Core and Libraries that can be updated
@@ -0,0 +1,201 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// ListLibraryExamples returns the example sketches bundled with the
+// installed libraries with the given name.
+func ListLibraryExamples(ctx context.Context, req *rpc.ListLibraryExamplesRequest) (*rpc.ListLibraryExamplesResponse, error) {
+	if req.GetName() == "" {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Missing library name")}
+	}
+
+	listResp, err := LibraryList(ctx, &rpc.LibraryListRequest{
+		Instance: req.GetInstance(),
+		All:      true,
+		Name:     req.GetName(),
+		Fqbn:     req.GetFqbn(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(listResp.GetInstalledLibraries()) == 0 {
+		return nil, &arduino.LibraryNotFoundError{Library: req.GetName()}
+	}
+
+	res := []*rpc.LibraryExamples{}
+	for _, installed := range listResp.GetInstalledLibraries() {
+		lib := installed.GetLibrary()
+		installDir := paths.New(lib.GetInstallDir())
+		examples := []*rpc.LibraryExample{}
+		for _, example := range lib.GetExamples() {
+			examplePath := paths.New(example)
+			examples = append(examples, &rpc.LibraryExample{
+				Name:   examplePath.Base(),
+				Path:   examplePath.String(),
+				Folder: exampleFolder(installDir, examplePath),
+			})
+		}
+		sort.Slice(examples, func(i, j int) bool {
+			return strings.ToLower(examples[i].Folder) < strings.ToLower(examples[j].Folder)
+		})
+		res = append(res, &rpc.LibraryExamples{Library: lib, Examples: examples})
+	}
+	// Libraries in the sketchbook first
+	sort.SliceStable(res, func(i, j int) bool {
+		iUser := res[i].Library.Location == rpc.LibraryLocation_LIBRARY_LOCATION_USER
+		jUser := res[j].Library.Location == rpc.LibraryLocation_LIBRARY_LOCATION_USER
+		if iUser != jUser {
+			return iUser
+		}
+		return res[i].Library.InstallDir < res[j].Library.InstallDir
+	})
+	return &rpc.ListLibraryExamplesResponse{Libraries: res}, nil
+}
+
+// exampleFolder returns the path of the example relative to the examples
+// folder of the library, e.g. "01.Basics/Blink" for
+// "LIBRARY/examples/01.Basics/Blink".
+func exampleFolder(installDir, example *paths.Path) string {
+	rel, err := installDir.RelTo(example)
+	if err != nil {
+		return example.Base()
+	}
+	// Strip the "examples" or "example" folder
+	split := strings.SplitN(filepath.ToSlash(rel.String()), "/", 2)
+	if len(split) != 2 {
+		return example.Base()
+	}
+	return split[1]
+}
+
+// CopyLibraryExample copies an example of an installed library into a new
+// sketch, keeping the structure of its subfolders.
+func CopyLibraryExample(ctx context.Context, req *rpc.CopyLibraryExampleRequest) (*rpc.CopyLibraryExampleResponse, error) {
+	if req.GetExample() == "" {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Missing example name")}
+	}
+	examplesResp, err := ListLibraryExamples(ctx, &rpc.ListLibraryExamplesRequest{
+		Instance: req.GetInstance(),
+		Name:     req.GetLibrary(),
+		Fqbn:     req.GetFqbn(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	example, err := findLibraryExample(examplesResp.GetLibraries(), req.GetExample())
+	if err != nil {
+		return nil, err
+	}
+
+	destDir := paths.New(req.GetDestinationDir())
+	if req.GetDestinationDir() == "" {
+		destDir = paths.New(configuration.Settings.GetString("directories.User"))
+	}
+	return copyExample(example, destDir, req.GetSketchName())
+}
+
+// validSketchName matches the names allowed by the sketch specification, a
+// single path element
+var validSketchName = regexp.MustCompile(`^[0-9a-zA-Z][0-9a-zA-Z_.-]{0,62}$`)
+
+// copyExample copies the example into a new sketch in destDir, named
+// sketchName or after the example if sketchName is empty.
+func copyExample(example *rpc.LibraryExample, destDir *paths.Path, sketchName string) (*rpc.CopyLibraryExampleResponse, error) {
+	if sketchName != "" && !validSketchName.MatchString(sketchName) {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Invalid sketch name '%s': it must start with a letter or a number, followed by letters, numbers, underscores, dots and dashes, up to 63 characters", sketchName)}
+	}
+	exampleSketch, err := sketch.New(paths.New(example.GetPath()))
+	if err != nil {
+		return nil, &arduino.CantOpenSketchError{Cause: err}
+	}
+	if err := destDir.MkdirAll(); err != nil {
+		return nil, &arduino.CantCreateSketchError{Cause: err}
+	}
+	if sketchName == "" {
+		sketchName = example.GetName()
+	}
+	sketchPath := destDir.Join(sketchName)
+	for i := 1; sketchPath.Exist(); i++ {
+		sketchPath = destDir.Join(fmt.Sprintf("%s_%d", sketchName, i))
+	}
+	if err := exampleSketch.FullPath.CopyDirTo(sketchPath); err != nil {
+		return nil, &arduino.CantCreateSketchError{Cause: err}
+	}
+
+	// The main file must be named after the sketch folder
+	mainFile := sketchPath.Join(sketchPath.Base() + exampleSketch.MainFile.Ext())
+	if copiedMainFile := sketchPath.Join(exampleSketch.MainFile.Base()); !copiedMainFile.EqualsTo(mainFile) {
+		if err := copiedMainFile.Rename(mainFile); err != nil {
+			return nil, &arduino.CantCreateSketchError{Cause: err}
+		}
+	}
+	if sketchPath, err = sketchPath.Abs(); err != nil {
+		return nil, &arduino.CantCreateSketchError{Cause: err}
+	}
+
+	return &rpc.CopyLibraryExampleResponse{
+		SketchPath: sketchPath.String(),
+		MainFile:   sketchPath.Join(mainFile.Base()).String(),
+	}, nil
+}
+
+// findLibraryExample returns the example with the given folder or, failing
+// that, with the given name. The libraries are searched in order.
+func findLibraryExample(libs []*rpc.LibraryExamples, example string) (*rpc.LibraryExample, error) {
+	for _, lib := range libs {
+		for _, candidate := range lib.GetExamples() {
+			if candidate.GetFolder() == example {
+				return candidate, nil
+			}
+		}
+	}
+	for _, lib := range libs {
+		found := []*rpc.LibraryExample{}
+		for _, candidate := range lib.GetExamples() {
+			if candidate.GetName() == example {
+				found = append(found, candidate)
+			}
+		}
+		if len(found) == 1 {
+			return found[0], nil
+		}
+		if len(found) > 1 {
+			folders := []string{}
+			for _, candidate := range found {
+				folders = append(folders, candidate.GetFolder())
+			}
+			return nil, &arduino.InvalidArgumentError{
+				Message: tr("More than one example named %[1]s, use one of: %[2]s", example, strings.Join(folders, ", ")),
+			}
+		}
+	}
+	return nil, &arduino.NotFoundError{Message: tr("Example %[1]s not found in library %[2]s", example, libs[0].GetLibrary().GetName())}
+}
@@ -0,0 +1,111 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLibraryExamplesFolders(t *testing.T) {
+	libDir, err := paths.MkTempDir("", "test_library_examples")
+	require.NoError(t, err)
+	defer libDir.RemoveAll()
+	libDir = libDir.Join("MyLib")
+
+	addSketch := func(dir *paths.Path) {
+		require.NoError(t, dir.MkdirAll())
+		require.NoError(t, dir.Join(dir.Base()+".ino").WriteFile([]byte("void setup() {}\nvoid loop() {}\n")))
+	}
+	require.NoError(t, libDir.Join("src").MkdirAll())
+	require.NoError(t, libDir.Join("library.properties").WriteFile([]byte("name=MyLib\nversion=1.0.0\n")))
+	addSketch(libDir.Join("examples", "01.Basics", "Blink"))
+	addSketch(libDir.Join("examples", "Fade"))
+	addSketch(libDir.Join("example", "Old"))
+
+	lib, err := libraries.Load(libDir, libraries.User)
+	require.NoError(t, err)
+	folders := []string{}
+	for _, example := range lib.Examples {
+		folders = append(folders, exampleFolder(libDir, example))
+	}
+	require.ElementsMatch(t, []string{"01.Basics/Blink", "Fade", "Old"}, folders)
+}
+
+func TestCopyLibraryExample(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "test_copy_library_example")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	exampleDir := tmp.Join("MyLib", "examples", "01.Basics", "Blink")
+	require.NoError(t, exampleDir.Join("data").MkdirAll())
+	require.NoError(t, exampleDir.Join("Blink.ino").WriteFile([]byte("void setup() {}\nvoid loop() {}\n")))
+	require.NoError(t, exampleDir.Join("data", "config.txt").WriteFile([]byte("config")))
+	example := &rpc.LibraryExample{Name: "Blink", Path: exampleDir.String(), Folder: "01.Basics/Blink"}
+
+	destDir := tmp.Join("sketchbook")
+	res, err := copyExample(example, destDir, "")
+	require.NoError(t, err)
+	require.Equal(t, destDir.Join("Blink").String(), res.GetSketchPath())
+	require.Equal(t, destDir.Join("Blink", "Blink.ino").String(), res.GetMainFile())
+	require.True(t, destDir.Join("Blink", "data", "config.txt").Exist())
+
+	// Name collisions are resolved with a suffix, and the main file is renamed
+	res, err = copyExample(example, destDir, "")
+	require.NoError(t, err)
+	require.Equal(t, destDir.Join("Blink_1", "Blink_1.ino").String(), res.GetMainFile())
+	require.True(t, destDir.Join("Blink_1", "data", "config.txt").Exist())
+	require.True(t, destDir.Join("Blink_1", "Blink.ino").NotExist())
+
+	res, err = copyExample(example, destDir, "MyBlink")
+	require.NoError(t, err)
+	require.Equal(t, destDir.Join("MyBlink", "MyBlink.ino").String(), res.GetMainFile())
+
+	// The sketch name must be a single valid path element
+	for _, name := range []string{"../Evil", "sub/Evil", "..", ".hidden", "My Blink", strings.Repeat("a", 64)} {
+		_, err = copyExample(example, destDir, name)
+		require.Error(t, err, name)
+	}
+	require.True(t, tmp.Join("Evil").NotExist())
+}
+
+func TestFindLibraryExample(t *testing.T) {
+	libs := []*rpc.LibraryExamples{
+		{
+			Library: &rpc.Library{Name: "MyLib"},
+			Examples: []*rpc.LibraryExample{
+				{Name: "Blink", Folder: "01.Basics/Blink"},
+				{Name: "Blink", Folder: "02.Advanced/Blink"},
+				{Name: "Fade", Folder: "Fade"},
+			},
+		},
+	}
+	example, err := findLibraryExample(libs, "02.Advanced/Blink")
+	require.NoError(t, err)
+	require.Equal(t, "02.Advanced/Blink", example.GetFolder())
+	example, err = findLibraryExample(libs, "Fade")
+	require.NoError(t, err)
+	require.Equal(t, "Fade", example.GetFolder())
+	_, err = findLibraryExample(libs, "Blink")
+	require.Error(t, err)
+	_, err = findLibraryExample(libs, "Missing")
+	require.Error(t, err)
+}
@@ -18,17 +18,23 @@ package lib
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
 	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/metrics"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
 	"github.com/sirupsen/logrus"
 )
 
 // LibraryInstall FIXMEDOC
-func LibraryInstall(ctx context.Context, req *rpc.LibraryInstallRequest, downloadCB rpc.DownloadProgressCB, taskCB rpc.TaskProgressCB) error {
+func LibraryInstall(ctx context.Context, req *rpc.LibraryInstallRequest, downloadCB rpc.DownloadProgressCB, taskCB rpc.TaskProgressCB) (e error) {
+	start := time.Now()
+	defer func() { metrics.ObserveCommandDuration("lib_install", start, e) }()
 
 	lm := commands.GetLibraryManager(req.GetInstance().GetId())
 	if lm == nil {
@@ -123,10 +129,23 @@ func ZipLibraryInstall(ctx context.Context, req *rpc.ZipLibraryInstallRequest, t
 	return nil
 }
 
+// LocalLibraryInstall installs a library from a local directory as a development link
+func LocalLibraryInstall(ctx context.Context, req *rpc.LocalLibraryInstallRequest, taskCB rpc.TaskProgressCB) error {
+	lm := commands.GetLibraryManager(req.GetInstance().GetId())
+	if err := lm.InstallLocalLibLink(paths.New(req.GetPath()), req.GetOverwrite()); err != nil {
+		return &arduino.FailedLibraryInstallError{Cause: err}
+	}
+	taskCB(&rpc.TaskProgress{Message: tr("Library linked"), Completed: true})
+	return nil
+}
+
 //GitLibraryInstall FIXMEDOC
 func GitLibraryInstall(ctx context.Context, req *rpc.GitLibraryInstallRequest, taskCB rpc.TaskProgressCB) error {
+	if configuration.NetworkOffline(configuration.Settings) {
+		return &arduino.OfflineModeError{}
+	}
 	lm := commands.GetLibraryManager(req.GetInstance().GetId())
-	if err := lm.InstallGitLib(req.Url, req.Overwrite); err != nil {
+	if err := lm.InstallGitLib(req.GetUrl(), req.GetRef(), req.GetOverwrite()); err != nil {
 		return &arduino.FailedLibraryInstallError{Cause: err}
 	}
 	taskCB(&rpc.TaskProgress{Message: tr("Library installed"), Completed: true})
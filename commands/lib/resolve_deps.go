@@ -21,6 +21,7 @@ import (
 
 	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
 	"github.com/arduino/arduino-cli/commands"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 )
@@ -31,7 +32,10 @@ func LibraryResolveDependencies(ctx context.Context, req *rpc.LibraryResolveDepe
 	if lm == nil {
 		return nil, &arduino.InvalidInstanceError{}
 	}
+	return resolveLibraryDependencies(lm, req)
+}
 
+func resolveLibraryDependencies(lm *librariesmanager.LibrariesManager, req *rpc.LibraryResolveDependenciesRequest) (*rpc.LibraryResolveDependenciesResponse, error) {
 	// Search the requested lib
 	reqLibRelease, err := findLibraryIndexRelease(lm, req)
 	if err != nil {
@@ -61,6 +65,11 @@ func LibraryResolveDependencies(ctx context.Context, req *rpc.LibraryResolveDepe
 		return nil, &arduino.LibraryDependenciesResolutionFailedError{}
 	}
 
+	resolved := map[string]bool{}
+	for _, dep := range deps {
+		resolved[dep.GetName()] = true
+	}
+
 	res := []*rpc.LibraryDependencyStatus{}
 	for _, dep := range deps {
 		// ...and add information on currently installed versions of the libraries
@@ -68,10 +77,19 @@ func LibraryResolveDependencies(ctx context.Context, req *rpc.LibraryResolveDepe
 		if installedLib, has := installedLibs[dep.GetName()]; has {
 			installed = installedLib.Version.String()
 		}
+		// ...and the edges of the dependency graph
+		dependsOn := []string{}
+		for _, d := range dep.GetDependencies() {
+			if resolved[d.GetName()] {
+				dependsOn = append(dependsOn, d.GetName())
+			}
+		}
 		res = append(res, &rpc.LibraryDependencyStatus{
 			Name:             dep.GetName(),
 			VersionRequired:  dep.GetVersion().String(),
 			VersionInstalled: installed,
+			DependsOn:        dependsOn,
+			AlreadyInstalled: installed != "" && installed == dep.GetVersion().String(),
 		})
 	}
 	return &rpc.LibraryResolveDependenciesResponse{Dependencies: res}, nil
@@ -0,0 +1,49 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveLibraryDependencies(t *testing.T) {
+	lm := librariesmanager.NewLibraryManager(paths.New("testdata", "deps"), nil)
+	require.NoError(t, lm.LoadIndex())
+
+	resp, err := resolveLibraryDependencies(lm, &rpc.LibraryResolveDependenciesRequest{
+		Instance: &rpc.Instance{Id: 1},
+		Name:     "Top",
+	})
+	require.NoError(t, err)
+
+	deps := map[string]*rpc.LibraryDependencyStatus{}
+	for _, dep := range resp.GetDependencies() {
+		deps[dep.GetName()] = dep
+	}
+	require.Len(t, deps, 3)
+	require.Equal(t, []string{"Middle"}, deps["Top"].GetDependsOn())
+	require.Equal(t, []string{"Bottom"}, deps["Middle"].GetDependsOn())
+	require.Empty(t, deps["Bottom"].GetDependsOn())
+	for _, dep := range deps {
+		require.Equal(t, "1.0.0", dep.GetVersionRequired())
+		require.False(t, dep.GetAlreadyInstalled())
+	}
+}
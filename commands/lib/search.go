@@ -17,6 +17,8 @@ package lib
 
 import (
 	"context"
+	"sort"
+	"strings"
 
 	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
@@ -40,17 +42,75 @@ func searchLibrary(req *rpc.LibrarySearchRequest, lm *librariesmanager.Libraries
 	res := []*rpc.SearchedLibrary{}
 	status := rpc.LibrarySearchStatus_LIBRARY_SEARCH_STATUS_SUCCESS
 
+	queryWords := strings.Fields(utils.NormalizeForSearch(req.GetQuery()))
 	for _, lib := range lm.Index.Libraries {
-		toTest := []string{lib.Name, lib.Latest.Paragraph, lib.Latest.Sentence}
-		if !utils.MatchAny(req.GetQuery(), toTest) {
+		score, ok := librarySearchScore(lib, queryWords)
+		if !ok {
 			continue
 		}
-		res = append(res, indexLibraryToRPCSearchLibrary(lib))
+		searchedLib := indexLibraryToRPCSearchLibrary(lib)
+		searchedLib.Score = score
+		res = append(res, searchedLib)
 	}
 
+	// Best matches first
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].Score != res[j].Score {
+			return res[i].Score > res[j].Score
+		}
+		return res[i].Name < res[j].Name
+	})
+
 	return &rpc.LibrarySearchResponse{Libraries: res, Status: status}
 }
 
+// Weights of the matches of a query word in each field of a library, the
+// name counts more than the author that counts more than the description.
+// An exact match of the whole name beats any other match.
+const (
+	exactNameMatchScore   = 100000
+	nameMatchScore        = 100
+	authorMatchScore      = 10
+	descriptionMatchScore = 1
+)
+
+// librarySearchScore returns how well the library matches the query words,
+// false is returned if any word doesn't match at least one of the fields of
+// the library. Words contained in a field count twice as much as the words
+// that match it approximately.
+func librarySearchScore(lib *librariesindex.Library, queryWords []string) (int32, bool) {
+	name := utils.NormalizeForSearch(lib.Name)
+	fields := []struct {
+		text   string
+		weight int32
+	}{
+		{name, nameMatchScore},
+		{utils.NormalizeForSearch(lib.Latest.Author + " " + lib.Latest.Maintainer), authorMatchScore},
+		{utils.NormalizeForSearch(lib.Latest.Sentence + " " + lib.Latest.Paragraph), descriptionMatchScore},
+	}
+
+	score := int32(0)
+	if len(queryWords) > 0 && strings.Join(queryWords, " ") == name {
+		score += exactNameMatchScore
+	}
+	for _, word := range queryWords {
+		wordMatches := false
+		for _, field := range fields {
+			if matches, exact := utils.FuzzyMatch(word, field.text); exact {
+				score += 2 * field.weight
+				wordMatches = true
+			} else if matches {
+				score += field.weight
+				wordMatches = true
+			}
+		}
+		if !wordMatches {
+			return 0, false
+		}
+	}
+	return score, true
+}
+
 // indexLibraryToRPCSearchLibrary converts a librariindex.Library to rpc.SearchLibrary
 func indexLibraryToRPCSearchLibrary(lib *librariesindex.Library) *rpc.SearchedLibrary {
 	releases := map[string]*rpc.LibraryRelease{}
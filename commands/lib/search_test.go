@@ -4,10 +4,14 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	"github.com/arduino/arduino-cli/arduino/resources"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	paths "github.com/arduino/go-paths-helper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	semver "go.bug.st/relaxed-semver"
 )
 
 var customIndexPath = paths.New("testdata")
@@ -49,3 +53,49 @@ func TestSearchLibrarySimilar(t *testing.T) {
 	assert.Contains(libs, "ArduinoTestPackage")
 	assert.Contains(libs, "Arduino")
 }
+
+func TestSearchLibraryRanking(t *testing.T) {
+	lm := librariesmanager.NewLibraryManager(nil, nil)
+	lm.Index = &librariesindex.Index{Libraries: map[string]*librariesindex.Library{}}
+	addLibrary := func(name, author, sentence string) {
+		release := &librariesindex.Release{
+			Author:   author,
+			Version:  semver.MustParse("1.0.0"),
+			Sentence: sentence,
+			Resource: &resources.DownloadResource{},
+		}
+		lm.Index.Libraries[name] = &librariesindex.Library{
+			Name:     name,
+			Releases: map[string]*librariesindex.Release{"1.0.0": release},
+			Latest:   release,
+		}
+	}
+	addLibrary("Servo", "Michael Margolis", "Allows Arduino boards to control a variety of servo motors.")
+	addLibrary("ServoEasing", "Armin Joachimsmeyer", "Easy servo movements.")
+	addLibrary("Motor Controller", "Servo Labs", "Drives DC motors.")
+	addLibrary("Stepper", "Arduino", "Drives stepper motors, not a servo.")
+	addLibrary("Arduino_JSON", "Arduino", "Process JSON in your Arduino sketches.")
+
+	search := func(query string) []string {
+		resp := searchLibrary(&rpc.LibrarySearchRequest{Query: query}, lm)
+		names := []string{}
+		for i, lib := range resp.GetLibraries() {
+			if i > 0 {
+				require.GreaterOrEqual(t, resp.GetLibraries()[i-1].Score, lib.Score)
+			}
+			names = append(names, lib.Name)
+		}
+		return names
+	}
+
+	// Exact name, then name, then author, then description matches
+	require.Equal(t, []string{"Servo", "ServoEasing", "Motor Controller", "Stepper"}, search("servo"))
+	// Near misses are found too
+	require.Equal(t, []string{"Servo", "ServoEasing", "Motor Controller", "Stepper"}, search("sevro"))
+	require.Equal(t, []string{"Arduino_JSON"}, search("arduino jsno"))
+	// Every word must match
+	require.Equal(t, []string{"Stepper"}, search("stepper servo"))
+	require.Empty(t, search("xyz"))
+	// Without a query all the libraries are returned, sorted by name
+	require.Equal(t, []string{"Arduino_JSON", "Motor Controller", "Servo", "ServoEasing", "Stepper"}, search(""))
+}
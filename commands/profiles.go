@@ -0,0 +1,111 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// ResolveSketchProfile resolves the platforms and the libraries pinned by a
+// sketch profile using the installed ones: nothing is installed, if a pinned
+// platform or library is not installed an error is returned. If profileName is
+// empty the default profile of the sketch is resolved, nil is returned if the
+// sketch has no default profile.
+func ResolveSketchProfile(instance *rpc.Instance, sk *sketch.Sketch, profileName string) (*rpc.ResolvedProfile, error) {
+	profile := sk.GetProfile(profileName)
+	if profile == nil {
+		if profileName == "" {
+			return nil, nil
+		}
+		available := []string{}
+		if sk.Project != nil {
+			available = sk.Project.ProfileNames()
+		}
+		return nil, &arduino.InvalidArgumentError{
+			Message: tr("Profile '%[1]s' not found in the sketch (available profiles: %[2]s)", profileName, strings.Join(available, ", ")),
+		}
+	}
+
+	pm := GetPackageManager(instance.GetId())
+	if pm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	lm := GetLibraryManager(instance.GetId())
+	if lm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+
+	res := &rpc.ResolvedProfile{
+		Name:      profile.Name,
+		Fqbn:      profile.FQBN,
+		Platforms: []*rpc.PlatformReference{},
+		Libraries: []*rpc.ProfileLibraryReference{},
+	}
+	if res.Fqbn == "" {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Missing FQBN in profile '%s'", profile.Name)}
+	}
+
+	for _, ref := range profile.Platforms {
+		platform := pm.FindPlatform(&packagemanager.PlatformReference{
+			Package:              ref.Packager,
+			PlatformArchitecture: ref.Architecture,
+		})
+		if platform == nil || pm.GetInstalledPlatformRelease(platform) == nil {
+			return nil, &arduino.PlatformNotFoundError{
+				Platform: ref.String(),
+				Cause:    fmt.Errorf(tr("required by profile '%s', platform not installed"), profile.Name),
+			}
+		}
+		installed := pm.GetInstalledPlatformRelease(platform)
+		if !installed.Version.Equal(ref.Version) {
+			return nil, &arduino.PlatformNotFoundError{
+				Platform: ref.String(),
+				Cause:    fmt.Errorf(tr("required by profile '%[1]s', the installed version is %[2]s"), profile.Name, installed.Version),
+			}
+		}
+		platformRef := &rpc.PlatformReference{
+			Id:      ref.Packager + ":" + ref.Architecture,
+			Version: installed.Version.String(),
+		}
+		if ref.PlatformIndexURL != nil {
+			platformRef.PlatformIndexUrl = ref.PlatformIndexURL.String()
+		}
+		res.Platforms = append(res.Platforms, platformRef)
+	}
+
+	for _, ref := range profile.Libraries {
+		lib := lm.FindByReference(&librariesindex.Reference{Name: ref.Library, Version: ref.Version})
+		if lib == nil {
+			return nil, &arduino.LibraryNotFoundError{
+				Library: ref.String(),
+				Cause:   fmt.Errorf(tr("required by profile '%s', library not installed"), profile.Name),
+			}
+		}
+		res.Libraries = append(res.Libraries, &rpc.ProfileLibraryReference{
+			Name:       lib.Name,
+			Version:    lib.Version.String(),
+			InstallDir: lib.InstallDir.String(),
+		})
+	}
+	return res, nil
+}
@@ -70,6 +70,18 @@ func ArchiveSketch(ctx context.Context, req *rpc.ArchiveSketchRequest) (*rpc.Arc
 		return nil, &arduino.InvalidArgumentError{Message: tr("Archive already exists")}
 	}
 
+	var usedLibraries []*rpc.Library
+	if req.GetIncludeLibraries() {
+		if sketchPath.Join(librariesFolder).Exist() {
+			return nil, &arduino.InvalidArgumentError{Message: tr("The sketch already contains a %s folder", librariesFolder)}
+		}
+		libs, err := resolveSketchLibraries(ctx, req, s)
+		if err != nil {
+			return nil, err
+		}
+		usedLibraries = libs
+	}
+
 	filesToZip, err := sketchPath.ReadDirRecursive()
 	if err != nil {
 		return nil, &arduino.PermissionDeniedError{Message: tr("Error reading sketch files"), Cause: err}
@@ -106,11 +118,25 @@ func ArchiveSketch(ctx context.Context, req *rpc.ArchiveSketchRequest) (*rpc.Arc
 		}
 	}
 
-	return &rpc.ArchiveSketchResponse{}, nil
+	resp := &rpc.ArchiveSketchResponse{}
+	if req.GetIncludeLibraries() {
+		archivedLibs, err := addLibrariesToSketchArchive(zipWriter, sketchName, usedLibraries)
+		if err != nil {
+			return nil, &arduino.PermissionDeniedError{Message: tr("Error adding libraries to sketch archive"), Cause: err}
+		}
+		resp.ArchivedLibraries = archivedLibs
+	}
+	return resp, nil
 }
 
 // Adds a single file to an existing zip file
 func addFileToSketchArchive(zipWriter *zip.Writer, filePath, sketchPath *paths.Path) error {
+	return addFileToSketchArchiveAs(zipWriter, filePath, sketchPath, nil)
+}
+
+// Adds a single file to an existing zip file, the file is placed in the archive
+// at its path relative to baseDir, inside the (optional) archiveDir folder
+func addFileToSketchArchiveAs(zipWriter *zip.Writer, filePath, baseDir, archiveDir *paths.Path) error {
 	f, err := filePath.Open()
 	if err != nil {
 		return err
@@ -127,10 +153,13 @@ func addFileToSketchArchive(zipWriter *zip.Writer, filePath, sketchPath *paths.P
 		return err
 	}
 
-	filePath, err = sketchPath.RelTo(filePath)
+	filePath, err = baseDir.RelTo(filePath)
 	if err != nil {
 		return err
 	}
+	if archiveDir != nil {
+		filePath = archiveDir.JoinPath(filePath)
+	}
 
 	header.Name = filePath.String()
 	header.Method = zip.Deflate
@@ -0,0 +1,157 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/commands"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// ExtractSketch extracts a sketch archive created by ArchiveSketch, the libraries
+// bundled in the archive are installed in the user libraries folder
+func ExtractSketch(ctx context.Context, req *rpc.ExtractSketchRequest) (_ *rpc.ExtractSketchResponse, e error) {
+	archivePath := paths.New(req.GetArchivePath())
+	if archivePath == nil || !archivePath.Exist() {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Sketch archive not found")}
+	}
+	destDir := paths.New(req.GetDestinationPath())
+	if destDir == nil {
+		destDir = archivePath.Parent()
+	}
+	destDir, err := destDir.Clean().Abs()
+	if err != nil {
+		return nil, &arduino.PermissionDeniedError{Message: tr("Error getting absolute path of destination folder"), Cause: err}
+	}
+
+	archive, err := zip.OpenReader(archivePath.String())
+	if err != nil {
+		return nil, &arduino.CantOpenSketchError{Cause: err}
+	}
+	defer archive.Close()
+
+	// The archive unpacks as a single folder containing the sketch
+	sketchName := ""
+	for _, f := range archive.File {
+		name := strings.SplitN(filepath.ToSlash(f.Name), "/", 2)[0]
+		if sketchName == "" {
+			sketchName = name
+		} else if name != sketchName {
+			return nil, &arduino.InvalidArgumentError{Message: tr("The archive doesn't contain a single sketch folder")}
+		}
+	}
+	if sketchName == "" {
+		return nil, &arduino.InvalidArgumentError{Message: tr("The archive is empty")}
+	}
+	sketchPath := destDir.Join(sketchName)
+	if sketchPath.Exist() {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Sketch %s already exists", sketchPath)}
+	}
+	// Don't leave a partially extracted sketch
+	defer func() {
+		if e != nil {
+			sketchPath.RemoveAll()
+		}
+	}()
+
+	for _, f := range archive.File {
+		if err := extractArchiveFile(f, destDir); err != nil {
+			return nil, &arduino.PermissionDeniedError{Message: tr("Error extracting %s", f.Name), Cause: err}
+		}
+	}
+
+	resp := &rpc.ExtractSketchResponse{SketchPath: sketchPath.String()}
+	librariesPath := sketchPath.Join(librariesFolder)
+	manifestPath := librariesPath.Join(librariesManifestFileName)
+	if manifestPath.NotExist() {
+		return resp, nil
+	}
+
+	lm := commands.GetLibraryManager(req.GetInstance().GetId())
+	if lm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	data, err := manifestPath.ReadFile()
+	if err != nil {
+		return nil, &arduino.PermissionDeniedError{Message: tr("Error reading the libraries manifest"), Cause: err}
+	}
+	var manifest librariesManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, &arduino.InvalidArgumentError{Message: tr("Invalid libraries manifest"), Cause: err}
+	}
+	for _, lib := range manifest.Libraries {
+		if version, err := semver.Parse(lib.Version); err == nil &&
+			lm.FindByReference(&librariesindex.Reference{Name: lib.Name, Version: version}) != nil {
+			resp.SkippedLibraries = append(resp.SkippedLibraries, lib.toRPC())
+			continue
+		}
+		// The manifest comes from the archive, the folder must be one of the
+		// bundled libraries
+		libPath := librariesPath.Join(lib.Folder).Clean()
+		if inside, err := libPath.IsInsideDir(librariesPath); err != nil || !inside {
+			return nil, &arduino.InvalidArgumentError{Message: tr("Invalid library folder in the libraries manifest: %s", lib.Folder)}
+		}
+		if err := lm.InstallLibraryDir(libPath, false); err != nil {
+			return nil, &arduino.FailedLibraryInstallError{Cause: err}
+		}
+		resp.InstalledLibraries = append(resp.InstalledLibraries, lib.toRPC())
+	}
+
+	// The bundled libraries are now installed, they are no more needed in the sketch
+	if err := librariesPath.RemoveAll(); err != nil {
+		return nil, &arduino.PermissionDeniedError{Message: tr("Error removing %s", librariesPath), Cause: err}
+	}
+	return resp, nil
+}
+
+// extractArchiveFile extracts a single file of a zip archive in destDir
+func extractArchiveFile(f *zip.File, destDir *paths.Path) error {
+	target := destDir.Join(filepath.FromSlash(f.Name))
+	if inside, err := target.IsInsideDir(destDir); err != nil {
+		return err
+	} else if !inside {
+		return &arduino.InvalidArgumentError{Message: tr("Invalid path in archive: %s", f.Name)}
+	}
+	if f.FileInfo().IsDir() {
+		return target.MkdirAll()
+	}
+	if err := target.Parent().MkdirAll(); err != nil {
+		return err
+	}
+
+	in, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := target.Create()
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	_, err = io.Copy(out, in)
+	return err
+}
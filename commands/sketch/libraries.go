@@ -0,0 +1,161 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/commands/compile"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// librariesFolder is the folder of a sketch archive containing the bundled libraries
+const librariesFolder = "libraries"
+
+// librariesManifestFileName is the name of the file, inside the librariesFolder,
+// recording the versions of the bundled libraries
+const librariesManifestFileName = "manifest.json"
+
+type librariesManifest struct {
+	Libraries []*manifestLibrary `json:"libraries"`
+}
+
+type manifestLibrary struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Folder  string `json:"folder"`
+}
+
+func (l *manifestLibrary) toRPC() *rpc.ArchivedLibrary {
+	return &rpc.ArchivedLibrary{Name: l.Name, Version: l.Version, Folder: l.Folder}
+}
+
+// resolveSketchLibraries runs the library detection of the builder and returns
+// the libraries used by the sketch that are not bundled with the platform
+func resolveSketchLibraries(ctx context.Context, req *rpc.ArchiveSketchRequest, s *sketch.Sketch) ([]*rpc.Library, error) {
+	fqbn, err := sketchFQBN(req.GetFqbn(), s)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := resolveSketchDependencies(ctx, req.GetInstance(), fqbn, s)
+	if err != nil {
+		return nil, err
+	}
+	return nonPlatformLibraries(resp.GetUsedLibraries()), nil
+}
+
+// sketchFQBN returns the given FQBN or, if empty, the FQBN of the board attached
+// to the sketch
+func sketchFQBN(fqbn string, s *sketch.Sketch) (string, error) {
+	if fqbn == "" && s.Metadata != nil {
+		fqbn = s.Metadata.CPU.Fqbn
+	}
+	if fqbn == "" {
+		return "", &arduino.MissingFQBNError{}
+	}
+	return fqbn, nil
+}
+
+// resolveSketchDependencies runs the library detection of the builder, the
+// response reports the platforms and the libraries used to build the sketch
+func resolveSketchDependencies(ctx context.Context, instance *rpc.Instance, fqbn string, s *sketch.Sketch) (*rpc.CompileResponse, error) {
+	buildPath, err := paths.MkTempDir("", "arduino-sketch-dependencies")
+	if err != nil {
+		return nil, &arduino.TempDirCreationFailedError{Cause: err}
+	}
+	defer buildPath.RemoveAll()
+
+	return compile.Compile(ctx, &rpc.CompileRequest{
+		Instance:   instance,
+		Fqbn:       fqbn,
+		SketchPath: s.FullPath.String(),
+		BuildPath:  buildPath.String(),
+		Preprocess: true,
+	}, ioutil.Discard, ioutil.Discard, nil, false)
+}
+
+// nonPlatformLibraries filters out the libraries bundled with the platform
+func nonPlatformLibraries(usedLibraries []*rpc.Library) []*rpc.Library {
+	libs := []*rpc.Library{}
+	for _, lib := range usedLibraries {
+		switch lib.GetLocation() {
+		case rpc.LibraryLocation_LIBRARY_LOCATION_PLATFORM_BUILTIN, rpc.LibraryLocation_LIBRARY_LOCATION_REFERENCED_PLATFORM_BUILTIN:
+			// Provided by the platform
+			continue
+		}
+		libs = append(libs, lib)
+	}
+	return libs
+}
+
+// addLibrariesToSketchArchive adds the given libraries, and the manifest recording
+// their versions, to the librariesFolder of the sketch archive
+func addLibrariesToSketchArchive(zipWriter *zip.Writer, sketchName string, libs []*rpc.Library) ([]*rpc.ArchivedLibrary, error) {
+	librariesPath := paths.New(sketchName, librariesFolder)
+	manifest := &librariesManifest{Libraries: []*manifestLibrary{}}
+	for _, lib := range libs {
+		libDir := paths.New(lib.GetInstallDir())
+		files, err := libDir.ReadDirRecursive()
+		if err != nil {
+			return nil, err
+		}
+		files.FilterOutDirs()
+		for _, f := range files {
+			if relPath, err := libDir.RelTo(f); err != nil {
+				return nil, err
+			} else if strings.HasPrefix(relPath.String(), ".git"+string(filepath.Separator)) {
+				// Skip the repository of libraries installed from git
+				continue
+			}
+			// Adding the file relative to the parent of the library folder, makes it
+			// appear in librariesFolder/LibraryFolderName
+			if err := addFileToSketchArchiveAs(zipWriter, f, libDir.Parent(), librariesPath); err != nil {
+				return nil, err
+			}
+		}
+		manifest.Libraries = append(manifest.Libraries, &manifestLibrary{
+			Name:    lib.GetName(),
+			Version: lib.GetVersion(),
+			Folder:  libDir.Base(),
+		})
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	writer, err := zipWriter.Create(filepath.ToSlash(librariesPath.Join(librariesManifestFileName).String()))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := writer.Write(data); err != nil {
+		return nil, err
+	}
+
+	res := []*rpc.ArchivedLibrary{}
+	for _, lib := range manifest.Libraries {
+		res = append(res, lib.toRPC())
+	}
+	return res, nil
+}
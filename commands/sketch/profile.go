@@ -0,0 +1,129 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/cli/globals"
+	"github.com/arduino/arduino-cli/commands"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// DumpSketchProfile generates a sketch profile pinning the platforms and the
+// libraries, with the versions currently installed, used to build the sketch
+func DumpSketchProfile(ctx context.Context, req *rpc.DumpSketchProfileRequest) (*rpc.DumpSketchProfileResponse, error) {
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+
+	sketchPath := paths.New(req.GetSketchPath())
+	if sketchPath == nil {
+		sketchPath = paths.New(".")
+	}
+	s, err := sketch.New(sketchPath)
+	if err != nil {
+		return nil, &arduino.CantOpenSketchError{Cause: err}
+	}
+
+	fqbnIn, err := sketchFQBN(req.GetFqbn(), s)
+	if err != nil {
+		return nil, err
+	}
+	fqbn, err := cores.ParseFQBN(fqbnIn)
+	if err != nil {
+		return nil, &arduino.InvalidFQBNError{Cause: err}
+	}
+	profileName := req.GetProfileName()
+	if profileName == "" {
+		profileName = fqbn.BoardID
+	}
+
+	compileResp, err := resolveSketchDependencies(ctx, req.GetInstance(), fqbnIn, s)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &sketch.Profile{Name: profileName, FQBN: fqbnIn}
+	res := &rpc.DumpSketchProfileResponse{
+		Profile: &rpc.ResolvedProfile{
+			Name:      profileName,
+			Fqbn:      fqbnIn,
+			Platforms: []*rpc.PlatformReference{},
+			Libraries: []*rpc.ProfileLibraryReference{},
+		},
+		UnversionedLibraries: []string{},
+	}
+
+	// The platform of the board comes first, followed by the referenced platform
+	// used for the build (if any)
+	usedPlatforms := []*rpc.PlatformReference{compileResp.GetBoardPlatform()}
+	if build := compileResp.GetBuildPlatform(); build != nil && build.GetId() != compileResp.GetBoardPlatform().GetId() {
+		usedPlatforms = append(usedPlatforms, build)
+	}
+	for _, used := range usedPlatforms {
+		if used == nil {
+			continue
+		}
+		split := strings.SplitN(used.GetId(), ":", 2)
+		version, err := semver.Parse(used.GetVersion())
+		if len(split) != 2 || err != nil {
+			return nil, &arduino.PlatformNotFoundError{Platform: used.GetId() + "@" + used.GetVersion(), Cause: err}
+		}
+		ref := &sketch.ProfilePlatformReference{
+			Packager:     split[0],
+			Architecture: split[1],
+			Version:      version,
+		}
+		rpcRef := &rpc.PlatformReference{Id: used.GetId(), Version: used.GetVersion()}
+		if pkg := pm.Packages[ref.Packager]; pkg != nil && pkg.URL != "" && pkg.URL != globals.DefaultIndexURL {
+			if indexURL, err := url.Parse(pkg.URL); err == nil {
+				ref.PlatformIndexURL = indexURL
+				rpcRef.PlatformIndexUrl = indexURL.String()
+			}
+		}
+		profile.Platforms = append(profile.Platforms, ref)
+		res.Profile.Platforms = append(res.Profile.Platforms, rpcRef)
+	}
+
+	for _, lib := range nonPlatformLibraries(compileResp.GetUsedLibraries()) {
+		version, err := semver.Parse(lib.GetVersion())
+		if lib.GetVersion() == "" || err != nil {
+			res.UnversionedLibraries = append(res.UnversionedLibraries, lib.GetName())
+			continue
+		}
+		profile.Libraries = append(profile.Libraries, &sketch.ProfileLibraryReference{
+			Library: lib.GetName(),
+			Version: version,
+		})
+		res.Profile.Libraries = append(res.Profile.Libraries, &rpc.ProfileLibraryReference{
+			Name:       lib.GetName(),
+			Version:    lib.GetVersion(),
+			InstallDir: lib.GetInstallDir(),
+		})
+	}
+
+	res.ProfileYaml = "profiles:\n" + profile.AsYaml()
+	return res, nil
+}
@@ -19,13 +19,14 @@ import (
 	"context"
 	"io"
 
+	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/arduino-cli/commands"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	"github.com/sirupsen/logrus"
 )
 
 // BurnBootloader FIXMEDOC
-func BurnBootloader(ctx context.Context, req *rpc.BurnBootloaderRequest, outStream io.Writer, errStream io.Writer) (*rpc.BurnBootloaderResponse, error) {
+func BurnBootloader(ctx context.Context, req *rpc.BurnBootloaderRequest, outStream io.Writer, errStream io.Writer, progressCB rpc.UploadProgressCB) (*rpc.BurnBootloaderResponse, error) {
 	logrus.
 		WithField("fqbn", req.GetFqbn()).
 		WithField("port", req.GetPort()).
@@ -34,7 +35,20 @@ func BurnBootloader(ctx context.Context, req *rpc.BurnBootloaderRequest, outStre
 
 	pm := commands.GetPackageManager(req.GetInstance().GetId())
 
-	err := runProgramAction(
+	var verifier *verifyDetector
+	if req.GetVerify() && !req.GetDryRun() {
+		verifier = &verifyDetector{}
+		outStream = io.MultiWriter(outStream, verifier.writer())
+		errStream = io.MultiWriter(errStream, verifier.writer())
+	}
+	if progressCB != nil && !req.GetDryRun() {
+		progress := newBootloaderProgressParser(progressCB)
+		outStream = io.MultiWriter(outStream, progress.writer())
+		errStream = io.MultiWriter(errStream, progress.writer())
+	}
+
+	result, err := runProgramAction(
+		ctx,
 		pm,
 		nil, // sketch
 		"",  // importFile
@@ -50,8 +64,29 @@ func BurnBootloader(ctx context.Context, req *rpc.BurnBootloaderRequest, outStre
 		req.GetDryRun(),
 		map[string]string{}, // User fields
 	)
+	resp := &rpc.BurnBootloaderResponse{Success: err == nil}
+	if result != nil {
+		resp.ResetPerformed = result.resetPerformed
+		if result.programmer != nil {
+			resp.Programmer = &rpc.Programmer{
+				Id:       result.programmerID,
+				Platform: result.programmer.PlatformRelease.String(),
+				Name:     result.programmer.Name,
+			}
+		}
+	}
+	if verifier != nil {
+		resp.VerifyStatus = verifier.result()
+		if resp.VerifyStatus == rpc.UploadVerifyStatus_UPLOAD_VERIFY_STATUS_FAILED {
+			resp.Success = false
+			return resp, &arduino.FailedVerifyError{Cause: err}
+		}
+	}
 	if err != nil {
-		return nil, err
+		if ctx.Err() != nil {
+			return resp, &arduino.CanceledError{Message: tr("Burn bootloader canceled"), Cause: ctx.Err()}
+		}
+		return resp, err
 	}
-	return &rpc.BurnBootloaderResponse{}, nil
+	return resp, nil
 }
@@ -17,6 +17,7 @@ package upload
 
 import (
 	"context"
+	"sort"
 
 	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/arduino-cli/arduino/cores"
@@ -51,14 +52,21 @@ func ListProgrammersAvailableForUpload(ctx context.Context, req *rpc.ListProgram
 			Name:     programmer.Name,
 		}
 	}
-	if refPlatform != platform {
-		for id, programmer := range refPlatform.Programmers {
-			result = append(result, createRPCProgrammer(id, programmer))
+	appendProgrammers := func(programmers map[string]*cores.Programmer) {
+		// Sort the programmers to return them always in the same order
+		ids := []string{}
+		for id := range programmers {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		for _, id := range ids {
+			result = append(result, createRPCProgrammer(id, programmers[id]))
 		}
 	}
-	for id, programmer := range platform.Programmers {
-		result = append(result, createRPCProgrammer(id, programmer))
+	if refPlatform != platform {
+		appendProgrammers(refPlatform.Programmers)
 	}
+	appendProgrammers(platform.Programmers)
 
 	return &rpc.ListProgrammersAvailableForUploadResponse{
 		Programmers: result,
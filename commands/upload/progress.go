@@ -0,0 +1,199 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package upload
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// Upload phases reported in the UploadProgress
+const (
+	phaseErasing   = "erasing"
+	phaseReading   = "reading"
+	phaseWriting   = "writing"
+	phaseVerifying = "verifying"
+
+	// Phases reported only while burning the bootloader
+	phaseWritingFuses      = "writing_fuses"
+	phaseWritingBootloader = "writing_bootloader"
+)
+
+var (
+	// avrdude: "Writing | ################################################## | 100% 0.15s"
+	avrdudeBarRegexp = regexp.MustCompile(`^(Reading|Writing) \| (#*)`)
+	// bossac: "[==============================] 100% (187/187 pages)"
+	bossacBarRegexp = regexp.MustCompile(`(\d+)% \((\d+)/(\d+) pages\)`)
+	// esptool: "Writing at 0x00010000... (14 %)"
+	esptoolWriteRegexp = regexp.MustCompile(`Writing at 0x[0-9a-fA-F]+\.\.\. \((\d+) ?%\)`)
+	// avrdude: "avrdude: writing lfuse (1 bytes):"
+	avrdudeMemoryRegexp = regexp.MustCompile(`^avrdude: writing (\w+) \(`)
+)
+
+// maxProgressLineLength limits the memory used to buffer a line of the tool
+// output that never terminates
+const maxProgressLineLength = 4096
+
+// progressParser parses the output of the known upload tools (avrdude,
+// bossac, esptool) to report the progress of the upload
+type progressParser struct {
+	mux           sync.Mutex
+	cb            rpc.UploadProgressCB
+	phase         string
+	percent       float32
+	recognized    bool
+	written       bool
+	indeterminate bool
+	// bootloader is true while burning the bootloader: in this case the
+	// writes are reported as phaseWritingFuses or phaseWritingBootloader
+	// depending on the memory being written.
+	bootloader bool
+	writePhase string
+}
+
+func newProgressParser(cb rpc.UploadProgressCB) *progressParser {
+	return &progressParser{cb: cb, writePhase: phaseWriting}
+}
+
+func newBootloaderProgressParser(cb rpc.UploadProgressCB) *progressParser {
+	return &progressParser{cb: cb, writePhase: phaseWritingBootloader, bootloader: true}
+}
+
+// writer returns an io.Writer that feeds the parser, a different writer
+// must be used for each output stream of the tool.
+func (p *progressParser) writer() io.Writer {
+	return &progressLineWriter{parser: p}
+}
+
+func (p *progressParser) report(phase string, percent float32) {
+	p.recognized = true
+	if phase == p.writePhase {
+		p.written = true
+	}
+	if percent > 100 {
+		percent = 100
+	}
+	if phase == p.phase && percent == p.percent {
+		return
+	}
+	p.phase = phase
+	p.percent = percent
+	p.cb(&rpc.UploadProgress{Phase: phase, Percent: percent})
+}
+
+// avrdudePhase returns the phase of an avrdude progress bar: avrdude reads
+// the memory also to verify it after a write.
+func (p *progressParser) avrdudePhase(action string) string {
+	if action == "Writing" {
+		return p.writePhase
+	}
+	if p.written {
+		return phaseVerifying
+	}
+	return phaseReading
+}
+
+// parsePartial parses a line not yet terminated, this is needed because
+// avrdude doesn't terminate the line while printing its progress bars.
+func (p *progressParser) parsePartial(line string) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	if m := avrdudeBarRegexp.FindStringSubmatch(line); m != nil {
+		p.report(p.avrdudePhase(m[1]), float32(len(m[2])*2))
+	}
+}
+
+func (p *progressParser) parseLine(line string) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return
+	}
+	if m := avrdudeBarRegexp.FindStringSubmatch(line); m != nil {
+		p.report(p.avrdudePhase(m[1]), float32(len(m[2])*2))
+		return
+	}
+	if m := avrdudeMemoryRegexp.FindStringSubmatch(line); m != nil && p.bootloader {
+		if m[1] == "flash" {
+			p.writePhase = phaseWritingBootloader
+		} else {
+			p.writePhase = phaseWritingFuses
+		}
+		p.report(p.writePhase, 0)
+		return
+	}
+	if m := bossacBarRegexp.FindStringSubmatch(line); m != nil {
+		if percent, err := strconv.Atoi(m[1]); err == nil {
+			p.report(p.phase, float32(percent))
+		}
+		return
+	}
+	if m := esptoolWriteRegexp.FindStringSubmatch(line); m != nil {
+		if percent, err := strconv.Atoi(m[1]); err == nil {
+			p.report(phaseWriting, float32(percent))
+		}
+		return
+	}
+	lower := strings.ToLower(line)
+	switch {
+	case strings.Contains(lower, "erasing chip"), // avrdude
+		strings.HasPrefix(lower, "erase flash"),   // bossac
+		strings.HasPrefix(lower, "erasing flash"): // esptool
+		p.report(phaseErasing, 0)
+	case strings.HasPrefix(lower, "write ") && strings.Contains(lower, "bytes to flash"): // bossac
+		p.report(phaseWriting, 0)
+	case strings.HasPrefix(lower, "verify ") && strings.Contains(lower, "bytes of flash"): // bossac
+		p.report(phaseVerifying, 0)
+	case strings.Contains(lower, "hash of data verified"): // esptool
+		p.report(phaseVerifying, 100)
+	default:
+		if !p.recognized && !p.indeterminate {
+			p.indeterminate = true
+			p.cb(&rpc.UploadProgress{Indeterminate: true})
+		}
+	}
+}
+
+type progressLineWriter struct {
+	parser *progressParser
+	buf    []byte
+}
+
+func (w *progressLineWriter) Write(data []byte) (int, error) {
+	w.buf = append(w.buf, data...)
+	for {
+		idx := bytes.IndexAny(w.buf, "\r\n")
+		if idx == -1 {
+			break
+		}
+		w.parser.parseLine(string(w.buf[:idx]))
+		w.buf = w.buf[idx+1:]
+	}
+	if len(w.buf) > maxProgressLineLength {
+		w.buf = nil
+	}
+	if len(w.buf) > 0 {
+		w.parser.parsePartial(string(w.buf))
+	}
+	return len(data), nil
+}
@@ -21,6 +21,8 @@ import (
 	"io"
 	"path/filepath"
 	"strings"
+	"time"
+	"unicode"
 
 	"github.com/arduino/arduino-cli/arduino"
 	"github.com/arduino/arduino-cli/arduino/cores"
@@ -31,6 +33,7 @@ import (
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/executils"
 	"github.com/arduino/arduino-cli/i18n"
+	"github.com/arduino/arduino-cli/metrics"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	paths "github.com/arduino/go-paths-helper"
 	properties "github.com/arduino/go-properties-orderedmap"
@@ -67,11 +70,14 @@ func SupportedUserFields(ctx context.Context, req *rpc.SupportedUserFieldsReques
 	}
 
 	_, platformRelease, _, boardProperties, _, err := pm.ResolveFQBN(fqbn)
+	var optionErr *arduino.InvalidBoardOptionError
 	if platformRelease == nil {
 		return nil, &arduino.PlatformNotFoundError{
 			Platform: fmt.Sprintf("%s:%s", fqbn.Package, fqbn.PlatformArch),
 			Cause:    err,
 		}
+	} else if errors.As(err, &optionErr) {
+		return nil, optionErr
 	} else if err != nil {
 		return nil, &arduino.UnknownFQBNError{Cause: err}
 	}
@@ -200,25 +206,59 @@ func getUserFields(toolID string, platformRelease *cores.PlatformRelease) []*rpc
 }
 
 // Upload FIXMEDOC
-func Upload(ctx context.Context, req *rpc.UploadRequest, outStream io.Writer, errStream io.Writer) (*rpc.UploadResponse, error) {
+func Upload(ctx context.Context, req *rpc.UploadRequest, outStream io.Writer, errStream io.Writer, progressCB rpc.UploadProgressCB) (_ *rpc.UploadResponse, e error) {
 	logrus.Tracef("Upload %s on %s started", req.GetSketchPath(), req.GetFqbn())
+	start := time.Now()
+	defer func() { metrics.ObserveCommandDuration("upload", start, e) }()
 
 	// TODO: make a generic function to extract sketch from request
 	// and remove duplication in commands/compile.go
 	sketchPath := paths.New(req.GetSketchPath())
-	sk, err := sketch.New(sketchPath)
-	if err != nil && req.GetImportDir() == "" && req.GetImportFile() == "" {
-		return nil, &arduino.CantOpenSketchError{Cause: err}
+	sk, sketchErr := sketch.New(sketchPath)
+	if sketchErr != nil && req.GetImportDir() == "" && req.GetImportFile() == "" {
+		return nil, &arduino.CantOpenSketchError{Cause: sketchErr}
 	}
 
 	pm := commands.GetPackageManager(req.GetInstance().GetId())
 
-	if err := runProgramAction(
+	fqbn := req.GetFqbn()
+	var profile *rpc.ResolvedProfile
+	var err error
+	if sk != nil {
+		profile, err = commands.ResolveSketchProfile(req.GetInstance(), sk, req.GetProfile())
+		if err != nil {
+			return nil, err
+		}
+		if fqbn == "" && profile != nil {
+			fqbn = profile.GetFqbn()
+		}
+	} else if req.GetProfile() != "" {
+		// The profile is defined in the sketch
+		if sketchPath == nil {
+			return nil, &arduino.MissingSketchPathError{}
+		}
+		return nil, &arduino.CantOpenSketchError{Cause: sketchErr}
+	}
+
+	var verifier *verifyDetector
+	if req.GetVerify() && !req.GetDryRun() {
+		verifier = &verifyDetector{}
+		outStream = io.MultiWriter(outStream, verifier.writer())
+		errStream = io.MultiWriter(errStream, verifier.writer())
+	}
+	if progressCB != nil && !req.GetDryRun() {
+		progress := newProgressParser(progressCB)
+		outStream = io.MultiWriter(outStream, progress.writer())
+		errStream = io.MultiWriter(errStream, progress.writer())
+	}
+
+	_, err = runProgramAction(
+		ctx,
 		pm,
 		sk,
 		req.GetImportFile(),
 		req.GetImportDir(),
-		req.GetFqbn(),
+		fqbn,
 		req.GetPort(),
 		req.GetProgrammer(),
 		req.GetVerbose(),
@@ -228,21 +268,31 @@ func Upload(ctx context.Context, req *rpc.UploadRequest, outStream io.Writer, er
 		errStream,
 		req.GetDryRun(),
 		req.GetUserFields(),
-	); err != nil {
+	)
+	resp := &rpc.UploadResponse{Profile: profile}
+	if verifier != nil {
+		resp.VerifyStatus = verifier.result()
+		if resp.VerifyStatus == rpc.UploadVerifyStatus_UPLOAD_VERIFY_STATUS_FAILED {
+			return resp, &arduino.FailedVerifyError{Cause: err}
+		}
+	}
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, &arduino.CanceledError{Message: tr("Upload canceled"), Cause: ctx.Err()}
+		}
 		return nil, err
 	}
-
-	return &rpc.UploadResponse{}, nil
+	return resp, nil
 }
 
 // UsingProgrammer FIXMEDOC
-func UsingProgrammer(ctx context.Context, req *rpc.UploadUsingProgrammerRequest, outStream io.Writer, errStream io.Writer) (*rpc.UploadUsingProgrammerResponse, error) {
+func UsingProgrammer(ctx context.Context, req *rpc.UploadUsingProgrammerRequest, outStream io.Writer, errStream io.Writer, progressCB rpc.UploadProgressCB) (*rpc.UploadUsingProgrammerResponse, error) {
 	logrus.Tracef("Upload using programmer %s on %s started", req.GetSketchPath(), req.GetFqbn())
 
 	if req.GetProgrammer() == "" {
 		return nil, &arduino.MissingProgrammerError{}
 	}
-	_, err := Upload(ctx, &rpc.UploadRequest{
+	resp, err := Upload(ctx, &rpc.UploadRequest{
 		Instance:   req.GetInstance(),
 		SketchPath: req.GetSketchPath(),
 		ImportFile: req.GetImportFile(),
@@ -253,20 +303,30 @@ func UsingProgrammer(ctx context.Context, req *rpc.UploadUsingProgrammerRequest,
 		Verbose:    req.GetVerbose(),
 		Verify:     req.GetVerify(),
 		UserFields: req.GetUserFields(),
-	}, outStream, errStream)
-	return &rpc.UploadUsingProgrammerResponse{}, err
+	}, outStream, errStream, progressCB)
+	return &rpc.UploadUsingProgrammerResponse{VerifyStatus: resp.GetVerifyStatus()}, err
+}
+
+// programActionResult reports how the upload has been performed
+type programActionResult struct {
+	// programmer is the programmer used, nil if not using a programmer
+	programmer   *cores.Programmer
+	programmerID string
+	// resetPerformed is true if the board has been reset (1200-bps touch)
+	// before running the upload tool
+	resetPerformed bool
 }
 
-func runProgramAction(pm *packagemanager.PackageManager,
+func runProgramAction(ctx context.Context, pm *packagemanager.PackageManager,
 	sk *sketch.Sketch,
 	importFile, importDir, fqbnIn string, port *rpc.Port,
 	programmerID string,
 	verbose, verify, burnBootloader bool,
 	outStream, errStream io.Writer,
-	dryRun bool, userFields map[string]string) error {
+	dryRun bool, userFields map[string]string) (*programActionResult, error) {
 
 	if burnBootloader && programmerID == "" {
-		return &arduino.MissingProgrammerError{}
+		return nil, &arduino.MissingProgrammerError{}
 	}
 
 	logrus.WithField("port", port).Tracef("Upload port")
@@ -279,25 +339,28 @@ func runProgramAction(pm *packagemanager.PackageManager,
 		var err error
 		fqbnIn, err = DetectConnectedBoard(pm, port.Address, port.Protocol)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
 	fqbn, err := cores.ParseFQBN(fqbnIn)
 	if err != nil {
-		return &arduino.InvalidFQBNError{Cause: err}
+		return nil, &arduino.InvalidFQBNError{Cause: err}
 	}
 	logrus.WithField("fqbn", fqbn).Tracef("Detected FQBN")
 
 	// Find target board and board properties
 	_, boardPlatform, board, boardProperties, buildPlatform, err := pm.ResolveFQBN(fqbn)
+	var optionErr *arduino.InvalidBoardOptionError
 	if boardPlatform == nil {
-		return &arduino.PlatformNotFoundError{
+		return nil, &arduino.PlatformNotFoundError{
 			Platform: fmt.Sprintf("%s:%s", fqbn.Package, fqbn.PlatformArch),
 			Cause:    err,
 		}
+	} else if errors.As(err, &optionErr) {
+		return nil, optionErr
 	} else if err != nil {
-		return &arduino.UnknownFQBNError{Cause: err}
+		return nil, &arduino.UnknownFQBNError{Cause: err}
 	}
 	logrus.
 		WithField("boardPlatform", boardPlatform).
@@ -314,9 +377,10 @@ func runProgramAction(pm *packagemanager.PackageManager,
 			programmer = buildPlatform.Programmers[programmerID]
 		}
 		if programmer == nil {
-			return &arduino.ProgrammerNotFoundError{Programmer: programmerID}
+			return nil, &arduino.ProgrammerNotFoundError{Programmer: programmerID}
 		}
 	}
+	result := &programActionResult{programmer: programmer, programmerID: programmerID}
 
 	// Determine upload tool
 	// create a temporary configuration only for the selection of upload tool
@@ -335,7 +399,7 @@ func runProgramAction(pm *packagemanager.PackageManager,
 	}
 	uploadToolID, err := getToolID(props, action, port.Protocol)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var uploadToolPlatform *cores.PlatformRelease
@@ -350,7 +414,7 @@ func runProgramAction(pm *packagemanager.PackageManager,
 		Trace("Upload tool")
 
 	if split := strings.Split(uploadToolID, ":"); len(split) > 2 {
-		return &arduino.InvalidPlatformPropertyError{
+		return nil, &arduino.InvalidPlatformPropertyError{
 			Property: fmt.Sprintf("%s.tool.%s", action, port.Protocol), // TODO: Can be done better, maybe inline getToolID(...)
 			Value:    uploadToolID}
 	} else if len(split) == 2 {
@@ -400,7 +464,7 @@ func runProgramAction(pm *packagemanager.PackageManager,
 	}
 
 	if !uploadProperties.ContainsKey("upload.protocol") && programmer == nil {
-		return &arduino.ProgrammerRequiredForUploadError{}
+		return nil, &arduino.ProgrammerRequiredForUploadError{}
 	}
 
 	// Set properties for verbose upload
@@ -448,16 +512,26 @@ func runProgramAction(pm *packagemanager.PackageManager,
 	if !burnBootloader {
 		importPath, sketchName, err := determineBuildPathAndSketchName(importFile, importDir, sk, fqbn)
 		if err != nil {
-			return &arduino.NotFoundError{Message: tr("Error finding build artifacts"), Cause: err}
+			return nil, &arduino.NotFoundError{Message: tr("Error finding build artifacts"), Cause: err}
 		}
 		if !importPath.Exist() {
-			return &arduino.NotFoundError{Message: tr("Compiled sketch not found in %s", importPath)}
+			return nil, &arduino.NotFoundError{Message: tr("Compiled sketch not found in %s", importPath)}
 		}
 		if !importPath.IsDir() {
-			return &arduino.NotFoundError{Message: tr("Expected compiled sketch in directory %s, but is a file instead", importPath)}
+			return nil, &arduino.NotFoundError{Message: tr("Expected compiled sketch in directory %s, but is a file instead", importPath)}
 		}
 		uploadProperties.SetPath("build.path", importPath)
 		uploadProperties.Set("build.project_name", sketchName)
+
+		if importFile != "" {
+			recipeID := "upload.pattern"
+			if programmer != nil {
+				recipeID = "program.pattern"
+			}
+			if err := checkArtifactExtension(uploadProperties, recipeID, paths.New(importFile)); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	// If not using programmer perform some action required
@@ -515,6 +589,7 @@ func runProgramAction(pm *packagemanager.PackageManager,
 		if newPort, err := serialutils.Reset(portToTouch, wait, cb, dryRun); err != nil {
 			outStream.Write([]byte(fmt.Sprintln(tr("Cannot perform port reset: %s", err))))
 		} else {
+			result.resetPerformed = portToTouch != "" && !dryRun
 			if newPort != "" {
 				actualPort.Address = newPort
 			}
@@ -543,27 +618,27 @@ func runProgramAction(pm *packagemanager.PackageManager,
 	// Run recipes for upload
 	toolEnv := pm.GetEnvVarsForSpawnedProcess()
 	if burnBootloader {
-		if err := runTool("erase.pattern", uploadProperties, outStream, errStream, verbose, dryRun, toolEnv); err != nil {
-			return &arduino.FailedUploadError{Message: tr("Failed chip erase"), Cause: err}
+		if err := runTool(ctx, "erase.pattern", uploadProperties, outStream, errStream, verbose, dryRun, toolEnv); err != nil {
+			return result, &arduino.FailedUploadError{Message: tr("Failed chip erase"), Cause: err}
 		}
-		if err := runTool("bootloader.pattern", uploadProperties, outStream, errStream, verbose, dryRun, toolEnv); err != nil {
-			return &arduino.FailedUploadError{Message: tr("Failed to burn bootloader"), Cause: err}
+		if err := runTool(ctx, "bootloader.pattern", uploadProperties, outStream, errStream, verbose, dryRun, toolEnv); err != nil {
+			return result, &arduino.FailedUploadError{Message: tr("Failed to burn bootloader"), Cause: err}
 		}
 	} else if programmer != nil {
-		if err := runTool("program.pattern", uploadProperties, outStream, errStream, verbose, dryRun, toolEnv); err != nil {
-			return &arduino.FailedUploadError{Message: tr("Failed programming"), Cause: err}
+		if err := runTool(ctx, "program.pattern", uploadProperties, outStream, errStream, verbose, dryRun, toolEnv); err != nil {
+			return result, &arduino.FailedUploadError{Message: tr("Failed programming"), Cause: err}
 		}
 	} else {
-		if err := runTool("upload.pattern", uploadProperties, outStream, errStream, verbose, dryRun, toolEnv); err != nil {
-			return &arduino.FailedUploadError{Message: tr("Failed uploading"), Cause: err}
+		if err := runTool(ctx, "upload.pattern", uploadProperties, outStream, errStream, verbose, dryRun, toolEnv); err != nil {
+			return result, &arduino.FailedUploadError{Message: tr("Failed uploading"), Cause: err}
 		}
 	}
 
 	logrus.Tracef("Upload successful")
-	return nil
+	return result, nil
 }
 
-func runTool(recipeID string, props *properties.Map, outStream, errStream io.Writer, verbose bool, dryRun bool, toolEnv []string) error {
+func runTool(ctx context.Context, recipeID string, props *properties.Map, outStream, errStream io.Writer, verbose bool, dryRun bool, toolEnv []string) error {
 	recipe, ok := props.GetOk(recipeID)
 	if !ok {
 		return fmt.Errorf(tr("recipe not found '%s'"), recipeID)
@@ -596,11 +671,7 @@ func runTool(recipeID string, props *properties.Map, outStream, errStream io.Wri
 	cmd.RedirectStdoutTo(outStream)
 	cmd.RedirectStderrTo(errStream)
 
-	if err := cmd.Start(); err != nil {
-		return fmt.Errorf(tr("cannot execute upload tool: %s"), err)
-	}
-
-	if err := cmd.Wait(); err != nil {
+	if err := cmd.RunWithinContext(ctx); err != nil {
 		return fmt.Errorf(tr("uploading error: %s"), err)
 	}
 
@@ -663,6 +734,50 @@ func determineBuildPathAndSketchName(importFile, importDir string, sk *sketch.Sk
 	return sk.BuildPath, sk.Name + sk.MainFile.Ext(), nil
 }
 
+// expectedArtifactExtensions returns the extensions of the build artifacts
+// referenced by the given recipe through the 'build.project_name' property.
+// For example "{build.path}/{build.project_name}.hex" expects a ".hex" file.
+func expectedArtifactExtensions(props *properties.Map, recipeID string) []string {
+	const marker = "\x00project\x00"
+	p := props.Clone()
+	p.Set("build.project_name", marker)
+	recipe := p.ExpandPropsInString(p.Get(recipeID))
+
+	extensions := []string{}
+	for _, chunk := range strings.Split(recipe, marker)[1:] {
+		// Take the suffix up to the first char not allowed in a file name
+		// extension, e.g. "Blink.ino.hex:i" -> ".hex"
+		end := strings.IndexFunc(chunk, func(r rune) bool {
+			return !(r == '.' || r == '_' || r == '-' || unicode.IsLetter(r) || unicode.IsDigit(r))
+		})
+		if end != -1 {
+			chunk = chunk[:end]
+		}
+		if ext := filepath.Ext(chunk); ext != "" {
+			extensions = append(extensions, ext)
+		}
+	}
+	return extensions
+}
+
+// checkArtifactExtension returns an error if the extension of the binary file
+// doesn't match any of the artifacts expected by the given upload recipe.
+func checkArtifactExtension(props *properties.Map, recipeID string, importFile *paths.Path) error {
+	expected := expectedArtifactExtensions(props, recipeID)
+	if len(expected) == 0 {
+		// The recipe doesn't reference the build artifacts by name
+		return nil
+	}
+	for _, ext := range expected {
+		if strings.EqualFold(ext, importFile.Ext()) {
+			return nil
+		}
+	}
+	return &arduino.InvalidArgumentError{
+		Message: tr("The binary file %[1]s doesn't match the upload recipe, expected file with extension: %[2]s", importFile, strings.Join(expected, ", ")),
+	}
+}
+
 func detectSketchNameFromBuildPath(buildPath *paths.Path) (string, error) {
 	files, err := buildPath.ReadDir()
 	if err != nil {
@@ -17,6 +17,7 @@ package upload
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"strings"
 	"testing"
@@ -180,7 +181,8 @@ func TestUploadPropertiesComposition(t *testing.T) {
 	testRunner := func(t *testing.T, test test, verboseVerify bool) {
 		outStream := &bytes.Buffer{}
 		errStream := &bytes.Buffer{}
-		err := runProgramAction(
+		_, err := runProgramAction(
+			context.Background(),
 			pm,
 			nil,                     // sketch
 			"",                      // importFile
@@ -345,3 +347,117 @@ func TestOverrideProtocolProperties(t *testing.T) {
 	require.Equal(t, res.Get("upload.unrelated_property"), "ok")
 
 }
+
+func TestVerifyDetector(t *testing.T) {
+	tests := []struct {
+		output string
+		status rpc.UploadVerifyStatus
+	}{
+		{"avrdude: verifying ...\navrdude: 924 bytes of flash verified\n", rpc.UploadVerifyStatus_UPLOAD_VERIFY_STATUS_PASSED},
+		{"avrdude: verification error, first mismatch at byte 0x0000\n", rpc.UploadVerifyStatus_UPLOAD_VERIFY_STATUS_FAILED},
+		{"Verify 11936 bytes of flash\r[====] 100%\rVerify successful\n", rpc.UploadVerifyStatus_UPLOAD_VERIFY_STATUS_PASSED},
+		{"Verify failed\nPage errors: 1\n", rpc.UploadVerifyStatus_UPLOAD_VERIFY_STATUS_FAILED},
+		{"Hash of data verified.\n", rpc.UploadVerifyStatus_UPLOAD_VERIFY_STATUS_PASSED},
+		{"Writing at 0x00010000... (100 %)\n", rpc.UploadVerifyStatus_UPLOAD_VERIFY_STATUS_UNKNOWN},
+	}
+	for _, test := range tests {
+		d := &verifyDetector{}
+		w := d.writer()
+		// Write the output in small chunks to exercise the line buffering
+		for i := 0; i < len(test.output); i += 5 {
+			end := i + 5
+			if end > len(test.output) {
+				end = len(test.output)
+			}
+			w.Write([]byte(test.output[i:end]))
+		}
+		require.Equal(t, test.status, d.result(), test.output)
+	}
+}
+
+func TestProgressParser(t *testing.T) {
+	parse := func(output string) []*rpc.UploadProgress {
+		res := []*rpc.UploadProgress{}
+		p := newProgressParser(func(msg *rpc.UploadProgress) { res = append(res, msg) })
+		w := p.writer()
+		for _, c := range []byte(output) {
+			w.Write([]byte{c})
+		}
+		return res
+	}
+
+	// avrdude prints the progress bars without terminating the line
+	res := parse("Reading | " + strings.Repeat("#", 50) + " | 100% 0.00s\n" +
+		"avrdude: erasing chip\n" +
+		"Writing | " + strings.Repeat("#", 50) + " | 100% 0.15s\n" +
+		"Reading | " + strings.Repeat("#", 50) + " | 100% 0.12s\n")
+	require.Equal(t, "reading", res[0].GetPhase())
+	require.Contains(t, res, &rpc.UploadProgress{Phase: "erasing"})
+	require.Contains(t, res, &rpc.UploadProgress{Phase: "writing", Percent: 50})
+	require.Equal(t, &rpc.UploadProgress{Phase: "verifying", Percent: 100}, res[len(res)-1])
+
+	res = parse("Erase flash\n" +
+		"Write 11936 bytes to flash (187 pages)\n" +
+		"[===============               ] 50% (94/187 pages)\r" +
+		"[==============================] 100% (187/187 pages)\n" +
+		"Verify 11936 bytes of flash\n")
+	require.Equal(t, []*rpc.UploadProgress{
+		{Phase: "erasing"},
+		{Phase: "writing"},
+		{Phase: "writing", Percent: 50},
+		{Phase: "writing", Percent: 100},
+		{Phase: "verifying"},
+	}, res)
+
+	res = parse("Erasing flash (this may take a while)...\n" +
+		"Writing at 0x00010000... (14 %)\n" +
+		"Hash of data verified.\n")
+	require.Equal(t, []*rpc.UploadProgress{
+		{Phase: "erasing"},
+		{Phase: "writing", Percent: 14},
+		{Phase: "verifying", Percent: 100},
+	}, res)
+
+	res = parse("Uploading with an unknown tool\nDone\n")
+	require.Equal(t, []*rpc.UploadProgress{{Indeterminate: true}}, res)
+}
+
+func TestBootloaderProgressParser(t *testing.T) {
+	res := []*rpc.UploadProgress{}
+	p := newBootloaderProgressParser(func(msg *rpc.UploadProgress) { res = append(res, msg) })
+	bar := func(action string) string {
+		return action + " | " + strings.Repeat("#", 50) + " | 100% 0.01s\n"
+	}
+	p.writer().Write([]byte("avrdude: erasing chip\n" +
+		"avrdude: writing lfuse (1 bytes):\n" + bar("Writing") +
+		"avrdude: verifying lfuse memory against 0xFF:\n" + bar("Reading") +
+		"avrdude: writing flash (32768 bytes):\n" + bar("Writing") +
+		"avrdude: verifying flash memory against optiboot.hex:\n" + bar("Reading")))
+	require.Equal(t, []*rpc.UploadProgress{
+		{Phase: "erasing"},
+		{Phase: "writing_fuses"},
+		{Phase: "writing_fuses", Percent: 100},
+		{Phase: "verifying", Percent: 100},
+		{Phase: "writing_bootloader"},
+		{Phase: "writing_bootloader", Percent: 100},
+		{Phase: "verifying", Percent: 100},
+	}, res)
+}
+
+func TestCheckArtifactExtension(t *testing.T) {
+	props := properties.NewMap()
+	props.Set("build.path", "/tmp/build")
+	props.Set("upload.pattern", `avrdude "-Uflash:w:{build.path}/{build.project_name}.hex:i"`)
+	props.Set("program.pattern", `bossac "{build.path}/{build.project_name}.bin" "{build.path}/{build.project_name}.with_bootloader.hex"`)
+	props.Set("erase.pattern", `tool erase "{serial.port}"`)
+
+	require.Equal(t, []string{".hex"}, expectedArtifactExtensions(props, "upload.pattern"))
+	require.Equal(t, []string{".bin", ".hex"}, expectedArtifactExtensions(props, "program.pattern"))
+	require.Empty(t, expectedArtifactExtensions(props, "erase.pattern"))
+
+	require.NoError(t, checkArtifactExtension(props, "upload.pattern", paths.New("Blink.ino.hex")))
+	require.NoError(t, checkArtifactExtension(props, "upload.pattern", paths.New("Blink.ino.HEX")))
+	require.Error(t, checkArtifactExtension(props, "upload.pattern", paths.New("Blink.ino.bin")))
+	require.NoError(t, checkArtifactExtension(props, "program.pattern", paths.New("Blink.ino.bin")))
+	require.NoError(t, checkArtifactExtension(props, "erase.pattern", paths.New("Blink.ino.elf")))
+}
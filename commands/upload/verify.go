@@ -0,0 +1,104 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package upload
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"sync"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// verifyPassedMarkers and verifyFailedMarkers are the (lowercase) messages
+// printed by the known upload tools (avrdude, bossac, esptool) when the
+// verification of the uploaded binary succeeds or fails
+var verifyPassedMarkers = []string{
+	"bytes of flash verified",
+	"verify successful",
+	"hash of data verified",
+}
+
+var verifyFailedMarkers = []string{
+	"verification error",
+	"verify failed",
+	"does not match",
+	"doesn't match",
+}
+
+// verifyDetector inspects the output of the upload tool to find out the
+// result of the verification of the uploaded binary
+type verifyDetector struct {
+	mux    sync.Mutex
+	status rpc.UploadVerifyStatus
+}
+
+// writer returns an io.Writer that feeds the detector, a different writer
+// must be used for each output stream of the tool.
+func (d *verifyDetector) writer() io.Writer {
+	return &verifyLineWriter{detector: d}
+}
+
+func (d *verifyDetector) feed(line string) {
+	line = strings.ToLower(line)
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	if d.status == rpc.UploadVerifyStatus_UPLOAD_VERIFY_STATUS_FAILED {
+		return
+	}
+	for _, marker := range verifyFailedMarkers {
+		if strings.Contains(line, marker) {
+			d.status = rpc.UploadVerifyStatus_UPLOAD_VERIFY_STATUS_FAILED
+			return
+		}
+	}
+	for _, marker := range verifyPassedMarkers {
+		if strings.Contains(line, marker) {
+			d.status = rpc.UploadVerifyStatus_UPLOAD_VERIFY_STATUS_PASSED
+			return
+		}
+	}
+}
+
+// result returns the result of the verification, UNKNOWN if the output of
+// the tool didn't report it
+func (d *verifyDetector) result() rpc.UploadVerifyStatus {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	if d.status == rpc.UploadVerifyStatus_UPLOAD_VERIFY_STATUS_NOT_REQUESTED {
+		return rpc.UploadVerifyStatus_UPLOAD_VERIFY_STATUS_UNKNOWN
+	}
+	return d.status
+}
+
+type verifyLineWriter struct {
+	detector *verifyDetector
+	buf      []byte
+}
+
+func (w *verifyLineWriter) Write(data []byte) (int, error) {
+	w.buf = append(w.buf, data...)
+	for {
+		idx := bytes.IndexAny(w.buf, "\r\n")
+		if idx == -1 {
+			break
+		}
+		w.detector.feed(string(w.buf[:idx]))
+		w.buf = w.buf[idx+1:]
+	}
+	return len(data), nil
+}
@@ -16,6 +16,12 @@
 package commands
 
 import (
+	"context"
+
+	"github.com/arduino/arduino-cli/arduino"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	semver "go.bug.st/relaxed-semver"
 )
 
@@ -32,3 +38,38 @@ func ParseVersion(req Versioned) (*semver.Version, error) {
 	}
 	return nil, nil
 }
+
+// Version returns the given version of Arduino CLI with a summary of the
+// environment in use. The installed platforms and libraries are counted only
+// if the request contains an instance.
+func Version(ctx context.Context, req *rpc.VersionRequest, version string) (*rpc.VersionResponse, error) {
+	res := &rpc.VersionResponse{
+		Version:      version,
+		ConfigFile:   configuration.Settings.ConfigFileUsed(),
+		DataDir:      configuration.Settings.GetString("directories.Data"),
+		UserDir:      configuration.Settings.GetString("directories.User"),
+		DownloadsDir: configuration.Settings.GetString("directories.Downloads"),
+	}
+	if req.GetInstance() == nil {
+		return res, nil
+	}
+
+	pm := GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	res.InstalledPlatforms = int32(len(pm.InstalledPlatformReleases()))
+
+	lm := GetLibraryManager(req.GetInstance().GetId())
+	if lm == nil {
+		return nil, &arduino.InvalidInstanceError{}
+	}
+	for _, alternatives := range lm.Libraries {
+		for _, lib := range alternatives.Alternatives {
+			if lib.Location == libraries.User {
+				res.InstalledLibraries++
+			}
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,45 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersion(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	configuration.Settings = configuration.Init(tmp.Join("arduino-cli.yaml").String())
+	configuration.Settings.Set("directories.Data", tmp.Join("data").String())
+	configuration.Settings.Set("directories.User", tmp.Join("user").String())
+
+	res, err := Version(context.Background(), &rpc.VersionRequest{}, "1.2.3")
+	require.NoError(t, err)
+	require.Equal(t, "1.2.3", res.GetVersion())
+	require.Equal(t, tmp.Join("data").String(), res.GetDataDir())
+	require.Equal(t, tmp.Join("user").String(), res.GetUserDir())
+	require.Zero(t, res.GetInstalledPlatforms())
+
+	_, err = Version(context.Background(), &rpc.VersionRequest{Instance: &rpc.Instance{Id: 42}}, "1.2.3")
+	require.Error(t, err)
+}
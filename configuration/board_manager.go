@@ -0,0 +1,94 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package configuration
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/spf13/viper"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// IndexSignatureKey is the public key used to verify the signature of the
+// package index downloaded from URL
+type IndexSignatureKey struct {
+	URL       string `mapstructure:"url"`
+	PublicKey string `mapstructure:"public_key"`
+}
+
+// IndexSignatureKeys returns the public keys configured in
+// board_manager.index_signature_keys to verify the package indexes
+func IndexSignatureKeys(settings *viper.Viper) ([]IndexSignatureKey, error) {
+	keys := []IndexSignatureKey{}
+	if err := settings.UnmarshalKey("board_manager.index_signature_keys", &keys); err != nil {
+		return nil, fmt.Errorf(tr("Invalid board_manager.index_signature_keys: %s"), err)
+	}
+	for _, key := range keys {
+		if key.URL == "" {
+			return nil, fmt.Errorf(tr("Missing index URL in board_manager.index_signature_keys"))
+		}
+		if key.PublicKey == "" {
+			return nil, fmt.Errorf(tr("Missing public key for index %s in board_manager.index_signature_keys"), key.URL)
+		}
+	}
+	return keys, nil
+}
+
+// IndexSignatureKeyPath returns the path of the public key configured in
+// board_manager.index_signature_keys for the package index at the given URL,
+// or nil if the signature of the index must not be verified
+func IndexSignatureKeyPath(settings *viper.Viper, indexURL string) (*paths.Path, error) {
+	keys, err := IndexSignatureKeys(settings)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		if key.URL == indexURL {
+			return paths.New(key.PublicKey), nil
+		}
+	}
+	return nil, nil
+}
+
+// PinnedPlatforms returns the versions the platforms listed in the
+// board_manager.pinned_platforms setting are pinned to, keyed by packager:arch.
+func PinnedPlatforms(settings *viper.Viper) (map[string]*semver.Version, error) {
+	res := map[string]*semver.Version{}
+	for _, pinned := range settings.GetStringSlice("board_manager.pinned_platforms") {
+		split := strings.SplitN(pinned, "@", 2)
+		if len(split) != 2 || strings.Count(split[0], ":") != 1 {
+			return nil, fmt.Errorf(tr("Invalid item '%s' in board_manager.pinned_platforms, expected packager:arch@version"), pinned)
+		}
+		version, err := semver.Parse(split[1])
+		if err != nil {
+			return nil, fmt.Errorf(tr("Invalid version in board_manager.pinned_platforms item '%[1]s': %[2]s"), pinned, err)
+		}
+		res[split[0]] = version
+	}
+	return res, nil
+}
+
+// PinnedPlatformVersion returns the version the platform packager:arch is
+// pinned to, or nil if the platform is not pinned.
+func PinnedPlatformVersion(settings *viper.Viper, packager, arch string) (*semver.Version, error) {
+	pinned, err := PinnedPlatforms(settings)
+	if err != nil {
+		return nil, err
+	}
+	return pinned[packager+":"+arch], nil
+}
@@ -18,6 +18,7 @@ package configuration
 import (
 	"os"
 	"path/filepath"
+	"reflect"
 	"runtime"
 	"strings"
 
@@ -36,10 +37,26 @@ var Settings *viper.Viper
 
 var tr = i18n.Tr
 
+// configFilesUsed are the configuration files read by Init, in order of precedence
+var configFilesUsed []string
+
+// keySources maps each key set in a configuration file to the file setting it
+var keySources map[string]string
+
+// additionalConfigFiles are the files merged by Init over the configuration
+// file, they are merged again by Reload
+var additionalConfigFiles []string
+
+// mergedValues maps each key set by an additional configuration file to the
+// value merged from it
+var mergedValues map[string]interface{}
+
 // Init initialize defaults and read the configuration file.
+// The additionalConfigFiles, if any, are merged in order: the values they set
+// override the ones of configFile and of the previous additional files.
 // Please note the logging system hasn't been configured yet,
 // so logging shouldn't be used here.
-func Init(configFile string) *viper.Viper {
+func Init(configFile string, additionalConfigFiles ...string) *viper.Viper {
 	jww.SetStdoutThreshold(jww.LevelFatal)
 
 	// Create a new viper instance with default values for all the settings
@@ -61,8 +78,7 @@ func Init(configFile string) *viper.Viper {
 		settings.AddConfigPath(configDir)
 	}
 
-	// Attempt to read config file
-	if err := settings.ReadInConfig(); err != nil {
+	for _, err := range readConfigFiles(settings, additionalConfigFiles) {
 		// ConfigFileNotFoundError is acceptable, anything else
 		// should be reported to the user
 		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
@@ -73,6 +89,117 @@ func Init(configFile string) *viper.Viper {
 	return settings
 }
 
+// Reload reads again the configuration file of settings and merges again the
+// additional configuration files given to Init, in the same order. The values
+// set with settings.Set keep the precedence over the ones read.
+func Reload(settings *viper.Viper) error {
+	if errs := readConfigFiles(settings, additionalConfigFiles); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// readConfigFiles reads the configuration file of settings and then merges
+// the additionalFiles. The errors are returned after reading all the files
+// that can be read.
+func readConfigFiles(settings *viper.Viper, additionalFiles []string) []error {
+	additionalConfigFiles = additionalFiles
+	configFilesUsed = []string{}
+	keySources = map[string]string{}
+	mergedValues = map[string]interface{}{}
+
+	errs := []error{}
+	if err := settings.ReadInConfig(); err != nil {
+		errs = append(errs, err)
+	} else if _, err := recordKeySources(settings.ConfigFileUsed()); err != nil {
+		errs = append(errs, err)
+	}
+
+	for _, additionalConfigFile := range additionalFiles {
+		fileSettings, err := recordKeySources(additionalConfigFile)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, key := range fileSettings.AllKeys() {
+			mergedValues[key] = fileSettings.Get(key)
+		}
+		if err := settings.MergeConfigMap(fileSettings.AllSettings()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// recordKeySources reads the given configuration file and records it as the
+// source of all the keys it sets. The settings read are returned.
+func recordKeySources(configFile string) (*viper.Viper, error) {
+	fileSettings := viper.New()
+	fileSettings.SetConfigFile(configFile)
+	if err := fileSettings.ReadInConfig(); err != nil {
+		return nil, err
+	}
+	configFilesUsed = append(configFilesUsed, configFile)
+	for _, key := range fileSettings.AllKeys() {
+		keySources[key] = configFile
+	}
+	return fileSettings, nil
+}
+
+// SettingsForFile returns the settings to write to configFile, by key. The
+// values merged from the other configuration files are not written, unless
+// they have been changed: configFile keeps its own value for these keys, if
+// any.
+func SettingsForFile(settings *viper.Viper, configFile string) map[string]interface{} {
+	ownSettings := viper.New()
+	ownSettings.SetConfigFile(configFile)
+	if err := ownSettings.ReadInConfig(); err != nil {
+		// The file may not exist yet
+		ownSettings = viper.New()
+	}
+
+	res := map[string]interface{}{}
+	for _, key := range settings.AllKeys() {
+		value := settings.Get(key)
+		source := keySources[key]
+		if merged, ok := mergedValues[key]; ok && source != configFile && reflect.DeepEqual(value, merged) {
+			if !ownSettings.IsSet(key) {
+				continue
+			}
+			value = ownSettings.Get(key)
+		}
+		res[key] = value
+	}
+	return res
+}
+
+// WriteConfigFile writes to configFile the settings returned by
+// SettingsForFile
+func WriteConfigFile(settings *viper.Viper, configFile string) error {
+	return WriteSettingsFile(SettingsForFile(settings, configFile), configFile)
+}
+
+// WriteSettingsFile writes the given settings, by key, to configFile
+func WriteSettingsFile(fileSettings map[string]interface{}, configFile string) error {
+	out := viper.New()
+	for key, value := range fileSettings {
+		out.Set(key, value)
+	}
+	return out.WriteConfigAs(configFile)
+}
+
+// ConfigFilesUsed returns all the configuration files read, in order of
+// precedence. The first one is the file returned by ConfigFileUsed.
+func ConfigFilesUsed() []string {
+	return configFilesUsed
+}
+
+// GetKeySource returns the configuration file setting the given key, or an
+// empty string if the key is not set by any configuration file
+func GetKeySource(key string) string {
+	return keySources[strings.ToLower(key)]
+}
+
 // BindFlags creates all the flags binding between the cobra Command and the instance of viper
 func BindFlags(cmd *cobra.Command, settings *viper.Viper) {
 	settings.BindPFlag("logging.level", cmd.Flag("log-level"))
@@ -80,6 +207,7 @@ func BindFlags(cmd *cobra.Command, settings *viper.Viper) {
 	settings.BindPFlag("logging.format", cmd.Flag("log-format"))
 	settings.BindPFlag("board_manager.additional_urls", cmd.Flag("additional-urls"))
 	settings.BindPFlag("output.no_color", cmd.Flag("no-color"))
+	settings.BindPFlag("network.offline", cmd.Flag("offline"))
 }
 
 // getDefaultArduinoDataDir returns the full path to the default arduino folder
@@ -191,12 +319,8 @@ func IsBundledInDesktopIDE(settings *viper.Viper) bool {
 // argument '--config-file' (if specified) or looking in the current working dir
 func FindConfigFileInArgsOrWorkingDirectory(args []string) string {
 	// Look for '--config-file' argument
-	for i, arg := range args {
-		if arg == "--config-file" {
-			if len(args) > i+1 {
-				return args[i+1]
-			}
-		}
+	if configFiles := findConfigFilesInArgs(args); len(configFiles) > 0 {
+		return configFiles[0]
 	}
 
 	// Look into current working directory
@@ -208,6 +332,30 @@ func FindConfigFileInArgsOrWorkingDirectory(args []string) string {
 	return ""
 }
 
+// FindAdditionalConfigFilesInArgs returns the config files paths specified with
+// the '--config-file' argument after the first one, they must be merged in
+// order over the first config file
+func FindAdditionalConfigFilesInArgs(args []string) []string {
+	if configFiles := findConfigFilesInArgs(args); len(configFiles) > 1 {
+		return configFiles[1:]
+	}
+	return []string{}
+}
+
+func findConfigFilesInArgs(args []string) []string {
+	res := []string{}
+	for i, arg := range args {
+		if arg == "--config-file" {
+			if len(args) > i+1 {
+				res = append(res, args[i+1])
+			}
+		} else if strings.HasPrefix(arg, "--config-file=") {
+			res = append(res, strings.TrimPrefix(arg, "--config-file="))
+		}
+	}
+	return res
+}
+
 func searchConfigTree(cwd *paths.Path) *paths.Path {
 	// go back up to root and search for the config file
 	for _, path := range cwd.Parents() {
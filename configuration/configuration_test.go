@@ -20,9 +20,11 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	paths "github.com/arduino/go-paths-helper"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 )
 
@@ -98,6 +100,7 @@ func TestInit(t *testing.T) {
 
 	require.Equal(t, true, settings.GetBool("metrics.enabled"))
 	require.Equal(t, ":9090", settings.GetString("metrics.addr"))
+	require.Equal(t, "", settings.GetString("metrics.endpoint"))
 }
 
 func TestFindConfigFile(t *testing.T) {
@@ -136,3 +139,160 @@ func TestFindConfigFile(t *testing.T) {
 	configFile = FindConfigFileInArgsOrWorkingDirectory([]string{})
 	require.Equal(t, filepath.Join(target, "arduino-cli.yaml"), configFile)
 }
+
+func TestFindAdditionalConfigFiles(t *testing.T) {
+	require.Empty(t, FindAdditionalConfigFilesInArgs([]string{"--config-file", "team.yaml"}))
+
+	args := []string{"--config-file", "team.yaml", "--config-file=me.yaml", "--config-file", "ci.yaml"}
+	require.Equal(t, "team.yaml", FindConfigFileInArgsOrWorkingDirectory(args))
+	require.Equal(t, []string{"me.yaml", "ci.yaml"}, FindAdditionalConfigFilesInArgs(args))
+}
+
+func TestInitWithAdditionalConfigFiles(t *testing.T) {
+	tmp := tmpDirOrDie()
+	defer os.RemoveAll(tmp)
+	teamConfig := filepath.Join(tmp, "team.yaml")
+	require.NoError(t, ioutil.WriteFile(teamConfig, []byte("logging:\n  level: warn\nnetwork:\n  retries: 5\n"), 0644))
+	userConfig := filepath.Join(tmp, "me.yaml")
+	require.NoError(t, ioutil.WriteFile(userConfig, []byte("logging:\n  level: debug\n"), 0644))
+
+	settings := Init(teamConfig, userConfig)
+	require.Equal(t, teamConfig, settings.ConfigFileUsed())
+	require.Equal(t, []string{teamConfig, userConfig}, ConfigFilesUsed())
+	require.Equal(t, "debug", settings.GetString("logging.level"))
+	require.Equal(t, 5, settings.GetInt("network.retries"))
+	require.Equal(t, "text", settings.GetString("logging.format"))
+
+	require.Equal(t, userConfig, GetKeySource("logging.level"))
+	require.Equal(t, teamConfig, GetKeySource("network.retries"))
+	require.Equal(t, "", GetKeySource("logging.format"))
+}
+
+func TestReloadWithAdditionalConfigFiles(t *testing.T) {
+	tmp := tmpDirOrDie()
+	defer os.RemoveAll(tmp)
+	teamConfig := filepath.Join(tmp, "team.yaml")
+	require.NoError(t, ioutil.WriteFile(teamConfig, []byte("logging:\n  level: warn\nnetwork:\n  retries: 5\n"), 0644))
+	userConfig := filepath.Join(tmp, "me.yaml")
+	require.NoError(t, ioutil.WriteFile(userConfig, []byte("logging:\n  level: debug\n"), 0644))
+
+	settings := Init(teamConfig, userConfig)
+	require.NoError(t, ioutil.WriteFile(teamConfig, []byte("logging:\n  level: warn\nnetwork:\n  retries: 6\n"), 0644))
+	require.NoError(t, Reload(settings))
+	// The additional files are merged again
+	require.Equal(t, "debug", settings.GetString("logging.level"))
+	require.Equal(t, 6, settings.GetInt("network.retries"))
+	require.Equal(t, userConfig, GetKeySource("logging.level"))
+	require.Equal(t, []string{teamConfig, userConfig}, ConfigFilesUsed())
+}
+
+func TestSettingsForFile(t *testing.T) {
+	tmp := tmpDirOrDie()
+	defer os.RemoveAll(tmp)
+	teamConfig := filepath.Join(tmp, "team.yaml")
+	require.NoError(t, ioutil.WriteFile(teamConfig, []byte("logging:\n  level: warn\nnetwork:\n  retries: 5\n"), 0644))
+	userConfig := filepath.Join(tmp, "me.yaml")
+	require.NoError(t, ioutil.WriteFile(userConfig, []byte("logging:\n  level: debug\n  format: json\n"), 0644))
+
+	settings := Init(teamConfig, userConfig)
+	settings.Set("network.retries", 7)
+
+	// The values merged from me.yaml are not written to team.yaml, team.yaml
+	// keeps its own value if it has one
+	fileSettings := SettingsForFile(settings, teamConfig)
+	require.Equal(t, "warn", fileSettings["logging.level"])
+	require.NotContains(t, fileSettings, "logging.format")
+	require.Equal(t, 7, fileSettings["network.retries"])
+
+	// A changed value is written
+	settings.Set("logging.format", "text")
+	require.Equal(t, "text", SettingsForFile(settings, teamConfig)["logging.format"])
+
+	require.NoError(t, WriteConfigFile(settings, teamConfig))
+	data, err := ioutil.ReadFile(teamConfig)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "level: warn")
+	require.NotContains(t, string(data), "debug")
+}
+
+func TestGetEnvOverrides(t *testing.T) {
+	os.Setenv("ARDUINO_NETWORK_RETRIES", "7")
+	os.Setenv("ARDUINO_SKETCHBOOK_DIR", "/tmp/sketchbook")
+	os.Setenv("ARDUINO_LOGGING_LEVEL", "")
+	defer os.Unsetenv("ARDUINO_NETWORK_RETRIES")
+	defer os.Unsetenv("ARDUINO_SKETCHBOOK_DIR")
+	defer os.Unsetenv("ARDUINO_LOGGING_LEVEL")
+
+	require.Equal(t, []string{"ARDUINO_DIRECTORIES_USER", "ARDUINO_SKETCHBOOK_DIR"}, EnvVarNames("directories.User"))
+
+	overrides := GetEnvOverrides([]string{"directories.user", "logging.level", "network.offline", "network.retries"})
+	require.Equal(t, []*EnvOverride{
+		{Key: "directories.user", EnvVar: "ARDUINO_SKETCHBOOK_DIR", Value: "/tmp/sketchbook"},
+		{Key: "network.retries", EnvVar: "ARDUINO_NETWORK_RETRIES", Value: "7"},
+	}, overrides)
+}
+
+func TestIndexSignatureKeyPath(t *testing.T) {
+	loadSettings := func(config string) *viper.Viper {
+		settings := viper.New()
+		settings.SetConfigType("yaml")
+		require.NoError(t, settings.ReadConfig(strings.NewReader(config)))
+		return settings
+	}
+	settings := loadSettings(`
+board_manager:
+  index_signature_keys:
+    - url: https://example.com/package_example_index.json
+      public_key: /keys/example.gpg.key
+`)
+
+	key, err := IndexSignatureKeyPath(settings, "https://example.com/package_example_index.json")
+	require.NoError(t, err)
+	require.Equal(t, paths.New("/keys/example.gpg.key"), key)
+
+	key, err = IndexSignatureKeyPath(settings, "https://example.com/package_other_index.json")
+	require.NoError(t, err)
+	require.Nil(t, key)
+
+	key, err = IndexSignatureKeyPath(viper.New(), "https://example.com/package_example_index.json")
+	require.NoError(t, err)
+	require.Nil(t, key)
+
+	settings = loadSettings(`
+board_manager:
+  index_signature_keys:
+    - url: https://example.com/package_nokey_index.json
+`)
+	_, err = IndexSignatureKeyPath(settings, "https://example.com/package_other_index.json")
+	require.Error(t, err)
+
+	settings = loadSettings(`
+board_manager:
+  index_signature_keys: https://example.com/package_example_index.json
+`)
+	_, err = IndexSignatureKeys(settings)
+	require.Error(t, err)
+}
+
+func TestPinnedPlatformVersion(t *testing.T) {
+	settings := viper.New()
+	settings.Set("board_manager.pinned_platforms", []string{"arduino:avr@1.8.3", "esp32:esp32@2.0.0"})
+
+	version, err := PinnedPlatformVersion(settings, "arduino", "avr")
+	require.NoError(t, err)
+	require.Equal(t, "1.8.3", version.String())
+
+	version, err = PinnedPlatformVersion(settings, "arduino", "samd")
+	require.NoError(t, err)
+	require.Nil(t, version)
+
+	version, err = PinnedPlatformVersion(viper.New(), "arduino", "avr")
+	require.NoError(t, err)
+	require.Nil(t, version)
+
+	for _, invalid := range []string{"arduino:avr", "arduino@1.8.3", "arduino:avr@not.a.version"} {
+		settings.Set("board_manager.pinned_platforms", []string{invalid})
+		_, err = PinnedPlatformVersion(settings, "arduino", "avr")
+		require.Error(t, err, invalid)
+	}
+}
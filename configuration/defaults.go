@@ -16,6 +16,7 @@
 package configuration
 
 import (
+	"os"
 	"path/filepath"
 	"strings"
 
@@ -28,6 +29,9 @@ func SetDefaults(settings *viper.Viper) {
 	settings.SetDefault("logging.level", "info")
 	settings.SetDefault("logging.format", "text")
 
+	// i18n
+	settings.SetDefault("i18n.extra_catalogs", "")
+
 	// Libraries
 	settings.SetDefault("library.enable_unsafe_install", false)
 
@@ -48,9 +52,17 @@ func SetDefaults(settings *viper.Viper) {
 	// metrics settings
 	settings.SetDefault("metrics.enabled", true)
 	settings.SetDefault("metrics.addr", ":9090")
+	settings.SetDefault("metrics.endpoint", "")
+
+	// network settings
+	settings.SetDefault("network.offline", false)
+	settings.SetDefault("network.parallel_downloads", 4)
+	settings.SetDefault("network.retries", 3)
+	settings.SetDefault("network.retry_backoff", "1s")
 
 	// output settings
 	settings.SetDefault("output.no_color", false)
+	settings.SetDefault("output.color", "auto")
 
 	// updater settings
 	settings.SetDefault("updater.enable_notification", true)
@@ -61,9 +73,50 @@ func SetDefaults(settings *viper.Viper) {
 	settings.AutomaticEnv()
 
 	// Bind env aliases to keep backward compatibility
-	settings.BindEnv("library.enable_unsafe_install", "ARDUINO_ENABLE_UNSAFE_LIBRARY_INSTALL")
-	settings.BindEnv("directories.User", "ARDUINO_SKETCHBOOK_DIR")
-	settings.BindEnv("directories.Downloads", "ARDUINO_DOWNLOADS_DIR")
-	settings.BindEnv("directories.Data", "ARDUINO_DATA_DIR")
-	settings.BindEnv("sketch.always_export_binaries", "ARDUINO_SKETCH_ALWAYS_EXPORT_BINARIES")
+	for key, envVar := range envAliases {
+		settings.BindEnv(key, envVar)
+	}
+}
+
+// envAliases are the environment variables, bound to the keys in addition to the
+// ones derived from the key name, kept for backward compatibility
+var envAliases = map[string]string{
+	"library.enable_unsafe_install": "ARDUINO_ENABLE_UNSAFE_LIBRARY_INSTALL",
+	"directories.user":              "ARDUINO_SKETCHBOOK_DIR",
+	"directories.downloads":         "ARDUINO_DOWNLOADS_DIR",
+	"directories.data":              "ARDUINO_DATA_DIR",
+	"sketch.always_export_binaries": "ARDUINO_SKETCH_ALWAYS_EXPORT_BINARIES",
+}
+
+// EnvVarNames returns the environment variables that can be used to set the
+// given key, in order of precedence
+func EnvVarNames(key string) []string {
+	key = strings.ToLower(key)
+	res := []string{"ARDUINO_" + strings.ToUpper(strings.ReplaceAll(key, ".", "_"))}
+	if alias, ok := envAliases[key]; ok {
+		res = append(res, alias)
+	}
+	return res
+}
+
+// EnvOverride is a configuration key set by an environment variable
+type EnvOverride struct {
+	Key    string `json:"key"`
+	EnvVar string `json:"env_var"`
+	Value  string `json:"value"`
+}
+
+// GetEnvOverrides returns the given keys that are currently set by an
+// environment variable (empty variables are ignored, as they are by viper)
+func GetEnvOverrides(keys []string) []*EnvOverride {
+	res := []*EnvOverride{}
+	for _, key := range keys {
+		for _, envVar := range EnvVarNames(key) {
+			if value := os.Getenv(envVar); value != "" {
+				res = append(res, &EnvOverride{Key: key, EnvVar: envVar, Value: value})
+				break
+			}
+		}
+	}
+	return res
 }
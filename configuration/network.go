@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"net/url"
 	"runtime"
+	"time"
 
 	"github.com/arduino/arduino-cli/cli/globals"
 	"github.com/spf13/viper"
@@ -55,3 +56,35 @@ func NetworkProxy(settings *viper.Viper) (*url.URL, error) {
 		return proxy, nil
 	}
 }
+
+// NetworkOffline returns true if the offline mode is enabled: in this mode
+// every network access fails immediately
+func NetworkOffline(settings *viper.Viper) bool {
+	return settings.GetBool("network.offline")
+}
+
+// NetworkParallelDownloads returns the maximum number of files downloaded at the
+// same time during the installation of a platform
+func NetworkParallelDownloads(settings *viper.Viper) int {
+	if n := settings.GetInt("network.parallel_downloads"); n > 0 {
+		return n
+	}
+	return 1
+}
+
+// NetworkRetries returns the number of times a failed download is retried
+func NetworkRetries(settings *viper.Viper) int {
+	if n := settings.GetInt("network.retries"); n > 0 {
+		return n
+	}
+	return 0
+}
+
+// NetworkRetryBackoff returns the time to wait before the first retry of a failed
+// download, the wait time is doubled at each subsequent retry
+func NetworkRetryBackoff(settings *viper.Viper) time.Duration {
+	if d := settings.GetDuration("network.retry_backoff"); d > 0 {
+		return d
+	}
+	return 0
+}
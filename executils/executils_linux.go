@@ -15,7 +15,22 @@
 
 package executils
 
-import "os/exec"
+import (
+	"os/exec"
+	"syscall"
+)
 
 func tellCommandNotToSpawnShell(_ *exec.Cmd) {
 }
+
+func setNewProcessGroup(oscmd *exec.Cmd) {
+	if oscmd.SysProcAttr == nil {
+		oscmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	oscmd.SysProcAttr.Setpgid = true
+}
+
+func killProcessGroup(oscmd *exec.Cmd) error {
+	// A negative pid sends the signal to all the processes of the group
+	return syscall.Kill(-oscmd.Process.Pid, syscall.SIGKILL)
+}
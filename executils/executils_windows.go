@@ -17,9 +17,30 @@ package executils
 
 import (
 	"os/exec"
+	"strconv"
 	"syscall"
 )
 
 func tellCommandNotToSpawnShell(oscmd *exec.Cmd) {
-	oscmd.SysProcAttr = &syscall.SysProcAttr{HideWindow: true}
+	if oscmd.SysProcAttr == nil {
+		oscmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	oscmd.SysProcAttr.HideWindow = true
+}
+
+func setNewProcessGroup(oscmd *exec.Cmd) {
+	if oscmd.SysProcAttr == nil {
+		oscmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	oscmd.SysProcAttr.CreationFlags |= syscall.CREATE_NEW_PROCESS_GROUP
+}
+
+func killProcessGroup(oscmd *exec.Cmd) error {
+	// taskkill terminates the whole tree of the processes started by the command
+	kill := exec.Command("taskkill", "/T", "/F", "/PID", strconv.Itoa(oscmd.Process.Pid))
+	tellCommandNotToSpawnShell(kill)
+	if err := kill.Run(); err != nil {
+		return oscmd.Process.Kill()
+	}
+	return nil
 }
@@ -148,17 +148,36 @@ func (p *Process) SetEnvironment(values []string) {
 }
 
 // RunWithinContext starts the specified command and waits for it to complete. If the given context
-// is canceled before the normal process termination, the process is killed.
+// is canceled before the normal process termination, the process is killed together with all
+// the processes started by it.
 func (p *Process) RunWithinContext(ctx context.Context) error {
+	return RunCommandWithinContext(ctx, p.cmd)
+}
+
+// RunCommandWithinContext starts the given command and waits for it to complete. If the given
+// context can be canceled, the command is started in a new process group that is killed if the
+// context is canceled before the normal process termination.
+func RunCommandWithinContext(ctx context.Context, cmd *exec.Cmd) error {
+	if ctx.Done() == nil {
+		// The context can't be canceled, leave the command in our process
+		// group so it receives the signals sent from the terminal
+		return cmd.Run()
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	setNewProcessGroup(cmd)
+	if err := cmd.Start(); err != nil {
+		return err
+	}
 	completed := make(chan struct{})
 	defer close(completed)
 	go func() {
 		select {
 		case <-ctx.Done():
-			p.Kill()
+			killProcessGroup(cmd)
 		case <-completed:
 		}
 	}()
-	res := p.cmd.Run()
-	return res
+	return cmd.Wait()
 }
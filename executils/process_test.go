@@ -16,7 +16,9 @@
 package executils
 
 import (
+	"bytes"
 	"context"
+	"runtime"
 	"testing"
 	"time"
 
@@ -40,3 +42,20 @@ func TestProcessWithinContext(t *testing.T) {
 	require.Less(t, time.Since(start), 500*time.Millisecond)
 	cancel()
 }
+
+func TestProcessWithinContextKillsChildren(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("sh not available")
+	}
+	// The child keeps the output pipe open: Wait would not return until the
+	// child terminates, if it is not killed together with its parent
+	process, err := NewProcess(nil, "sh", "-c", "sleep 30 & wait")
+	require.NoError(t, err)
+	process.RedirectStdoutTo(&bytes.Buffer{})
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), 250*time.Millisecond)
+	defer cancel()
+	err = process.RunWithinContext(ctx)
+	require.Error(t, err)
+	require.Less(t, time.Since(start), 5*time.Second)
+}
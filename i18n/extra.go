@@ -0,0 +1,107 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package i18n
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/leonelquinteros/gotext"
+)
+
+// extraCatalogsDir is the directory containing the catalogs loaded in addition
+// to the built-in ones, the catalogs are named <locale>.po or <locale>.json
+var extraCatalogsDir string
+
+// extraPo contains the translations of the extra catalog of the selected
+// locale, it's nil if there is no such catalog
+var extraPo *gotext.Po
+
+// extraLocales returns the locales of the catalogs found in extraCatalogsDir
+func extraLocales() []string {
+	if extraCatalogsDir == "" {
+		return nil
+	}
+	files, err := os.ReadDir(extraCatalogsDir)
+	if err != nil {
+		return nil
+	}
+	var locales []string
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		ext := filepath.Ext(file.Name())
+		if ext != ".po" && ext != ".json" {
+			continue
+		}
+		locale := strings.TrimSuffix(file.Name(), ext)
+		if !contains(locales, locale) {
+			locales = append(locales, locale)
+		}
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// loadExtraCatalog loads the extra catalog of the given locale, the .po
+// catalog has the precedence over the .json one
+func loadExtraCatalog(locale string) *gotext.Po {
+	if extraCatalogsDir == "" {
+		return nil
+	}
+	if data, err := os.ReadFile(filepath.Join(extraCatalogsDir, locale+".po")); err == nil {
+		extra := new(gotext.Po)
+		extra.Parse(data)
+		return extra
+	}
+	if data, err := os.ReadFile(filepath.Join(extraCatalogsDir, locale+".json")); err == nil {
+		if extra, err := parseJSONCatalog(data); err == nil {
+			return extra
+		}
+	}
+	return nil
+}
+
+// parseJSONCatalog parses a JSON catalog, an object mapping each message to
+// its translation
+func parseJSONCatalog(data []byte) (*gotext.Po, error) {
+	var translations map[string]string
+	if err := json.Unmarshal(data, &translations); err != nil {
+		return nil, err
+	}
+	var poFile strings.Builder
+	for msgid, msgstr := range translations {
+		poFile.WriteString("msgid " + strconv.Quote(msgid) + "\n")
+		poFile.WriteString("msgstr " + strconv.Quote(msgstr) + "\n\n")
+	}
+	extra := new(gotext.Po)
+	extra.Parse([]byte(poFile.String()))
+	return extra, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -19,8 +19,12 @@ package i18n
 // 1. Locale specified via the function call
 // 2. OS Locale
 // 3. en (default)
-func Init(configLocale string) {
-	locales := supportedLocales()
+// If extraCatalogsDir is not empty, the catalogs found in that directory are used
+// in addition to the built-in ones: the messages missing from an extra catalog are
+// translated with the built-in catalog, and then fall back to English.
+func Init(configLocale string, extraCatalogsDir string) {
+	setExtraCatalogsDir(extraCatalogsDir)
+	locales := SupportedLocales()
 	if configLocale != "" {
 		if locale := findMatchingLocale(configLocale, locales); locale != "" {
 			setLocale(locale)
@@ -41,5 +45,10 @@ func Init(configLocale string) {
 // Tr returns msg translated to the selected locale
 // the msg argument must be a literal string
 func Tr(msg string, args ...interface{}) string {
+	if extraPo != nil {
+		if translated := extraPo.Get(msg); translated != msg {
+			return extraPo.Get(msg, args...)
+		}
+	}
 	return po.Get(msg, args...)
 }
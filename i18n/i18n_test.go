@@ -17,6 +17,8 @@ package i18n
 
 import (
 	"bytes"
+	"os"
+	"path/filepath"
 	"testing"
 	"text/template"
 
@@ -95,3 +97,27 @@ func TestTranslationWithLineBreaks(t *testing.T) {
 	require.Equal(t, "test-key-translated", Tr(`test-key "quoted"
 new line`))
 }
+
+func TestExtraCatalogs(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "it_IT.po"), []byte(`
+		msgid "test-key-extra %s"
+		msgstr "test-key-extra-translated %s"
+	`), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "xx.json"), []byte(`{"test-key-json": "test-key-json-translated"}`), 0644))
+	defer Init("en", "")
+
+	Init("it_IT", dir)
+	require.Equal(t, "it_IT", CurrentLocale())
+	require.Equal(t, "test-key-extra-translated message", Tr("test-key-extra %s", "message"))
+	// Missing keys fall back to the built-in catalog and then to English
+	require.Equal(t, "Informazioni aggiuntive:", Tr("Additional help topics:"))
+	require.Equal(t, "test-key", Tr("test-key"))
+
+	// The locales of the extra catalogs are supported even if not built-in
+	require.Contains(t, SupportedLocales(), "xx")
+	Init("xx", dir)
+	require.Equal(t, "xx", CurrentLocale())
+	require.Equal(t, "test-key-json-translated", Tr("test-key-json"))
+	require.Equal(t, "test-key", Tr("test-key"))
+}
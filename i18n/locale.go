@@ -24,6 +24,9 @@ import (
 
 var po *gotext.Po
 
+// currentLocale is the locale selected with setLocale
+var currentLocale string
+
 //go:embed data/*.po
 var contents embed.FS
 
@@ -31,6 +34,29 @@ func init() {
 	po = new(gotext.Po)
 }
 
+// SupportedLocales returns the list of the locales bundled with Arduino CLI,
+// and of the ones provided by the extra catalogs
+func SupportedLocales() []string {
+	locales := supportedLocales()
+	for _, locale := range extraLocales() {
+		if !contains(locales, locale) {
+			locales = append(locales, locale)
+		}
+	}
+	return locales
+}
+
+func setExtraCatalogsDir(dir string) {
+	extraCatalogsDir = dir
+	extraPo = nil
+}
+
+// CurrentLocale returns the locale currently selected, or an empty string if
+// the i18n module has not been initialized
+func CurrentLocale() string {
+	return currentLocale
+}
+
 func supportedLocales() []string {
 	var locales []string
 	files, err := contents.ReadDir("data")
@@ -71,10 +97,14 @@ func findMatchingLocale(locale string, supportedLocales []string) string {
 }
 
 func setLocale(locale string) {
-	poFile, err := contents.ReadFile("data/" + locale + ".po")
-	if err != nil {
-		panic("Error reading embedded i18n data: " + err.Error())
-	}
+	extraPo = loadExtraCatalog(locale)
 	po = new(gotext.Po)
-	po.Parse(poFile)
+	if contains(supportedLocales(), locale) {
+		poFile, err := contents.ReadFile("data/" + locale + ".po")
+		if err != nil {
+			panic("Error reading embedded i18n data: " + err.Error())
+		}
+		po.Parse(poFile)
+	}
+	currentLocale = locale
 }
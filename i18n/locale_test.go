@@ -36,3 +36,15 @@ func TestLocaleMatch(t *testing.T) {
 	require.Equal(t, "", findMatchingLocale("es", supportedLocales), "Multiple languages match")
 	require.Equal(t, "", findMatchingLocale("zn_CH", supportedLocales), "Not supported")
 }
+
+func TestSupportedLocales(t *testing.T) {
+	locales := SupportedLocales()
+	require.Contains(t, locales, "en")
+	require.Contains(t, locales, "it_IT")
+	require.NotContains(t, locales, "en.po")
+
+	setLocale("it_IT")
+	require.Equal(t, "it_IT", CurrentLocale())
+	setLocale("en")
+	require.Equal(t, "en", CurrentLocale())
+}
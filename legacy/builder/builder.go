@@ -183,6 +183,9 @@ func runCommands(ctx *types.Context, commands []types.Command) error {
 	defer ctx.Progress.RemoveSubSteps()
 
 	for _, command := range commands {
+		if ctx.CancelContext != nil && ctx.CancelContext.Err() != nil {
+			return errors.WithStack(ctx.CancelContext.Err())
+		}
 		PrintRingNameIfDebug(ctx, command)
 		err := command.Run(ctx)
 		if err != nil {
@@ -23,6 +23,7 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/arduino/arduino-cli/arduino/globals"
 	"github.com/arduino/arduino-cli/i18n"
@@ -94,6 +95,7 @@ func compileFiles(ctx *types.Context, sourcePath *paths.Path, recurse bool, buil
 	}
 
 	sources.FilterSuffix(validExtensions...)
+	sources.Sort()
 	ctx.Progress.AddSubSteps(len(sources))
 	defer ctx.Progress.RemoveSubSteps()
 
@@ -102,22 +104,41 @@ func compileFiles(ctx *types.Context, sourcePath *paths.Path, recurse bool, buil
 	if len(sources) == 0 {
 		return objectFiles, nil
 	}
-	var errorsList []error
+	// The errors are indexed by the position of the source file, this way
+	// the error reported is always the same regardless of the scheduling
+	errorsList := map[int]error{}
 	var errorsMux sync.Mutex
+	var progressMux sync.Mutex
+
+	type queuedSource struct {
+		index  int
+		source *paths.Path
+	}
+	queue := make(chan queuedSource)
+	job := func(index int, source *paths.Path) {
+		// Report the file being compiled when the compile starts...
+		progressMux.Lock()
+		ctx.PushProgressWithMessage(tr("Compiling"), source.String())
+		progressMux.Unlock()
 
-	queue := make(chan *paths.Path)
-	job := func(source *paths.Path) {
 		recipe := fmt.Sprintf("recipe%s.o.pattern", source.Ext())
 		objectFile, err := compileFileWithRecipe(ctx, sourcePath, source, buildPath, buildProperties, includes, recipe)
 		if err != nil {
 			errorsMux.Lock()
-			errorsList = append(errorsList, err)
+			errorsList[index] = err
 			errorsMux.Unlock()
 		} else {
 			objectFilesMux.Lock()
 			objectFiles.Add(objectFile)
 			objectFilesMux.Unlock()
 		}
+
+		// ...and advance the percentage when it completes, the percentage is
+		// derived from the number of files compiled over the total
+		progressMux.Lock()
+		ctx.Progress.CompleteStep()
+		ctx.PushProgress()
+		progressMux.Unlock()
 	}
 
 	// Spawn jobs runners
@@ -129,31 +150,34 @@ func compileFiles(ctx *types.Context, sourcePath *paths.Path, recurse bool, buil
 	for i := 0; i < jobs; i++ {
 		wg.Add(1)
 		go func() {
-			for source := range queue {
-				job(source)
+			for queued := range queue {
+				job(queued.index, queued.source)
 			}
 			wg.Done()
 		}()
 	}
 
 	// Feed jobs until error or done
-	for _, source := range sources {
+	for i, source := range sources {
 		errorsMux.Lock()
 		gotError := len(errorsList) > 0
 		errorsMux.Unlock()
 		if gotError {
 			break
 		}
-		queue <- source
-
-		ctx.Progress.CompleteStep()
-		ctx.PushProgress()
+		queue <- queuedSource{index: i, source: source}
 	}
 	close(queue)
 	wg.Wait()
 	if len(errorsList) > 0 {
-		// output the first error
-		return nil, errors.WithStack(errorsList[0])
+		// output the error of the first source file in order
+		first := len(sources)
+		for i := range errorsList {
+			if i < first {
+				first = i
+			}
+		}
+		return nil, errors.WithStack(errorsList[first])
 	}
 	objectFiles.Sort()
 	return objectFiles, nil
@@ -188,16 +212,26 @@ func compileFileWithRecipe(ctx *types.Context, sourcePath *paths.Path, source *p
 	if ctx.CompilationDatabase != nil {
 		ctx.CompilationDatabase.Add(source, command)
 	}
-	if !objIsUpToDate && !ctx.OnlyUpdateCompilationDatabase {
-		_, _, err = utils.ExecCommand(ctx, command, utils.ShowIfVerbose /* stdout */, utils.Show /* stderr */)
+	// Added after the compilation database entry, that is written to disk
+	command.Args = append(command.Args, ctx.Defines...)
+	if (!objIsUpToDate || ctx.DryRun) && !ctx.OnlyUpdateCompilationDatabase {
+		_, _, err = utils.ExecBuildCommand(ctx, command, utils.ShowIfVerbose /* stdout */, utils.Show /* stderr */)
 		if err != nil {
 			return nil, errors.WithStack(err)
 		}
-	} else if ctx.Verbose {
+		atomic.AddInt32(&ctx.CompiledFiles, 1)
+	} else {
 		if objIsUpToDate {
-			ctx.Info(tr("Using previously compiled file: %[1]s", objectFile))
-		} else {
-			ctx.Info(tr("Skipping compile of: %[1]s", objectFile))
+			atomic.AddInt32(&ctx.ReusedFiles, 1)
+			// Reused objects count as built, to get the same digest of an incremental build
+			ctx.AddBuildCommand(utils.PrintableBuildCommand(ctx, command.Args))
+		}
+		if ctx.Verbose {
+			if objIsUpToDate {
+				ctx.Info(tr("Using previously compiled file: %[1]s", objectFile))
+			} else {
+				ctx.Info(tr("Skipping compile of: %[1]s", objectFile))
+			}
 		}
 	}
 
@@ -380,7 +414,21 @@ func ArchiveCompiledFiles(ctx *types.Context, buildPath *paths.Path, archiveFile
 		return archiveFilePath, nil
 	}
 
-	if archiveFileStat, err := archiveFilePath.Stat(); err == nil {
+	commands := []*exec.Cmd{}
+	for _, objectFile := range objectFilesToArchive {
+		properties := buildProperties.Clone()
+		properties.Set(constants.BUILD_PROPERTIES_ARCHIVE_FILE, archiveFilePath.Base())
+		properties.SetPath(constants.BUILD_PROPERTIES_ARCHIVE_FILE_PATH, archiveFilePath)
+		properties.SetPath(constants.BUILD_PROPERTIES_OBJECT_FILE, objectFile)
+
+		command, err := PrepareCommandForRecipe(properties, constants.RECIPE_AR_PATTERN, false, ctx.PackageManager.GetEnvVarsForSpawnedProcess())
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		commands = append(commands, command)
+	}
+
+	if archiveFileStat, err := archiveFilePath.Stat(); err == nil && !ctx.DryRun {
 		rebuildArchive := false
 		for _, objectFile := range objectFilesToArchive {
 			objectFileStat, err := objectFile.Stat()
@@ -400,22 +448,16 @@ func ArchiveCompiledFiles(ctx *types.Context, buildPath *paths.Path, archiveFile
 			if ctx.Verbose {
 				ctx.Info(tr("Using previously compiled file: %[1]s", archiveFilePath))
 			}
+			// The reused archive counts as built, to get the same digest of an incremental build
+			for _, command := range commands {
+				ctx.AddBuildCommand(utils.PrintableBuildCommand(ctx, command.Args))
+			}
 			return archiveFilePath, nil
 		}
 	}
 
-	for _, objectFile := range objectFilesToArchive {
-		properties := buildProperties.Clone()
-		properties.Set(constants.BUILD_PROPERTIES_ARCHIVE_FILE, archiveFilePath.Base())
-		properties.SetPath(constants.BUILD_PROPERTIES_ARCHIVE_FILE_PATH, archiveFilePath)
-		properties.SetPath(constants.BUILD_PROPERTIES_OBJECT_FILE, objectFile)
-
-		command, err := PrepareCommandForRecipe(properties, constants.RECIPE_AR_PATTERN, false, ctx.PackageManager.GetEnvVarsForSpawnedProcess())
-		if err != nil {
-			return nil, errors.WithStack(err)
-		}
-
-		_, _, err = utils.ExecCommand(ctx, command, utils.ShowIfVerbose /* stdout */, utils.Show /* stderr */)
+	for _, command := range commands {
+		_, _, err := utils.ExecBuildCommand(ctx, command, utils.ShowIfVerbose /* stdout */, utils.Show /* stderr */)
 		if err != nil {
 			return nil, errors.WithStack(err)
 		}
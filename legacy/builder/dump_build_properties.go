@@ -17,22 +17,35 @@ package builder
 
 import (
 	"fmt"
+	"os"
 	"sort"
 
 	"github.com/arduino/arduino-cli/legacy/builder/types"
+	properties "github.com/arduino/go-properties-orderedmap"
 )
 
 type DumpBuildProperties struct{}
 
 func (s *DumpBuildProperties) Run(ctx *types.Context) error {
-	buildProperties := ctx.BuildProperties
+	out := ctx.Stdout
+	if out == nil {
+		out = os.Stdout
+	}
+	for _, line := range ExpandedBuildProperties(ctx.BuildProperties) {
+		fmt.Fprintln(out, line)
+	}
+	return nil
+}
 
+// ExpandedBuildProperties returns the build properties as "key=value" lines
+// sorted by key, with the references to the other properties expanded.
+func ExpandedBuildProperties(buildProperties *properties.Map) []string {
 	keys := buildProperties.Keys()
 	sort.Strings(keys)
 
+	res := []string{}
 	for _, key := range keys {
-		fmt.Println(key + "=" + buildProperties.Get(key))
+		res = append(res, key+"="+buildProperties.ExpandPropsInString(buildProperties.Get(key)))
 	}
-
-	return nil
+	return res
 }
@@ -30,7 +30,7 @@ import (
 type MergeSketchWithBootloader struct{}
 
 func (s *MergeSketchWithBootloader) Run(ctx *types.Context) error {
-	if ctx.OnlyUpdateCompilationDatabase {
+	if ctx.OnlyUpdateCompilationDatabase || ctx.DryRun {
 		return nil
 	}
 
@@ -93,9 +93,13 @@ func compileCore(ctx *types.Context, buildPath *paths.Path, buildCachePath *path
 	var targetArchivedCore *paths.Path
 	if buildCachePath != nil {
 		archivedCoreName := GetCachedCoreArchiveFileName(buildProperties.Get(constants.BUILD_PROPERTIES_FQBN),
-			buildProperties.Get("compiler.optimization_flags"), realCoreFolder)
+			buildProperties, realCoreFolder)
 		targetArchivedCore = buildCachePath.Join(archivedCoreName)
+		if ctx.Verbose {
+			ctx.Info(tr("Core cache key: %[1]s", coreCacheKeyHash(buildProperties, realCoreFolder)))
+		}
 		canUseArchivedCore := !ctx.OnlyUpdateCompilationDatabase &&
+			!ctx.DryRun &&
 			!ctx.Clean &&
 			!builder_utils.CoreOrReferencedCoreHasChanged(realCoreFolder, targetCoreFolder, targetArchivedCore)
 
@@ -104,6 +108,7 @@ func compileCore(ctx *types.Context, buildPath *paths.Path, buildCachePath *path
 			if ctx.Verbose {
 				ctx.Info(tr("Using precompiled core: %[1]s", targetArchivedCore))
 			}
+			ctx.UsedCachedCore = true
 			return targetArchivedCore, variantObjectFiles, nil
 		}
 	}
@@ -119,7 +124,7 @@ func compileCore(ctx *types.Context, buildPath *paths.Path, buildCachePath *path
 	}
 
 	// archive core.a
-	if targetArchivedCore != nil && !ctx.OnlyUpdateCompilationDatabase {
+	if targetArchivedCore != nil && !ctx.OnlyUpdateCompilationDatabase && !ctx.DryRun {
 		err := archiveFile.CopyTo(targetArchivedCore)
 		if ctx.Verbose {
 			if err == nil {
@@ -137,15 +142,59 @@ func compileCore(ctx *types.Context, buildPath *paths.Path, buildCachePath *path
 	return archiveFile, variantObjectFiles, nil
 }
 
-// GetCachedCoreArchiveFileName returns the filename to be used to store
-// the global cached core.a.
-func GetCachedCoreArchiveFileName(fqbn string, optimizationFlags string, coreFolder *paths.Path) string {
-	fqbnToUnderscore := strings.Replace(fqbn, ":", "_", -1)
-	fqbnToUnderscore = strings.Replace(fqbnToUnderscore, "=", "_", -1)
+// coreCacheKeyRecipes are the recipes used to build the core, the cached
+// core can be reused only if all of them expand to the same command lines
+var coreCacheKeyRecipes = []string{
+	"recipe.c.o.pattern",
+	"recipe.cpp.o.pattern",
+	"recipe.S.o.pattern",
+	"recipe.ar.pattern",
+}
+
+// coreCacheKey returns the properties that affect the build of the core:
+// the core and variant folders and the expanded recipes used to compile the
+// core. This way the menu options of the board that don't change the
+// compiler command lines share the same cached core.
+func coreCacheKey(buildProperties *properties.Map, coreFolder *paths.Path) []string {
 	if absCoreFolder, err := coreFolder.Abs(); err == nil {
 		coreFolder = absCoreFolder
 	} // silently continue if absolute path can't be detected
-	hash := utils.MD5Sum([]byte(coreFolder.String() + optimizationFlags))
+
+	props := buildProperties.Clone()
+	// Remove the properties that change from sketch to sketch, or from build
+	// to build, that are not related to the core
+	props.Remove("build.path")
+	props.Remove("build.project_name")
+	props.Remove("build.source.path")
+	for _, key := range props.Keys() {
+		if strings.HasPrefix(key, "extra.time.") {
+			props.Remove(key)
+		}
+	}
+
+	key := []string{
+		"core=" + coreFolder.String(),
+		"variant=" + props.Get("build.variant.path"),
+	}
+	for _, recipe := range coreCacheKeyRecipes {
+		key = append(key, recipe+"="+props.ExpandPropsInString(props.Get(recipe)))
+	}
+	return key
+}
+
+func coreCacheKeyHash(buildProperties *properties.Map, coreFolder *paths.Path) string {
+	return utils.MD5Sum([]byte(strings.Join(coreCacheKey(buildProperties, coreFolder), "\n")))
+}
+
+// GetCachedCoreArchiveFileName returns the filename to be used to store
+// the global cached core.a. The menu options of the FQBN are not part of the
+// name, the hash of the properties affecting the core build is used instead.
+func GetCachedCoreArchiveFileName(fqbn string, buildProperties *properties.Map, coreFolder *paths.Path) string {
+	if split := strings.SplitN(fqbn, ":", 4); len(split) == 4 {
+		fqbn = strings.Join(split[:3], ":")
+	}
+	fqbnToUnderscore := strings.Replace(fqbn, ":", "_", -1)
+	hash := coreCacheKeyHash(buildProperties, coreFolder)
 	realName := "core_" + fqbnToUnderscore + "_" + hash + ".a"
 	if len(realName) > 100 {
 		// avoid really long names, simply hash the final part
@@ -97,7 +97,7 @@ func link(ctx *types.Context, objectFiles paths.PathList, coreDotARelPath *paths
 				return errors.WithStack(err)
 			}
 
-			if _, _, err := utils.ExecCommand(ctx, command, utils.ShowIfVerbose /* stdout */, utils.Show /* stderr */); err != nil {
+			if _, _, err := utils.ExecBuildCommand(ctx, command, utils.ShowIfVerbose /* stdout */, utils.Show /* stderr */); err != nil {
 				return errors.WithStack(err)
 			}
 		}
@@ -118,7 +118,7 @@ func link(ctx *types.Context, objectFiles paths.PathList, coreDotARelPath *paths
 		return err
 	}
 
-	_, _, err = utils.ExecCommand(ctx, command, utils.ShowIfVerbose /* stdout */, utils.Show /* stderr */)
+	_, _, err = utils.ExecBuildCommand(ctx, command, utils.ShowIfVerbose /* stdout */, utils.Show /* stderr */)
 	return err
 }
 
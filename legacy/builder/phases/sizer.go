@@ -33,7 +33,7 @@ type Sizer struct {
 }
 
 func (s *Sizer) Run(ctx *types.Context) error {
-	if ctx.OnlyUpdateCompilationDatabase {
+	if ctx.OnlyUpdateCompilationDatabase || ctx.DryRun {
 		return nil
 	}
 	if s.SketchError {
@@ -56,7 +56,7 @@ func (s *RecipeByPrefixSuffixRunner) Run(ctx *types.Context) error {
 			return nil
 		}
 
-		_, _, err = utils.ExecCommand(ctx, command, utils.ShowIfVerbose /* stdout */, utils.Show /* stderr */)
+		_, _, err = utils.ExecBuildCommand(ctx, command, utils.ShowIfVerbose /* stdout */, utils.Show /* stderr */)
 		if err != nil {
 			return errors.WithStack(err)
 		}
@@ -30,6 +30,15 @@ func (s *SetCustomBuildProperties) Run(ctx *types.Context) error {
 		return errors.WithStack(err)
 	}
 
+	// The properties not defined by the platform are added anyway, but they may
+	// be a typo so they are reported in verbose mode
+	if ctx.Verbose {
+		for _, key := range customBuildProperties.Keys() {
+			if !buildProperties.ContainsKey(key) {
+				ctx.Warn(tr("Warning: build property '%s' is not defined by the platform, adding it", key))
+			}
+		}
+	}
 	buildProperties.Merge(customBuildProperties)
 
 	return nil
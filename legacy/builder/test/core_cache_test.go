@@ -0,0 +1,60 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package test
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/legacy/builder/phases"
+	paths "github.com/arduino/go-paths-helper"
+	properties "github.com/arduino/go-properties-orderedmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachedCoreArchiveFileName(t *testing.T) {
+	coreFolder := paths.New("downloaded_hardware", "arduino", "avr")
+	props := properties.NewMap()
+	props.Set("build.path", "/tmp/build1")
+	props.Set("build.mcu", "atmega328p")
+	props.Set("build.variant.path", "/hardware/arduino/avr/variants/standard")
+	props.Set("upload.speed", "115200")
+	props.Set("extra.time.utc", "1000")
+	props.Set("recipe.c.o.pattern", `avr-gcc -mmcu={build.mcu} {includes} "{source_file}" -o "{object_file}"`)
+	props.Set("recipe.ar.pattern", `avr-gcc-ar rcs "{build.path}/{archive_file}" "{object_file}"`)
+	name := phases.GetCachedCoreArchiveFileName("arduino:avr:nano:cpu=atmega328", props, coreFolder)
+	require.Regexp(t, `^core_arduino_avr_nano_[0-9a-f]{32}\.a$`, name)
+
+	// A menu option that doesn't change the core build shares the same cache
+	other := props.Clone()
+	other.Set("upload.speed", "57600")
+	require.Equal(t, name, phases.GetCachedCoreArchiveFileName("arduino:avr:nano:cpu=atmega328old", other, coreFolder))
+
+	// The build path and the build time don't affect the core build
+	other = props.Clone()
+	other.Set("build.path", "/tmp/build2")
+	other.Set("extra.time.utc", "2000")
+	require.Equal(t, name, phases.GetCachedCoreArchiveFileName("arduino:avr:nano", other, coreFolder))
+
+	// A different compiler command line needs a different core
+	other = props.Clone()
+	other.Set("build.mcu", "atmega168")
+	require.NotEqual(t, name, phases.GetCachedCoreArchiveFileName("arduino:avr:nano:cpu=atmega168", other, coreFolder))
+
+	// A different variant needs a different core
+	other = props.Clone()
+	other.Set("build.variant.path", "/hardware/arduino/avr/variants/eightanaloginputs")
+	require.NotEqual(t, name, phases.GetCachedCoreArchiveFileName("arduino:avr:nano", other, coreFolder))
+}
@@ -0,0 +1,48 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/arduino/arduino-cli/legacy/builder"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	properties "github.com/arduino/go-properties-orderedmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDumpBuildProperties(t *testing.T) {
+	props := properties.NewMap()
+	props.Set("compiler.path", "{runtime.tools.avr-gcc.path}/bin/")
+	props.Set("runtime.tools.avr-gcc.path", "/opt/avr-gcc")
+	props.Set("build.mcu", "atmega328p")
+	props.Set("recipe.c.o.pattern", `"{compiler.path}avr-gcc" -mmcu={build.mcu} "{source_file}"`)
+
+	expected := []string{
+		"build.mcu=atmega328p",
+		"compiler.path=/opt/avr-gcc/bin/",
+		`recipe.c.o.pattern="/opt/avr-gcc/bin/avr-gcc" -mmcu=atmega328p "{source_file}"`,
+		"runtime.tools.avr-gcc.path=/opt/avr-gcc",
+	}
+	require.Equal(t, expected, builder.ExpandedBuildProperties(props))
+
+	out := &bytes.Buffer{}
+	ctx := &types.Context{BuildProperties: props, Stdout: out}
+	require.NoError(t, (&builder.DumpBuildProperties{}).Run(ctx))
+	require.Equal(t, strings.Join(expected, "\n")+"\n", out.String())
+}
@@ -16,10 +16,14 @@
 package test
 
 import (
+	"bytes"
+	"os/exec"
 	"strings"
 	"testing"
 
+	"github.com/arduino/arduino-cli/legacy/builder/types"
 	"github.com/arduino/arduino-cli/legacy/builder/utils"
+	"github.com/arduino/go-paths-helper"
 	"github.com/stretchr/testify/require"
 )
 
@@ -42,6 +46,32 @@ func TestPrintableCommand(t *testing.T) {
 	require.Equal(t, correct, result)
 }
 
+func TestExecBuildCommandDryRun(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "dry_run")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	marker := tmp.Join("marker")
+
+	stdout := &bytes.Buffer{}
+	ctx := &types.Context{DryRun: true, Stdout: stdout}
+	command := exec.Command("touch", marker.String())
+	_, _, err = utils.ExecBuildCommand(ctx, command, utils.Show, utils.Show)
+	require.NoError(t, err)
+	require.Equal(t, utils.PrintableCommand(command.Args)+"\n", stdout.String())
+	require.False(t, marker.Exist())
+}
+
+func TestExecBuildCommandMasksDefines(t *testing.T) {
+	stdout := &bytes.Buffer{}
+	ctx := &types.Context{DryRun: true, Stdout: stdout, Defines: []string{"-DSECRET=hunter2", "-DDEBUG"}}
+	command := exec.Command("gcc", "-c", "-DSECRET=hunter2", "-DDEBUG", "-DOTHER=1")
+	_, _, err := utils.ExecBuildCommand(ctx, command, utils.Show, utils.Show)
+	require.NoError(t, err)
+	require.Equal(t, "gcc -c -DSECRET=*** -DDEBUG -DOTHER=1\n", stdout.String())
+	// The command is run with the real values
+	require.Contains(t, command.Args, "-DSECRET=hunter2")
+}
+
 func TestMapTrimSpace(t *testing.T) {
 	value := "hello, world , how are,you? "
 	parts := utils.Map(strings.Split(value, ","), utils.TrimSpace)
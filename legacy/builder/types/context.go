@@ -16,9 +16,12 @@
 package types
 
 import (
+	"context"
+	"crypto/sha256"
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strings"
 	"sync"
 
@@ -103,6 +106,9 @@ type Context struct {
 	CoreBuildPath                *paths.Path
 	CoreBuildCachePath           *paths.Path
 	CoreArchiveFilePath          *paths.Path
+	UsedCachedCore               bool
+	CompiledFiles                int32 // Updated atomically
+	ReusedFiles                  int32 // Updated atomically
 	CoreObjectsFiles             paths.PathList
 	LibrariesBuildPath           *paths.Path
 	LibrariesObjectFiles         paths.PathList
@@ -151,6 +157,10 @@ type Context struct {
 
 	// Contents of a custom build properties file (line by line)
 	CustomBuildProperties []string
+	// -D flags appended to the compile and preprocessing commands, after the
+	// expansion of the recipes: they may contain secrets, so they are kept
+	// out of the build properties and of the compilation database
+	Defines []string
 
 	// Reuse old tools since the backing storage didn't change
 	CanUseCachedTools bool
@@ -171,9 +181,21 @@ type Context struct {
 
 	// Compilation Database to build/update
 	CompilationDatabase *builder.CompilationDatabase
+
+	// Command lines of the build, to compute their digest
+	buildCommands     []string
+	buildCommandsLock sync.Mutex
 	// Set to true to skip build and produce only Compilation Database
 	OnlyUpdateCompilationDatabase bool
 
+	// Set to true to print the compile, archive and link commands instead of
+	// running them
+	DryRun bool
+
+	// The build is stopped, and the running commands are killed, when this
+	// context is canceled (if nil the build can't be canceled)
+	CancelContext context.Context
+
 	// Source code overrides (filename -> content map).
 	// The provided source data is used instead of reading it from disk.
 	// The keys of the map are paths relative to sketch folder.
@@ -226,6 +248,10 @@ func (ctx *Context) ExtractBuildOptions() *properties.Map {
 	opts.Set("customBuildProperties", strings.Join(ctx.CustomBuildProperties, ","))
 	opts.Set("additionalFiles", strings.Join(additionalFilesRelative, ","))
 	opts.Set("compiler.optimization_flags", ctx.OptimizationFlags)
+	if len(ctx.Defines) > 0 {
+		// Only a digest, the defines may contain secrets
+		opts.Set("definesHash", fmt.Sprintf("%x", sha256.Sum256([]byte(strings.Join(ctx.Defines, "\n")))))
+	}
 	return opts
 }
 
@@ -251,6 +277,14 @@ func (ctx *Context) PushProgress() {
 	}
 }
 
+// PushProgressWithMessage sends the current progress together with the name
+// of the running task and a message (for example the file being compiled)
+func (ctx *Context) PushProgressWithMessage(name, message string) {
+	if ctx.ProgressCB != nil {
+		ctx.ProgressCB(&rpc.TaskProgress{Name: name, Message: message, Percent: ctx.Progress.Progress})
+	}
+}
+
 func (ctx *Context) Info(msg string) {
 	ctx.stdLock.Lock()
 	if ctx.Stdout == nil {
@@ -270,3 +304,23 @@ func (ctx *Context) Warn(msg string) {
 	}
 	ctx.stdLock.Unlock()
 }
+
+// AddBuildCommand records the command line of a compile, archive, link or
+// recipe hook command of the build, even if the command is not run because
+// its output is up to date.
+func (ctx *Context) AddBuildCommand(commandLine string) {
+	ctx.buildCommandsLock.Lock()
+	ctx.buildCommands = append(ctx.buildCommands, commandLine)
+	ctx.buildCommandsLock.Unlock()
+}
+
+// BuildCommandsSHA256 returns the SHA-256 of the command lines recorded with
+// AddBuildCommand. The command lines are sorted, since parallel compiles may
+// run in any order.
+func (ctx *Context) BuildCommandsSHA256() string {
+	ctx.buildCommandsLock.Lock()
+	commands := append([]string{}, ctx.buildCommands...)
+	ctx.buildCommandsLock.Unlock()
+	sort.Strings(commands)
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(strings.Join(commands, "\n"))))
+}
@@ -0,0 +1,38 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildCommandsSHA256(t *testing.T) {
+	ctx1 := &Context{}
+	ctx1.AddBuildCommand("gcc -c a.c -o a.o")
+	ctx1.AddBuildCommand("gcc -c b.c -o b.o")
+	ctx2 := &Context{}
+	ctx2.AddBuildCommand("gcc -c b.c -o b.o")
+	ctx2.AddBuildCommand("gcc -c a.c -o a.o")
+	// The order of the commands doesn't matter
+	require.Equal(t, ctx1.BuildCommandsSHA256(), ctx2.BuildCommandsSHA256())
+
+	ctx2.AddBuildCommand("gcc a.o b.o -o sketch.elf")
+	require.NotEqual(t, ctx1.BuildCommandsSHA256(), ctx2.BuildCommandsSHA256())
+
+	require.Equal(t, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", (&Context{}).BuildCommandsSHA256())
+}
@@ -17,6 +17,7 @@ package utils
 
 import (
 	"bytes"
+	"context"
 	"crypto/md5"
 	"encoding/hex"
 	"fmt"
@@ -29,6 +30,7 @@ import (
 	"unicode"
 	"unicode/utf8"
 
+	"github.com/arduino/arduino-cli/executils"
 	"github.com/arduino/arduino-cli/i18n"
 	"github.com/arduino/arduino-cli/legacy/builder/gohasissues"
 	"github.com/arduino/arduino-cli/legacy/builder/types"
@@ -177,6 +179,25 @@ func PrintableCommand(parts []string) string {
 	return strings.Join(Map(parts, printableArgument), " ")
 }
 
+// PrintableBuildCommand is like PrintableCommand, but the values of the macros
+// of the defines file (ctx.Defines) are masked, since they may be secrets.
+func PrintableBuildCommand(ctx *types.Context, parts []string) string {
+	if len(ctx.Defines) == 0 {
+		return PrintableCommand(parts)
+	}
+	defines := map[string]bool{}
+	for _, define := range ctx.Defines {
+		defines[define] = true
+	}
+	masked := Map(parts, func(arg string) string {
+		if i := strings.Index(arg, "="); defines[arg] && i != -1 {
+			return arg[:i] + "=***"
+		}
+		return arg
+	})
+	return PrintableCommand(masked)
+}
+
 const (
 	Ignore        = 0 // Redirect to null
 	Show          = 1 // Show on stdout/stderr as normal
@@ -193,7 +214,7 @@ func ExecCommand(ctx *types.Context, command *exec.Cmd, stdout int, stderr int)
 	}
 
 	if ctx.Verbose {
-		ctx.Info(PrintableCommand(command.Args))
+		ctx.Info(PrintableBuildCommand(ctx, command.Args))
 	}
 
 	if stdout == Capture {
@@ -210,12 +231,11 @@ func ExecCommand(ctx *types.Context, command *exec.Cmd, stdout int, stderr int)
 		command.Stderr = ctx.Stderr
 	}
 
-	err := command.Start()
-	if err != nil {
-		return nil, nil, errors.WithStack(err)
+	cancelCtx := ctx.CancelContext
+	if cancelCtx == nil {
+		cancelCtx = context.Background()
 	}
-
-	err = command.Wait()
+	err := executils.RunCommandWithinContext(cancelCtx, command)
 
 	var outbytes, errbytes []byte
 	if buf, ok := command.Stdout.(*bytes.Buffer); ok {
@@ -228,6 +248,18 @@ func ExecCommand(ctx *types.Context, command *exec.Cmd, stdout int, stderr int)
 	return outbytes, errbytes, errors.WithStack(err)
 }
 
+// ExecBuildCommand runs a command of the build (compile, archive, link or
+// recipe hook) like ExecCommand, but in dry-run mode the command is only
+// printed and not executed.
+func ExecBuildCommand(ctx *types.Context, command *exec.Cmd, stdout int, stderr int) ([]byte, []byte, error) {
+	ctx.AddBuildCommand(PrintableBuildCommand(ctx, command.Args))
+	if ctx.DryRun {
+		ctx.Info(PrintableBuildCommand(ctx, command.Args))
+		return nil, nil, nil
+	}
+	return ExecCommand(ctx, command, stdout, stderr)
+}
+
 func AbsolutizePaths(files []string) ([]string, error) {
 	for idx, file := range files {
 		if file == "" {
@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"path/filepath"
 
+	bldr "github.com/arduino/arduino-cli/arduino/builder"
 	"github.com/arduino/arduino-cli/legacy/builder/builder_utils"
 	"github.com/arduino/arduino-cli/legacy/builder/constants"
 	"github.com/arduino/arduino-cli/legacy/builder/types"
@@ -85,6 +86,10 @@ func doCleanup(buildPath *paths.Path) error {
 		return errors.WithMessage(err, tr("cleaning build path"))
 	} else {
 		for _, file := range files {
+			if file.Base() == bldr.BuildPathLockFileName {
+				// the lock must be kept while building
+				continue
+			}
 			if err := file.RemoveAll(); err != nil {
 				return errors.WithMessage(err, tr("cleaning build path"))
 			}
@@ -25,8 +25,8 @@ import (
 )
 
 func main() {
-	configuration.Settings = configuration.Init(configuration.FindConfigFileInArgsOrWorkingDirectory(os.Args))
-	i18n.Init(configuration.Settings.GetString("locale"))
+	configuration.Settings = configuration.Init(configuration.FindConfigFileInArgsOrWorkingDirectory(os.Args), configuration.FindAdditionalConfigFilesInArgs(os.Args)...)
+	i18n.Init(configuration.Settings.GetString("locale"), configuration.Settings.GetString("i18n.extra_catalogs"))
 	arduinoCmd := cli.NewCommand()
 	if err := arduinoCmd.Execute(); err != nil {
 		os.Exit(errorcodes.ErrGeneric)
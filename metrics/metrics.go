@@ -19,11 +19,17 @@ import (
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
+	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/i18n"
 	"github.com/arduino/arduino-cli/inventory"
 	"github.com/segmentio/stats/v4"
+	"github.com/segmentio/stats/v4/influxdb"
 	"github.com/segmentio/stats/v4/prometheus"
 	"github.com/sirupsen/logrus"
 )
@@ -31,8 +37,45 @@ import (
 // serverPattern is the metrics endpoint resource path for consume metrics
 var serverPattern = "/metrics"
 
-// Activate configures and starts the metrics server exposing a Prometheus resource
-func Activate(metricPrefix string) {
+// endpointDatabase is the database the metrics are written to when pushed to
+// the metrics.endpoint, if not specified by the db query parameter
+var endpointDatabase = "arduino_cli"
+
+// commandDurationMetric is the histogram recording the duration of the commands
+var commandDurationMetric = "command.duration_seconds"
+
+// commandDurationBuckets are the upper bounds, in seconds, of the buckets of
+// the commandDurationMetric histogram
+var commandDurationBuckets = []interface{}{0.1, 0.5, 1.0, 2.5, 5.0, 10.0, 30.0, 60.0, 120.0, 300.0}
+
+var tr = i18n.Tr
+
+// ValidateEndpoint checks that endpoint is a valid URL of a metrics collector
+func ValidateEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf(tr("invalid metrics endpoint %[1]s: %[2]s"), endpoint, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf(tr("invalid metrics endpoint %s: the scheme must be http or https"), endpoint)
+	}
+	if u.Host == "" {
+		return fmt.Errorf(tr("invalid metrics endpoint %s: missing host"), endpoint)
+	}
+	return nil
+}
+
+// Activate configures and starts the metrics server exposing a Prometheus resource.
+// If the metrics.endpoint setting is set, the metrics are also pushed to that
+// collector using the InfluxDB line protocol.
+func Activate(metricPrefix string) error {
+	endpoint := configuration.Settings.GetString("metrics.endpoint")
+	if endpoint != "" {
+		if err := ValidateEndpoint(endpoint); err != nil {
+			return err
+		}
+	}
+
 	// Create a Prometheus default handler
 	ph := prometheus.DefaultHandler
 	// Create a new stats engine with an engine that prepends the "daemon" prefix to all metrics
@@ -41,6 +84,15 @@ func Activate(metricPrefix string) {
 		inventory.Store.GetString("installation.id")))
 	// Register the handler so it receives metrics from the default engine.
 	stats.Register(ph)
+	// Prometheus needs the buckets of the histograms to be defined upfront
+	stats.Buckets.Set(metricPrefix+"."+commandDurationMetric, commandDurationBuckets...)
+	if endpoint != "" {
+		logrus.Infof("Pushing metrics to %s", endpoint)
+		stats.Register(influxdb.NewClientWith(influxdb.ClientConfig{
+			Address:  endpoint,
+			Database: endpointDatabase,
+		}))
+	}
 
 	// Configure using viper settings
 	serverAddr := configuration.Settings.GetString("metrics.addr")
@@ -49,7 +101,16 @@ func Activate(metricPrefix string) {
 		http.Handle(serverPattern, ph)
 		logrus.Error(http.ListenAndServe(serverAddr, nil))
 	}()
+	return nil
+}
 
+// ObserveCommandDuration records the time elapsed since start in the duration
+// histogram of the commands, tagged with the command name and the outcome given
+// by err. It's a no-op if the metrics are not activated.
+func ObserveCommandDuration(command string, start time.Time, err error) {
+	stats.Observe(commandDurationMetric, time.Since(start).Seconds(),
+		stats.T("command", command),
+		stats.T("success", strconv.FormatBool(err == nil)))
 }
 
 // Sanitize uses config generated UUID (installation.secret) as an HMAC secret to sanitize and anonymize
@@ -0,0 +1,55 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package metrics
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/segmentio/stats/v4"
+	"github.com/segmentio/stats/v4/statstest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateEndpoint(t *testing.T) {
+	require.NoError(t, ValidateEndpoint("http://localhost:8086/write"))
+	require.NoError(t, ValidateEndpoint("https://metrics.example.com/write?db=cli"))
+
+	require.Error(t, ValidateEndpoint("localhost:8086"))
+	require.Error(t, ValidateEndpoint("ftp://localhost/write"))
+	require.Error(t, ValidateEndpoint("http:///write"))
+	require.Error(t, ValidateEndpoint("http://local host"))
+}
+
+func TestObserveCommandDuration(t *testing.T) {
+	h := &statstest.Handler{}
+	defaultEngine := stats.DefaultEngine
+	stats.DefaultEngine = stats.NewEngine("test", h)
+	defer func() { stats.DefaultEngine = defaultEngine }()
+
+	ObserveCommandDuration("compile", time.Now().Add(-2*time.Second), nil)
+	ObserveCommandDuration("upload", time.Now(), errors.New("upload failed"))
+
+	measures := h.Measures()
+	require.Len(t, measures, 2)
+	require.Equal(t, "test.command", measures[0].Name)
+	require.Equal(t, "duration_seconds", measures[0].Fields[0].Name)
+	require.Equal(t, stats.Histogram, measures[0].Fields[0].Type())
+	require.GreaterOrEqual(t, measures[0].Fields[0].Value.Float(), 2.0)
+	require.Equal(t, []stats.Tag{stats.T("command", "compile"), stats.T("success", "true")}, measures[0].Tags)
+	require.Equal(t, []stats.Tag{stats.T("command", "upload"), stats.T("success", "false")}, measures[1].Tags)
+}
@@ -35,7 +35,9 @@ type ArduinoCoreServiceClient interface {
 	Outdated(ctx context.Context, in *OutdatedRequest, opts ...grpc.CallOption) (*OutdatedResponse, error)
 	// Upgrade both Cores and Libraries
 	Upgrade(ctx context.Context, in *UpgradeRequest, opts ...grpc.CallOption) (ArduinoCoreService_UpgradeClient, error)
-	// Get the version of Arduino CLI in use.
+	// Get the version of Arduino CLI and a summary of the environment in use:
+	// the configuration file, the directories and, if an instance is provided,
+	// the number of installed platforms and libraries.
 	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
 	// Create a new Sketch
 	NewSketch(ctx context.Context, in *NewSketchRequest, opts ...grpc.CallOption) (*NewSketchResponse, error)
@@ -43,6 +45,11 @@ type ArduinoCoreServiceClient interface {
 	LoadSketch(ctx context.Context, in *LoadSketchRequest, opts ...grpc.CallOption) (*LoadSketchResponse, error)
 	// Creates a zip file containing all files of specified Sketch
 	ArchiveSketch(ctx context.Context, in *ArchiveSketchRequest, opts ...grpc.CallOption) (*ArchiveSketchResponse, error)
+	// Extracts a Sketch archive, installing the libraries bundled in it
+	ExtractSketch(ctx context.Context, in *ExtractSketchRequest, opts ...grpc.CallOption) (*ExtractSketchResponse, error)
+	// Generates a sketch profile pinning the platforms and the libraries
+	// currently used to build a Sketch
+	DumpSketchProfile(ctx context.Context, in *DumpSketchProfileRequest, opts ...grpc.CallOption) (*DumpSketchProfileResponse, error)
 	// Requests details about a board
 	BoardDetails(ctx context.Context, in *BoardDetailsRequest, opts ...grpc.CallOption) (*BoardDetailsResponse, error)
 	// Attach a board to a sketch. When the `fqbn` field of a request is not
@@ -52,12 +59,24 @@ type ArduinoCoreServiceClient interface {
 	BoardList(ctx context.Context, in *BoardListRequest, opts ...grpc.CallOption) (*BoardListResponse, error)
 	// List all the boards provided by installed platforms.
 	BoardListAll(ctx context.Context, in *BoardListAllRequest, opts ...grpc.CallOption) (*BoardListAllResponse, error)
+	// Identify the boards matching the given port identification properties,
+	// returning all the candidates ranked by score.
+	BoardIdentify(ctx context.Context, in *BoardIdentifyRequest, opts ...grpc.CallOption) (*BoardIdentifyResponse, error)
+	// Parse an FQBN returning its components and its canonical form. Only the
+	// syntax of the FQBN is checked, the board may not be installed.
+	ParseFQBN(ctx context.Context, in *ParseFQBNRequest, opts ...grpc.CallOption) (*ParseFQBNResponse, error)
 	// Search boards in installed and not installed Platforms.
 	BoardSearch(ctx context.Context, in *BoardSearchRequest, opts ...grpc.CallOption) (*BoardSearchResponse, error)
 	// List boards connection and disconnected events.
 	BoardListWatch(ctx context.Context, opts ...grpc.CallOption) (ArduinoCoreService_BoardListWatchClient, error)
 	// Compile an Arduino sketch.
 	Compile(ctx context.Context, in *CompileRequest, opts ...grpc.CallOption) (ArduinoCoreService_CompileClient, error)
+	// Compile an Arduino sketch and compile it again every time a file of the
+	// sketch changes, until the stream is closed.
+	CompileWatch(ctx context.Context, in *CompileWatchRequest, opts ...grpc.CallOption) (ArduinoCoreService_CompileWatchClient, error)
+	// Run only the preprocessing stage of the build on an Arduino sketch and
+	// return the generated `.cpp` source.
+	Preprocess(ctx context.Context, in *PreprocessRequest, opts ...grpc.CallOption) (*PreprocessResponse, error)
 	// Download and install a platform and its tool dependencies.
 	PlatformInstall(ctx context.Context, in *PlatformInstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformInstallClient, error)
 	// Download a platform and its tool dependencies to the `staging/packages`
@@ -68,6 +87,9 @@ type ArduinoCoreServiceClient interface {
 	PlatformUninstall(ctx context.Context, in *PlatformUninstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformUninstallClient, error)
 	// Upgrade an installed platform to the latest version.
 	PlatformUpgrade(ctx context.Context, in *PlatformUpgradeRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformUpgradeClient, error)
+	// Restore the version of an installed platform replaced by the last install
+	// or upgrade.
+	PlatformRollback(ctx context.Context, in *PlatformRollbackRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformRollbackClient, error)
 	// Upload a compiled sketch to a board.
 	Upload(ctx context.Context, in *UploadRequest, opts ...grpc.CallOption) (ArduinoCoreService_UploadClient, error)
 	// Upload a compiled sketch to a board using a programmer.
@@ -75,7 +97,9 @@ type ArduinoCoreServiceClient interface {
 	// Returns the list of users fields necessary to upload to that board
 	// using the specified protocol.
 	SupportedUserFields(ctx context.Context, in *SupportedUserFieldsRequest, opts ...grpc.CallOption) (*SupportedUserFieldsResponse, error)
-	// List programmers available for a board.
+	// List programmers available for a board, as declared in the
+	// `programmers.txt` of the board platform and of the referenced platform.
+	// The programmers are sorted by ID.
 	ListProgrammersAvailableForUpload(ctx context.Context, in *ListProgrammersAvailableForUploadRequest, opts ...grpc.CallOption) (*ListProgrammersAvailableForUploadResponse, error)
 	// Burn bootloader to a board.
 	BurnBootloader(ctx context.Context, in *BurnBootloaderRequest, opts ...grpc.CallOption) (ArduinoCoreService_BurnBootloaderClient, error)
@@ -92,6 +116,9 @@ type ArduinoCoreServiceClient interface {
 	ZipLibraryInstall(ctx context.Context, in *ZipLibraryInstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_ZipLibraryInstallClient, error)
 	// Download and install a library from a git url
 	GitLibraryInstall(ctx context.Context, in *GitLibraryInstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_GitLibraryInstallClient, error)
+	// Install a library from a local directory as a development link: the
+	// library is symlinked (not copied) into the user libraries folder.
+	LocalLibraryInstall(ctx context.Context, in *LocalLibraryInstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_LocalLibraryInstallClient, error)
 	// Uninstall an Arduino library.
 	LibraryUninstall(ctx context.Context, in *LibraryUninstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryUninstallClient, error)
 	// Upgrade all installed Arduino libraries to the newest version available.
@@ -103,10 +130,26 @@ type ArduinoCoreServiceClient interface {
 	LibrarySearch(ctx context.Context, in *LibrarySearchRequest, opts ...grpc.CallOption) (*LibrarySearchResponse, error)
 	// List the installed libraries.
 	LibraryList(ctx context.Context, in *LibraryListRequest, opts ...grpc.CallOption) (*LibraryListResponse, error)
+	// List the examples bundled with an installed library.
+	ListLibraryExamples(ctx context.Context, in *ListLibraryExamplesRequest, opts ...grpc.CallOption) (*ListLibraryExamplesResponse, error)
+	// Copy an example of an installed library into a new sketch.
+	CopyLibraryExample(ctx context.Context, in *CopyLibraryExampleRequest, opts ...grpc.CallOption) (*CopyLibraryExampleResponse, error)
 	// Open a monitor connection to a board port
 	Monitor(ctx context.Context, opts ...grpc.CallOption) (ArduinoCoreService_MonitorClient, error)
 	// Returns the parameters that can be set in the MonitorRequest calls
 	EnumerateMonitorPortSettings(ctx context.Context, in *EnumerateMonitorPortSettingsRequest, opts ...grpc.CallOption) (*EnumerateMonitorPortSettingsResponse, error)
+	// Returns the build cache size and the core cache hit/miss counters
+	// accumulated since the daemon has been started.
+	GetCacheStats(ctx context.Context, in *GetCacheStatsRequest, opts ...grpc.CallOption) (*GetCacheStatsResponse, error)
+	// Deletes the build cache (cached cores and sketch build folders).
+	ClearCache(ctx context.Context, in *ClearCacheRequest, opts ...grpc.CallOption) (*ClearCacheResponse, error)
+	// Removes the downloaded archives not referenced by any installed platform,
+	// tool or library. The archives modified in the last 10 minutes are kept,
+	// since they may be used by an install in progress.
+	PruneDownloadsCache(ctx context.Context, in *PruneDownloadsCacheRequest, opts ...grpc.CallOption) (*PruneDownloadsCacheResponse, error)
+	// Returns the candidates for the dynamic shell completion: the FQBNs of the
+	// installed boards, the attached ports and the installed libraries.
+	CompletionData(ctx context.Context, in *CompletionDataRequest, opts ...grpc.CallOption) (*CompletionDataResponse, error)
 }
 
 type arduinoCoreServiceClient struct {
@@ -340,6 +383,24 @@ func (c *arduinoCoreServiceClient) ArchiveSketch(ctx context.Context, in *Archiv
 	return out, nil
 }
 
+func (c *arduinoCoreServiceClient) ExtractSketch(ctx context.Context, in *ExtractSketchRequest, opts ...grpc.CallOption) (*ExtractSketchResponse, error) {
+	out := new(ExtractSketchResponse)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.v1.ArduinoCoreService/ExtractSketch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreServiceClient) DumpSketchProfile(ctx context.Context, in *DumpSketchProfileRequest, opts ...grpc.CallOption) (*DumpSketchProfileResponse, error) {
+	out := new(DumpSketchProfileResponse)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.v1.ArduinoCoreService/DumpSketchProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *arduinoCoreServiceClient) BoardDetails(ctx context.Context, in *BoardDetailsRequest, opts ...grpc.CallOption) (*BoardDetailsResponse, error) {
 	out := new(BoardDetailsResponse)
 	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.v1.ArduinoCoreService/BoardDetails", in, out, opts...)
@@ -399,6 +460,24 @@ func (c *arduinoCoreServiceClient) BoardListAll(ctx context.Context, in *BoardLi
 	return out, nil
 }
 
+func (c *arduinoCoreServiceClient) BoardIdentify(ctx context.Context, in *BoardIdentifyRequest, opts ...grpc.CallOption) (*BoardIdentifyResponse, error) {
+	out := new(BoardIdentifyResponse)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.v1.ArduinoCoreService/BoardIdentify", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreServiceClient) ParseFQBN(ctx context.Context, in *ParseFQBNRequest, opts ...grpc.CallOption) (*ParseFQBNResponse, error) {
+	out := new(ParseFQBNResponse)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.v1.ArduinoCoreService/ParseFQBN", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *arduinoCoreServiceClient) BoardSearch(ctx context.Context, in *BoardSearchRequest, opts ...grpc.CallOption) (*BoardSearchResponse, error) {
 	out := new(BoardSearchResponse)
 	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.v1.ArduinoCoreService/BoardSearch", in, out, opts...)
@@ -471,8 +550,49 @@ func (x *arduinoCoreServiceCompileClient) Recv() (*CompileResponse, error) {
 	return m, nil
 }
 
+func (c *arduinoCoreServiceClient) CompileWatch(ctx context.Context, in *CompileWatchRequest, opts ...grpc.CallOption) (ArduinoCoreService_CompileWatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[8], "/cc.arduino.cli.commands.v1.ArduinoCoreService/CompileWatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServiceCompileWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCoreService_CompileWatchClient interface {
+	Recv() (*CompileWatchResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServiceCompileWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServiceCompileWatchClient) Recv() (*CompileWatchResponse, error) {
+	m := new(CompileWatchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreServiceClient) Preprocess(ctx context.Context, in *PreprocessRequest, opts ...grpc.CallOption) (*PreprocessResponse, error) {
+	out := new(PreprocessResponse)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.v1.ArduinoCoreService/Preprocess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *arduinoCoreServiceClient) PlatformInstall(ctx context.Context, in *PlatformInstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformInstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[8], "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformInstall", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[9], "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformInstall", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -504,7 +624,7 @@ func (x *arduinoCoreServicePlatformInstallClient) Recv() (*PlatformInstallRespon
 }
 
 func (c *arduinoCoreServiceClient) PlatformDownload(ctx context.Context, in *PlatformDownloadRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformDownloadClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[9], "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformDownload", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[10], "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformDownload", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -536,7 +656,7 @@ func (x *arduinoCoreServicePlatformDownloadClient) Recv() (*PlatformDownloadResp
 }
 
 func (c *arduinoCoreServiceClient) PlatformUninstall(ctx context.Context, in *PlatformUninstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformUninstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[10], "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformUninstall", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[11], "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformUninstall", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -568,7 +688,7 @@ func (x *arduinoCoreServicePlatformUninstallClient) Recv() (*PlatformUninstallRe
 }
 
 func (c *arduinoCoreServiceClient) PlatformUpgrade(ctx context.Context, in *PlatformUpgradeRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformUpgradeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[11], "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformUpgrade", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[12], "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformUpgrade", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -599,8 +719,40 @@ func (x *arduinoCoreServicePlatformUpgradeClient) Recv() (*PlatformUpgradeRespon
 	return m, nil
 }
 
+func (c *arduinoCoreServiceClient) PlatformRollback(ctx context.Context, in *PlatformRollbackRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformRollbackClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[13], "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformRollback", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServicePlatformRollbackClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCoreService_PlatformRollbackClient interface {
+	Recv() (*PlatformRollbackResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServicePlatformRollbackClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServicePlatformRollbackClient) Recv() (*PlatformRollbackResponse, error) {
+	m := new(PlatformRollbackResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *arduinoCoreServiceClient) Upload(ctx context.Context, in *UploadRequest, opts ...grpc.CallOption) (ArduinoCoreService_UploadClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[12], "/cc.arduino.cli.commands.v1.ArduinoCoreService/Upload", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[14], "/cc.arduino.cli.commands.v1.ArduinoCoreService/Upload", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -632,7 +784,7 @@ func (x *arduinoCoreServiceUploadClient) Recv() (*UploadResponse, error) {
 }
 
 func (c *arduinoCoreServiceClient) UploadUsingProgrammer(ctx context.Context, in *UploadUsingProgrammerRequest, opts ...grpc.CallOption) (ArduinoCoreService_UploadUsingProgrammerClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[13], "/cc.arduino.cli.commands.v1.ArduinoCoreService/UploadUsingProgrammer", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[15], "/cc.arduino.cli.commands.v1.ArduinoCoreService/UploadUsingProgrammer", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -682,7 +834,7 @@ func (c *arduinoCoreServiceClient) ListProgrammersAvailableForUpload(ctx context
 }
 
 func (c *arduinoCoreServiceClient) BurnBootloader(ctx context.Context, in *BurnBootloaderRequest, opts ...grpc.CallOption) (ArduinoCoreService_BurnBootloaderClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[14], "/cc.arduino.cli.commands.v1.ArduinoCoreService/BurnBootloader", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[16], "/cc.arduino.cli.commands.v1.ArduinoCoreService/BurnBootloader", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -732,7 +884,7 @@ func (c *arduinoCoreServiceClient) PlatformList(ctx context.Context, in *Platfor
 }
 
 func (c *arduinoCoreServiceClient) LibraryDownload(ctx context.Context, in *LibraryDownloadRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryDownloadClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[15], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryDownload", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[17], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryDownload", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -764,7 +916,7 @@ func (x *arduinoCoreServiceLibraryDownloadClient) Recv() (*LibraryDownloadRespon
 }
 
 func (c *arduinoCoreServiceClient) LibraryInstall(ctx context.Context, in *LibraryInstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryInstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[16], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryInstall", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[18], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryInstall", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -796,7 +948,7 @@ func (x *arduinoCoreServiceLibraryInstallClient) Recv() (*LibraryInstallResponse
 }
 
 func (c *arduinoCoreServiceClient) ZipLibraryInstall(ctx context.Context, in *ZipLibraryInstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_ZipLibraryInstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[17], "/cc.arduino.cli.commands.v1.ArduinoCoreService/ZipLibraryInstall", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[19], "/cc.arduino.cli.commands.v1.ArduinoCoreService/ZipLibraryInstall", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -828,7 +980,7 @@ func (x *arduinoCoreServiceZipLibraryInstallClient) Recv() (*ZipLibraryInstallRe
 }
 
 func (c *arduinoCoreServiceClient) GitLibraryInstall(ctx context.Context, in *GitLibraryInstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_GitLibraryInstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[18], "/cc.arduino.cli.commands.v1.ArduinoCoreService/GitLibraryInstall", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[20], "/cc.arduino.cli.commands.v1.ArduinoCoreService/GitLibraryInstall", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -859,8 +1011,40 @@ func (x *arduinoCoreServiceGitLibraryInstallClient) Recv() (*GitLibraryInstallRe
 	return m, nil
 }
 
+func (c *arduinoCoreServiceClient) LocalLibraryInstall(ctx context.Context, in *LocalLibraryInstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_LocalLibraryInstallClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[21], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LocalLibraryInstall", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServiceLocalLibraryInstallClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCoreService_LocalLibraryInstallClient interface {
+	Recv() (*LocalLibraryInstallResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServiceLocalLibraryInstallClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServiceLocalLibraryInstallClient) Recv() (*LocalLibraryInstallResponse, error) {
+	m := new(LocalLibraryInstallResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *arduinoCoreServiceClient) LibraryUninstall(ctx context.Context, in *LibraryUninstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryUninstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[19], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryUninstall", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[22], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryUninstall", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -892,7 +1076,7 @@ func (x *arduinoCoreServiceLibraryUninstallClient) Recv() (*LibraryUninstallResp
 }
 
 func (c *arduinoCoreServiceClient) LibraryUpgradeAll(ctx context.Context, in *LibraryUpgradeAllRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryUpgradeAllClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[20], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryUpgradeAll", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[23], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryUpgradeAll", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -950,8 +1134,26 @@ func (c *arduinoCoreServiceClient) LibraryList(ctx context.Context, in *LibraryL
 	return out, nil
 }
 
+func (c *arduinoCoreServiceClient) ListLibraryExamples(ctx context.Context, in *ListLibraryExamplesRequest, opts ...grpc.CallOption) (*ListLibraryExamplesResponse, error) {
+	out := new(ListLibraryExamplesResponse)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.v1.ArduinoCoreService/ListLibraryExamples", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreServiceClient) CopyLibraryExample(ctx context.Context, in *CopyLibraryExampleRequest, opts ...grpc.CallOption) (*CopyLibraryExampleResponse, error) {
+	out := new(CopyLibraryExampleResponse)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.v1.ArduinoCoreService/CopyLibraryExample", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *arduinoCoreServiceClient) Monitor(ctx context.Context, opts ...grpc.CallOption) (ArduinoCoreService_MonitorClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[21], "/cc.arduino.cli.commands.v1.ArduinoCoreService/Monitor", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[24], "/cc.arduino.cli.commands.v1.ArduinoCoreService/Monitor", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -990,6 +1192,42 @@ func (c *arduinoCoreServiceClient) EnumerateMonitorPortSettings(ctx context.Cont
 	return out, nil
 }
 
+func (c *arduinoCoreServiceClient) GetCacheStats(ctx context.Context, in *GetCacheStatsRequest, opts ...grpc.CallOption) (*GetCacheStatsResponse, error) {
+	out := new(GetCacheStatsResponse)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.v1.ArduinoCoreService/GetCacheStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreServiceClient) ClearCache(ctx context.Context, in *ClearCacheRequest, opts ...grpc.CallOption) (*ClearCacheResponse, error) {
+	out := new(ClearCacheResponse)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.v1.ArduinoCoreService/ClearCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreServiceClient) PruneDownloadsCache(ctx context.Context, in *PruneDownloadsCacheRequest, opts ...grpc.CallOption) (*PruneDownloadsCacheResponse, error) {
+	out := new(PruneDownloadsCacheResponse)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.v1.ArduinoCoreService/PruneDownloadsCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreServiceClient) CompletionData(ctx context.Context, in *CompletionDataRequest, opts ...grpc.CallOption) (*CompletionDataResponse, error) {
+	out := new(CompletionDataResponse)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.v1.ArduinoCoreService/CompletionData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ArduinoCoreServiceServer is the server API for ArduinoCoreService service.
 // All implementations must embed UnimplementedArduinoCoreServiceServer
 // for forward compatibility
@@ -1011,7 +1249,9 @@ type ArduinoCoreServiceServer interface {
 	Outdated(context.Context, *OutdatedRequest) (*OutdatedResponse, error)
 	// Upgrade both Cores and Libraries
 	Upgrade(*UpgradeRequest, ArduinoCoreService_UpgradeServer) error
-	// Get the version of Arduino CLI in use.
+	// Get the version of Arduino CLI and a summary of the environment in use:
+	// the configuration file, the directories and, if an instance is provided,
+	// the number of installed platforms and libraries.
 	Version(context.Context, *VersionRequest) (*VersionResponse, error)
 	// Create a new Sketch
 	NewSketch(context.Context, *NewSketchRequest) (*NewSketchResponse, error)
@@ -1019,6 +1259,11 @@ type ArduinoCoreServiceServer interface {
 	LoadSketch(context.Context, *LoadSketchRequest) (*LoadSketchResponse, error)
 	// Creates a zip file containing all files of specified Sketch
 	ArchiveSketch(context.Context, *ArchiveSketchRequest) (*ArchiveSketchResponse, error)
+	// Extracts a Sketch archive, installing the libraries bundled in it
+	ExtractSketch(context.Context, *ExtractSketchRequest) (*ExtractSketchResponse, error)
+	// Generates a sketch profile pinning the platforms and the libraries
+	// currently used to build a Sketch
+	DumpSketchProfile(context.Context, *DumpSketchProfileRequest) (*DumpSketchProfileResponse, error)
 	// Requests details about a board
 	BoardDetails(context.Context, *BoardDetailsRequest) (*BoardDetailsResponse, error)
 	// Attach a board to a sketch. When the `fqbn` field of a request is not
@@ -1028,12 +1273,24 @@ type ArduinoCoreServiceServer interface {
 	BoardList(context.Context, *BoardListRequest) (*BoardListResponse, error)
 	// List all the boards provided by installed platforms.
 	BoardListAll(context.Context, *BoardListAllRequest) (*BoardListAllResponse, error)
+	// Identify the boards matching the given port identification properties,
+	// returning all the candidates ranked by score.
+	BoardIdentify(context.Context, *BoardIdentifyRequest) (*BoardIdentifyResponse, error)
+	// Parse an FQBN returning its components and its canonical form. Only the
+	// syntax of the FQBN is checked, the board may not be installed.
+	ParseFQBN(context.Context, *ParseFQBNRequest) (*ParseFQBNResponse, error)
 	// Search boards in installed and not installed Platforms.
 	BoardSearch(context.Context, *BoardSearchRequest) (*BoardSearchResponse, error)
 	// List boards connection and disconnected events.
 	BoardListWatch(ArduinoCoreService_BoardListWatchServer) error
 	// Compile an Arduino sketch.
 	Compile(*CompileRequest, ArduinoCoreService_CompileServer) error
+	// Compile an Arduino sketch and compile it again every time a file of the
+	// sketch changes, until the stream is closed.
+	CompileWatch(*CompileWatchRequest, ArduinoCoreService_CompileWatchServer) error
+	// Run only the preprocessing stage of the build on an Arduino sketch and
+	// return the generated `.cpp` source.
+	Preprocess(context.Context, *PreprocessRequest) (*PreprocessResponse, error)
 	// Download and install a platform and its tool dependencies.
 	PlatformInstall(*PlatformInstallRequest, ArduinoCoreService_PlatformInstallServer) error
 	// Download a platform and its tool dependencies to the `staging/packages`
@@ -1044,6 +1301,9 @@ type ArduinoCoreServiceServer interface {
 	PlatformUninstall(*PlatformUninstallRequest, ArduinoCoreService_PlatformUninstallServer) error
 	// Upgrade an installed platform to the latest version.
 	PlatformUpgrade(*PlatformUpgradeRequest, ArduinoCoreService_PlatformUpgradeServer) error
+	// Restore the version of an installed platform replaced by the last install
+	// or upgrade.
+	PlatformRollback(*PlatformRollbackRequest, ArduinoCoreService_PlatformRollbackServer) error
 	// Upload a compiled sketch to a board.
 	Upload(*UploadRequest, ArduinoCoreService_UploadServer) error
 	// Upload a compiled sketch to a board using a programmer.
@@ -1051,7 +1311,9 @@ type ArduinoCoreServiceServer interface {
 	// Returns the list of users fields necessary to upload to that board
 	// using the specified protocol.
 	SupportedUserFields(context.Context, *SupportedUserFieldsRequest) (*SupportedUserFieldsResponse, error)
-	// List programmers available for a board.
+	// List programmers available for a board, as declared in the
+	// `programmers.txt` of the board platform and of the referenced platform.
+	// The programmers are sorted by ID.
 	ListProgrammersAvailableForUpload(context.Context, *ListProgrammersAvailableForUploadRequest) (*ListProgrammersAvailableForUploadResponse, error)
 	// Burn bootloader to a board.
 	BurnBootloader(*BurnBootloaderRequest, ArduinoCoreService_BurnBootloaderServer) error
@@ -1068,6 +1330,9 @@ type ArduinoCoreServiceServer interface {
 	ZipLibraryInstall(*ZipLibraryInstallRequest, ArduinoCoreService_ZipLibraryInstallServer) error
 	// Download and install a library from a git url
 	GitLibraryInstall(*GitLibraryInstallRequest, ArduinoCoreService_GitLibraryInstallServer) error
+	// Install a library from a local directory as a development link: the
+	// library is symlinked (not copied) into the user libraries folder.
+	LocalLibraryInstall(*LocalLibraryInstallRequest, ArduinoCoreService_LocalLibraryInstallServer) error
 	// Uninstall an Arduino library.
 	LibraryUninstall(*LibraryUninstallRequest, ArduinoCoreService_LibraryUninstallServer) error
 	// Upgrade all installed Arduino libraries to the newest version available.
@@ -1079,10 +1344,26 @@ type ArduinoCoreServiceServer interface {
 	LibrarySearch(context.Context, *LibrarySearchRequest) (*LibrarySearchResponse, error)
 	// List the installed libraries.
 	LibraryList(context.Context, *LibraryListRequest) (*LibraryListResponse, error)
+	// List the examples bundled with an installed library.
+	ListLibraryExamples(context.Context, *ListLibraryExamplesRequest) (*ListLibraryExamplesResponse, error)
+	// Copy an example of an installed library into a new sketch.
+	CopyLibraryExample(context.Context, *CopyLibraryExampleRequest) (*CopyLibraryExampleResponse, error)
 	// Open a monitor connection to a board port
 	Monitor(ArduinoCoreService_MonitorServer) error
 	// Returns the parameters that can be set in the MonitorRequest calls
 	EnumerateMonitorPortSettings(context.Context, *EnumerateMonitorPortSettingsRequest) (*EnumerateMonitorPortSettingsResponse, error)
+	// Returns the build cache size and the core cache hit/miss counters
+	// accumulated since the daemon has been started.
+	GetCacheStats(context.Context, *GetCacheStatsRequest) (*GetCacheStatsResponse, error)
+	// Deletes the build cache (cached cores and sketch build folders).
+	ClearCache(context.Context, *ClearCacheRequest) (*ClearCacheResponse, error)
+	// Removes the downloaded archives not referenced by any installed platform,
+	// tool or library. The archives modified in the last 10 minutes are kept,
+	// since they may be used by an install in progress.
+	PruneDownloadsCache(context.Context, *PruneDownloadsCacheRequest) (*PruneDownloadsCacheResponse, error)
+	// Returns the candidates for the dynamic shell completion: the FQBNs of the
+	// installed boards, the attached ports and the installed libraries.
+	CompletionData(context.Context, *CompletionDataRequest) (*CompletionDataResponse, error)
 	mustEmbedUnimplementedArduinoCoreServiceServer()
 }
 
@@ -1126,6 +1407,12 @@ func (UnimplementedArduinoCoreServiceServer) LoadSketch(context.Context, *LoadSk
 func (UnimplementedArduinoCoreServiceServer) ArchiveSketch(context.Context, *ArchiveSketchRequest) (*ArchiveSketchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ArchiveSketch not implemented")
 }
+func (UnimplementedArduinoCoreServiceServer) ExtractSketch(context.Context, *ExtractSketchRequest) (*ExtractSketchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtractSketch not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) DumpSketchProfile(context.Context, *DumpSketchProfileRequest) (*DumpSketchProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DumpSketchProfile not implemented")
+}
 func (UnimplementedArduinoCoreServiceServer) BoardDetails(context.Context, *BoardDetailsRequest) (*BoardDetailsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BoardDetails not implemented")
 }
@@ -1138,6 +1425,12 @@ func (UnimplementedArduinoCoreServiceServer) BoardList(context.Context, *BoardLi
 func (UnimplementedArduinoCoreServiceServer) BoardListAll(context.Context, *BoardListAllRequest) (*BoardListAllResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BoardListAll not implemented")
 }
+func (UnimplementedArduinoCoreServiceServer) BoardIdentify(context.Context, *BoardIdentifyRequest) (*BoardIdentifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BoardIdentify not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) ParseFQBN(context.Context, *ParseFQBNRequest) (*ParseFQBNResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParseFQBN not implemented")
+}
 func (UnimplementedArduinoCoreServiceServer) BoardSearch(context.Context, *BoardSearchRequest) (*BoardSearchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BoardSearch not implemented")
 }
@@ -1147,6 +1440,12 @@ func (UnimplementedArduinoCoreServiceServer) BoardListWatch(ArduinoCoreService_B
 func (UnimplementedArduinoCoreServiceServer) Compile(*CompileRequest, ArduinoCoreService_CompileServer) error {
 	return status.Errorf(codes.Unimplemented, "method Compile not implemented")
 }
+func (UnimplementedArduinoCoreServiceServer) CompileWatch(*CompileWatchRequest, ArduinoCoreService_CompileWatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method CompileWatch not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) Preprocess(context.Context, *PreprocessRequest) (*PreprocessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Preprocess not implemented")
+}
 func (UnimplementedArduinoCoreServiceServer) PlatformInstall(*PlatformInstallRequest, ArduinoCoreService_PlatformInstallServer) error {
 	return status.Errorf(codes.Unimplemented, "method PlatformInstall not implemented")
 }
@@ -1159,6 +1458,9 @@ func (UnimplementedArduinoCoreServiceServer) PlatformUninstall(*PlatformUninstal
 func (UnimplementedArduinoCoreServiceServer) PlatformUpgrade(*PlatformUpgradeRequest, ArduinoCoreService_PlatformUpgradeServer) error {
 	return status.Errorf(codes.Unimplemented, "method PlatformUpgrade not implemented")
 }
+func (UnimplementedArduinoCoreServiceServer) PlatformRollback(*PlatformRollbackRequest, ArduinoCoreService_PlatformRollbackServer) error {
+	return status.Errorf(codes.Unimplemented, "method PlatformRollback not implemented")
+}
 func (UnimplementedArduinoCoreServiceServer) Upload(*UploadRequest, ArduinoCoreService_UploadServer) error {
 	return status.Errorf(codes.Unimplemented, "method Upload not implemented")
 }
@@ -1192,6 +1494,9 @@ func (UnimplementedArduinoCoreServiceServer) ZipLibraryInstall(*ZipLibraryInstal
 func (UnimplementedArduinoCoreServiceServer) GitLibraryInstall(*GitLibraryInstallRequest, ArduinoCoreService_GitLibraryInstallServer) error {
 	return status.Errorf(codes.Unimplemented, "method GitLibraryInstall not implemented")
 }
+func (UnimplementedArduinoCoreServiceServer) LocalLibraryInstall(*LocalLibraryInstallRequest, ArduinoCoreService_LocalLibraryInstallServer) error {
+	return status.Errorf(codes.Unimplemented, "method LocalLibraryInstall not implemented")
+}
 func (UnimplementedArduinoCoreServiceServer) LibraryUninstall(*LibraryUninstallRequest, ArduinoCoreService_LibraryUninstallServer) error {
 	return status.Errorf(codes.Unimplemented, "method LibraryUninstall not implemented")
 }
@@ -1207,12 +1512,30 @@ func (UnimplementedArduinoCoreServiceServer) LibrarySearch(context.Context, *Lib
 func (UnimplementedArduinoCoreServiceServer) LibraryList(context.Context, *LibraryListRequest) (*LibraryListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LibraryList not implemented")
 }
+func (UnimplementedArduinoCoreServiceServer) ListLibraryExamples(context.Context, *ListLibraryExamplesRequest) (*ListLibraryExamplesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLibraryExamples not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) CopyLibraryExample(context.Context, *CopyLibraryExampleRequest) (*CopyLibraryExampleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyLibraryExample not implemented")
+}
 func (UnimplementedArduinoCoreServiceServer) Monitor(ArduinoCoreService_MonitorServer) error {
 	return status.Errorf(codes.Unimplemented, "method Monitor not implemented")
 }
 func (UnimplementedArduinoCoreServiceServer) EnumerateMonitorPortSettings(context.Context, *EnumerateMonitorPortSettingsRequest) (*EnumerateMonitorPortSettingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EnumerateMonitorPortSettings not implemented")
 }
+func (UnimplementedArduinoCoreServiceServer) GetCacheStats(context.Context, *GetCacheStatsRequest) (*GetCacheStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCacheStats not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) ClearCache(context.Context, *ClearCacheRequest) (*ClearCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearCache not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) PruneDownloadsCache(context.Context, *PruneDownloadsCacheRequest) (*PruneDownloadsCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneDownloadsCache not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) CompletionData(context.Context, *CompletionDataRequest) (*CompletionDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompletionData not implemented")
+}
 func (UnimplementedArduinoCoreServiceServer) mustEmbedUnimplementedArduinoCoreServiceServer() {}
 
 // UnsafeArduinoCoreServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1457,6 +1780,42 @@ func _ArduinoCoreService_ArchiveSketch_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArduinoCoreService_ExtractSketch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtractSketchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).ExtractSketch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.v1.ArduinoCoreService/ExtractSketch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).ExtractSketch(ctx, req.(*ExtractSketchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCoreService_DumpSketchProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DumpSketchProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).DumpSketchProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.v1.ArduinoCoreService/DumpSketchProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).DumpSketchProfile(ctx, req.(*DumpSketchProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArduinoCoreService_BoardDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BoardDetailsRequest)
 	if err := dec(in); err != nil {
@@ -1532,6 +1891,42 @@ func _ArduinoCoreService_BoardListAll_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArduinoCoreService_BoardIdentify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardIdentifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).BoardIdentify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.v1.ArduinoCoreService/BoardIdentify",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).BoardIdentify(ctx, req.(*BoardIdentifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCoreService_ParseFQBN_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseFQBNRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).ParseFQBN(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.v1.ArduinoCoreService/ParseFQBN",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).ParseFQBN(ctx, req.(*ParseFQBNRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArduinoCoreService_BoardSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BoardSearchRequest)
 	if err := dec(in); err != nil {
@@ -1597,6 +1992,45 @@ func (x *arduinoCoreServiceCompileServer) Send(m *CompileResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ArduinoCoreService_CompileWatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CompileWatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServiceServer).CompileWatch(m, &arduinoCoreServiceCompileWatchServer{stream})
+}
+
+type ArduinoCoreService_CompileWatchServer interface {
+	Send(*CompileWatchResponse) error
+	grpc.ServerStream
+}
+
+type arduinoCoreServiceCompileWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServiceCompileWatchServer) Send(m *CompileWatchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCoreService_Preprocess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreprocessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).Preprocess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.v1.ArduinoCoreService/Preprocess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).Preprocess(ctx, req.(*PreprocessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArduinoCoreService_PlatformInstall_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(PlatformInstallRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1681,6 +2115,27 @@ func (x *arduinoCoreServicePlatformUpgradeServer) Send(m *PlatformUpgradeRespons
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ArduinoCoreService_PlatformRollback_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PlatformRollbackRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServiceServer).PlatformRollback(m, &arduinoCoreServicePlatformRollbackServer{stream})
+}
+
+type ArduinoCoreService_PlatformRollbackServer interface {
+	Send(*PlatformRollbackResponse) error
+	grpc.ServerStream
+}
+
+type arduinoCoreServicePlatformRollbackServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServicePlatformRollbackServer) Send(m *PlatformRollbackResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ArduinoCoreService_Upload_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(UploadRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1900,6 +2355,27 @@ func (x *arduinoCoreServiceGitLibraryInstallServer) Send(m *GitLibraryInstallRes
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ArduinoCoreService_LocalLibraryInstall_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LocalLibraryInstallRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServiceServer).LocalLibraryInstall(m, &arduinoCoreServiceLocalLibraryInstallServer{stream})
+}
+
+type ArduinoCoreService_LocalLibraryInstallServer interface {
+	Send(*LocalLibraryInstallResponse) error
+	grpc.ServerStream
+}
+
+type arduinoCoreServiceLocalLibraryInstallServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServiceLocalLibraryInstallServer) Send(m *LocalLibraryInstallResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ArduinoCoreService_LibraryUninstall_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(LibraryUninstallRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1996,6 +2472,42 @@ func _ArduinoCoreService_LibraryList_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArduinoCoreService_ListLibraryExamples_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLibraryExamplesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).ListLibraryExamples(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.v1.ArduinoCoreService/ListLibraryExamples",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).ListLibraryExamples(ctx, req.(*ListLibraryExamplesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCoreService_CopyLibraryExample_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyLibraryExampleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).CopyLibraryExample(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.v1.ArduinoCoreService/CopyLibraryExample",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).CopyLibraryExample(ctx, req.(*CopyLibraryExampleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArduinoCoreService_Monitor_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(ArduinoCoreServiceServer).Monitor(&arduinoCoreServiceMonitorServer{stream})
 }
@@ -2040,6 +2552,78 @@ func _ArduinoCoreService_EnumerateMonitorPortSettings_Handler(srv interface{}, c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArduinoCoreService_GetCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCacheStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).GetCacheStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.v1.ArduinoCoreService/GetCacheStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).GetCacheStats(ctx, req.(*GetCacheStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCoreService_ClearCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).ClearCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.v1.ArduinoCoreService/ClearCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).ClearCache(ctx, req.(*ClearCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCoreService_PruneDownloadsCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneDownloadsCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).PruneDownloadsCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.v1.ArduinoCoreService/PruneDownloadsCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).PruneDownloadsCache(ctx, req.(*PruneDownloadsCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCoreService_CompletionData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompletionDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).CompletionData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.v1.ArduinoCoreService/CompletionData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).CompletionData(ctx, req.(*CompletionDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ArduinoCoreService_ServiceDesc is the grpc.ServiceDesc for ArduinoCoreService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2075,6 +2659,14 @@ var ArduinoCoreService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ArchiveSketch",
 			Handler:    _ArduinoCoreService_ArchiveSketch_Handler,
 		},
+		{
+			MethodName: "ExtractSketch",
+			Handler:    _ArduinoCoreService_ExtractSketch_Handler,
+		},
+		{
+			MethodName: "DumpSketchProfile",
+			Handler:    _ArduinoCoreService_DumpSketchProfile_Handler,
+		},
 		{
 			MethodName: "BoardDetails",
 			Handler:    _ArduinoCoreService_BoardDetails_Handler,
@@ -2087,10 +2679,22 @@ var ArduinoCoreService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BoardListAll",
 			Handler:    _ArduinoCoreService_BoardListAll_Handler,
 		},
+		{
+			MethodName: "BoardIdentify",
+			Handler:    _ArduinoCoreService_BoardIdentify_Handler,
+		},
+		{
+			MethodName: "ParseFQBN",
+			Handler:    _ArduinoCoreService_ParseFQBN_Handler,
+		},
 		{
 			MethodName: "BoardSearch",
 			Handler:    _ArduinoCoreService_BoardSearch_Handler,
 		},
+		{
+			MethodName: "Preprocess",
+			Handler:    _ArduinoCoreService_Preprocess_Handler,
+		},
 		{
 			MethodName: "SupportedUserFields",
 			Handler:    _ArduinoCoreService_SupportedUserFields_Handler,
@@ -2119,10 +2723,34 @@ var ArduinoCoreService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LibraryList",
 			Handler:    _ArduinoCoreService_LibraryList_Handler,
 		},
+		{
+			MethodName: "ListLibraryExamples",
+			Handler:    _ArduinoCoreService_ListLibraryExamples_Handler,
+		},
+		{
+			MethodName: "CopyLibraryExample",
+			Handler:    _ArduinoCoreService_CopyLibraryExample_Handler,
+		},
 		{
 			MethodName: "EnumerateMonitorPortSettings",
 			Handler:    _ArduinoCoreService_EnumerateMonitorPortSettings_Handler,
 		},
+		{
+			MethodName: "GetCacheStats",
+			Handler:    _ArduinoCoreService_GetCacheStats_Handler,
+		},
+		{
+			MethodName: "ClearCache",
+			Handler:    _ArduinoCoreService_ClearCache_Handler,
+		},
+		{
+			MethodName: "PruneDownloadsCache",
+			Handler:    _ArduinoCoreService_PruneDownloadsCache_Handler,
+		},
+		{
+			MethodName: "CompletionData",
+			Handler:    _ArduinoCoreService_CompletionData_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2166,6 +2794,11 @@ var ArduinoCoreService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ArduinoCoreService_Compile_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "CompileWatch",
+			Handler:       _ArduinoCoreService_CompileWatch_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "PlatformInstall",
 			Handler:       _ArduinoCoreService_PlatformInstall_Handler,
@@ -2186,6 +2819,11 @@ var ArduinoCoreService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ArduinoCoreService_PlatformUpgrade_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "PlatformRollback",
+			Handler:       _ArduinoCoreService_PlatformRollback_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Upload",
 			Handler:       _ArduinoCoreService_Upload_Handler,
@@ -2221,6 +2859,11 @@ var ArduinoCoreService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ArduinoCoreService_GitLibraryInstall_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "LocalLibraryInstall",
+			Handler:       _ArduinoCoreService_LocalLibraryInstall_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "LibraryUninstall",
 			Handler:       _ArduinoCoreService_LibraryUninstall_Handler,
@@ -20,3 +20,6 @@ type DownloadProgressCB func(curr *DownloadProgress)
 
 // TaskProgressCB is a callback to receive progress messages
 type TaskProgressCB func(msg *TaskProgress)
+
+// UploadProgressCB is a callback to receive upload progress messages
+type UploadProgressCB func(msg *UploadProgress)
@@ -20,6 +20,10 @@ const _ = grpc.SupportPackageIsVersion7
 type DebugServiceClient interface {
 	// Start a debug session and communicate with the debugger tool.
 	Debug(ctx context.Context, opts ...grpc.CallOption) (DebugService_DebugClient, error)
+	// Returns the debug configuration (GDB server, toolchain and their options)
+	// resolved for the given board and programmer. The `sketch_path` is
+	// optional: if omitted the `executable` is not resolved, this can be used to
+	// check if a board supports debugging before compiling the sketch.
 	GetDebugConfig(ctx context.Context, in *DebugConfigRequest, opts ...grpc.CallOption) (*GetDebugConfigResponse, error)
 }
 
@@ -77,6 +81,10 @@ func (c *debugServiceClient) GetDebugConfig(ctx context.Context, in *DebugConfig
 type DebugServiceServer interface {
 	// Start a debug session and communicate with the debugger tool.
 	Debug(DebugService_DebugServer) error
+	// Returns the debug configuration (GDB server, toolchain and their options)
+	// resolved for the given board and programmer. The `sketch_path` is
+	// optional: if omitted the `executable` is not resolved, this can be used to
+	// check if a board supports debugging before compiling the sketch.
 	GetDebugConfig(context.Context, *DebugConfigRequest) (*GetDebugConfigResponse, error)
 	mustEmbedUnimplementedDebugServiceServer()
 }
@@ -82,6 +82,58 @@ func (MonitorConfig_TargetType) EnumDescriptor() ([]byte, []int) {
 	return file_cc_arduino_cli_monitor_v1_monitor_proto_rawDescGZIP(), []int{1, 0}
 }
 
+type MonitorConfig_LineEnding int32
+
+const (
+	MonitorConfig_LINE_ENDING_NONE MonitorConfig_LineEnding = 0
+	MonitorConfig_LINE_ENDING_CR   MonitorConfig_LineEnding = 1
+	MonitorConfig_LINE_ENDING_LF   MonitorConfig_LineEnding = 2
+	MonitorConfig_LINE_ENDING_CRLF MonitorConfig_LineEnding = 3
+)
+
+// Enum value maps for MonitorConfig_LineEnding.
+var (
+	MonitorConfig_LineEnding_name = map[int32]string{
+		0: "LINE_ENDING_NONE",
+		1: "LINE_ENDING_CR",
+		2: "LINE_ENDING_LF",
+		3: "LINE_ENDING_CRLF",
+	}
+	MonitorConfig_LineEnding_value = map[string]int32{
+		"LINE_ENDING_NONE": 0,
+		"LINE_ENDING_CR":   1,
+		"LINE_ENDING_LF":   2,
+		"LINE_ENDING_CRLF": 3,
+	}
+)
+
+func (x MonitorConfig_LineEnding) Enum() *MonitorConfig_LineEnding {
+	p := new(MonitorConfig_LineEnding)
+	*p = x
+	return p
+}
+
+func (x MonitorConfig_LineEnding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MonitorConfig_LineEnding) Descriptor() protoreflect.EnumDescriptor {
+	return file_cc_arduino_cli_monitor_v1_monitor_proto_enumTypes[1].Descriptor()
+}
+
+func (MonitorConfig_LineEnding) Type() protoreflect.EnumType {
+	return &file_cc_arduino_cli_monitor_v1_monitor_proto_enumTypes[1]
+}
+
+func (x MonitorConfig_LineEnding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MonitorConfig_LineEnding.Descriptor instead.
+func (MonitorConfig_LineEnding) EnumDescriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_monitor_v1_monitor_proto_rawDescGZIP(), []int{1, 1}
+}
+
 // The top-level message sent by the client for the `StreamingOpen` method.
 // Multiple `StreamingOpenReq` messages can be sent but the first message
 // must contain a `monitor_config` message to initialize the monitor target.
@@ -217,6 +269,38 @@ type MonitorConfig struct {
 	// will send incoming data to the client only when the client allows it: see
 	// the StreamingOpenReq.recv_acknowledge parameter for details.
 	RecvRateLimitBuffer int32 `protobuf:"varint,4,opt,name=recv_rate_limit_buffer,json=recvRateLimitBuffer,proto3" json:"recv_rate_limit_buffer,omitempty"`
+	// The size of the buffer used to read data from the target. If the
+	// rate limiter is not enabled, the incoming data is sent to the client in
+	// chunks of at most this size. If 0 a default of 1024 bytes is used.
+	ReadBufferSize int32 `protobuf:"varint,5,opt,name=read_buffer_size,json=readBufferSize,proto3" json:"read_buffer_size,omitempty"`
+	// If >0 the incoming data is coalesced: the server waits up to this number
+	// of milliseconds (or until the read buffer is full) before sending the
+	// data received to the client. This reduces the number of messages sent
+	// on high baud rates.
+	FlushIntervalMs int32 `protobuf:"varint,6,opt,name=flush_interval_ms,json=flushIntervalMs,proto3" json:"flush_interval_ms,omitempty"`
+	// If set, all the data received from the target is also appended to this
+	// file on the server, each line is prefixed with a timestamp.
+	MirrorFile string `protobuf:"bytes,7,opt,name=mirror_file,json=mirrorFile,proto3" json:"mirror_file,omitempty"`
+	// If true, each line received from the target is prefixed with an ISO-8601
+	// timestamp before being sent to the client. A line split across multiple
+	// messages gets only one timestamp.
+	Timestamp bool `protobuf:"varint,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// If true, the target is opened in shared read-only mode: if the target is
+	// already opened by another client its incoming data is shared with this
+	// client. Data can't be sent to the target in this mode, only one client
+	// at a time can open the target in the default (exclusive) mode to write
+	// to it.
+	Shared bool `protobuf:"varint,9,opt,name=shared,proto3" json:"shared,omitempty"`
+	// If true and the target is a serial port, when the port disappears (for
+	// example after a board reset) the server waits for the same device to
+	// re-enumerate, matching it by USB VID/PID/serial number, and reopens it
+	// instead of closing the stream. The client is notified with a
+	// StreamingOpenResponse.reconnected_target message. Data sent while the
+	// device is disconnected is discarded. When the target is shared this
+	// option is taken from the client that opened it.
+	Reconnect bool `protobuf:"varint,10,opt,name=reconnect,proto3" json:"reconnect,omitempty"`
+	// The line terminator appended to each data message sent to the target.
+	LineEnding MonitorConfig_LineEnding `protobuf:"varint,11,opt,name=line_ending,json=lineEnding,proto3,enum=cc.arduino.cli.monitor.v1.MonitorConfig_LineEnding" json:"line_ending,omitempty"`
 }
 
 func (x *MonitorConfig) Reset() {
@@ -279,6 +363,55 @@ func (x *MonitorConfig) GetRecvRateLimitBuffer() int32 {
 	return 0
 }
 
+func (x *MonitorConfig) GetReadBufferSize() int32 {
+	if x != nil {
+		return x.ReadBufferSize
+	}
+	return 0
+}
+
+func (x *MonitorConfig) GetFlushIntervalMs() int32 {
+	if x != nil {
+		return x.FlushIntervalMs
+	}
+	return 0
+}
+
+func (x *MonitorConfig) GetMirrorFile() string {
+	if x != nil {
+		return x.MirrorFile
+	}
+	return ""
+}
+
+func (x *MonitorConfig) GetTimestamp() bool {
+	if x != nil {
+		return x.Timestamp
+	}
+	return false
+}
+
+func (x *MonitorConfig) GetShared() bool {
+	if x != nil {
+		return x.Shared
+	}
+	return false
+}
+
+func (x *MonitorConfig) GetReconnect() bool {
+	if x != nil {
+		return x.Reconnect
+	}
+	return false
+}
+
+func (x *MonitorConfig) GetLineEnding() MonitorConfig_LineEnding {
+	if x != nil {
+		return x.LineEnding
+	}
+	return MonitorConfig_LINE_ENDING_NONE
+}
+
 // DEPRECATION WARNING: StreamingOpenResponse is deprecated and will be removed
 // in a future release. Use ArduinoCoreService.Monitor and
 // ArduinoCoreService.EnumerateMonitorPortSettings instead.
@@ -296,6 +429,10 @@ type StreamingOpenResponse struct {
 	// client is not able to process the recv window quickly enough this
 	// parameter will report the number of dropped bytes.
 	Dropped int32 `protobuf:"varint,2,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	// If not empty the target has been reopened after a disconnection, this is
+	// the name of the reopened port (it may be different from the original
+	// one). See MonitorConfig.reconnect for details.
+	ReconnectedTarget string `protobuf:"bytes,3,opt,name=reconnected_target,json=reconnectedTarget,proto3" json:"reconnected_target,omitempty"`
 }
 
 func (x *StreamingOpenResponse) Reset() {
@@ -344,6 +481,13 @@ func (x *StreamingOpenResponse) GetDropped() int32 {
 	return 0
 }
 
+func (x *StreamingOpenResponse) GetReconnectedTarget() string {
+	if x != nil {
+		return x.ReconnectedTarget
+	}
+	return ""
+}
+
 var File_cc_arduino_cli_monitor_v1_monitor_proto protoreflect.FileDescriptor
 
 var file_cc_arduino_cli_monitor_v1_monitor_proto_rawDesc = []byte{
@@ -364,7 +508,7 @@ var file_cc_arduino_cli_monitor_v1_monitor_proto_rawDesc = []byte{
 	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48,
 	0x00, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x76, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
 	0x67, 0x65, 0x3a, 0x02, 0x18, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x22, 0xab, 0x02, 0x0a, 0x0d, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e,
+	0x74, 0x22, 0xae, 0x05, 0x0a, 0x0d, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e,
 	0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x47, 0x0a, 0x04, 0x74,
 	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x63, 0x63, 0x2e, 0x61,
@@ -378,30 +522,58 @@ var file_cc_arduino_cli_monitor_v1_monitor_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x33, 0x0a, 0x16, 0x72, 0x65,
 	0x63, 0x76, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x75,
 	0x66, 0x66, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x72, 0x65, 0x63, 0x76,
-	0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x22,
-	0x3a, 0x0a, 0x0a, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
-	0x12, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x52,
-	0x49, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x63, 0x3a, 0x02, 0x18, 0x01, 0x22,
-	0x49, 0x0a, 0x15, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x65, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07,
-	0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x64,
-	0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x3a, 0x02, 0x18, 0x01, 0x32, 0x8f, 0x01, 0x0a, 0x0e, 0x4d,
-	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x78, 0x0a,
-	0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x2f,
-	0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e,
-	0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x30, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
-	0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x1a, 0x03, 0x88, 0x02, 0x01, 0x42, 0x49, 0x5a, 0x44,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69,
-	0x6e, 0x6f, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2d, 0x63, 0x6c, 0x69, 0x2f, 0x72,
-	0x70, 0x63, 0x2f, 0x63, 0x63, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x63, 0x6c,
-	0x69, 0x2f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x6f, 0x6e,
-	0x69, 0x74, 0x6f, 0x72, 0xb8, 0x01, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x12,
+	0x28, 0x0a, 0x10, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x72, 0x65, 0x61, 0x64, 0x42,
+	0x75, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x66, 0x6c, 0x75,
+	0x73, 0x68, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x4d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x69, 0x72, 0x72,
+	0x6f, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x54, 0x0a, 0x0b, 0x6c, 0x69,
+	0x6e, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x33, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4c, 0x69, 0x6e, 0x65, 0x45, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x22, 0x3a, 0x0a, 0x0a, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16,
+	0x0a, 0x12, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45,
+	0x52, 0x49, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x63, 0x22, 0x60, 0x0a, 0x0a,
+	0x4c, 0x69, 0x6e, 0x65, 0x45, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x10, 0x4c, 0x49,
+	0x4e, 0x45, 0x5f, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00,
+	0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f,
+	0x43, 0x52, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x45, 0x4e, 0x44,
+	0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x46, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x4c, 0x49, 0x4e, 0x45,
+	0x5f, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x52, 0x4c, 0x46, 0x10, 0x03, 0x3a, 0x02,
+	0x18, 0x01, 0x22, 0x78, 0x0a, 0x15, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4f,
+	0x70, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x3a, 0x02, 0x18, 0x01, 0x32, 0x8f, 0x01, 0x0a,
+	0x0e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x78, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x65, 0x6e,
+	0x12, 0x2f, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c,
+	0x69, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x30, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63,
+	0x6c, 0x69, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x1a, 0x03, 0x88, 0x02, 0x01, 0x42, 0x49,
+	0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x64,
+	0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2d, 0x63, 0x6c, 0x69,
+	0x2f, 0x72, 0x70, 0x63, 0x2f, 0x63, 0x63, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f,
+	0x63, 0x6c, 0x69, 0x2f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x6d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0xb8, 0x01, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -416,26 +588,28 @@ func file_cc_arduino_cli_monitor_v1_monitor_proto_rawDescGZIP() []byte {
 	return file_cc_arduino_cli_monitor_v1_monitor_proto_rawDescData
 }
 
-var file_cc_arduino_cli_monitor_v1_monitor_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cc_arduino_cli_monitor_v1_monitor_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
 var file_cc_arduino_cli_monitor_v1_monitor_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_cc_arduino_cli_monitor_v1_monitor_proto_goTypes = []interface{}{
 	(MonitorConfig_TargetType)(0), // 0: cc.arduino.cli.monitor.v1.MonitorConfig.TargetType
-	(*StreamingOpenRequest)(nil),  // 1: cc.arduino.cli.monitor.v1.StreamingOpenRequest
-	(*MonitorConfig)(nil),         // 2: cc.arduino.cli.monitor.v1.MonitorConfig
-	(*StreamingOpenResponse)(nil), // 3: cc.arduino.cli.monitor.v1.StreamingOpenResponse
-	(*structpb.Struct)(nil),       // 4: google.protobuf.Struct
+	(MonitorConfig_LineEnding)(0), // 1: cc.arduino.cli.monitor.v1.MonitorConfig.LineEnding
+	(*StreamingOpenRequest)(nil),  // 2: cc.arduino.cli.monitor.v1.StreamingOpenRequest
+	(*MonitorConfig)(nil),         // 3: cc.arduino.cli.monitor.v1.MonitorConfig
+	(*StreamingOpenResponse)(nil), // 4: cc.arduino.cli.monitor.v1.StreamingOpenResponse
+	(*structpb.Struct)(nil),       // 5: google.protobuf.Struct
 }
 var file_cc_arduino_cli_monitor_v1_monitor_proto_depIdxs = []int32{
-	2, // 0: cc.arduino.cli.monitor.v1.StreamingOpenRequest.config:type_name -> cc.arduino.cli.monitor.v1.MonitorConfig
+	3, // 0: cc.arduino.cli.monitor.v1.StreamingOpenRequest.config:type_name -> cc.arduino.cli.monitor.v1.MonitorConfig
 	0, // 1: cc.arduino.cli.monitor.v1.MonitorConfig.type:type_name -> cc.arduino.cli.monitor.v1.MonitorConfig.TargetType
-	4, // 2: cc.arduino.cli.monitor.v1.MonitorConfig.additional_config:type_name -> google.protobuf.Struct
-	1, // 3: cc.arduino.cli.monitor.v1.MonitorService.StreamingOpen:input_type -> cc.arduino.cli.monitor.v1.StreamingOpenRequest
-	3, // 4: cc.arduino.cli.monitor.v1.MonitorService.StreamingOpen:output_type -> cc.arduino.cli.monitor.v1.StreamingOpenResponse
-	4, // [4:5] is the sub-list for method output_type
-	3, // [3:4] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	5, // 2: cc.arduino.cli.monitor.v1.MonitorConfig.additional_config:type_name -> google.protobuf.Struct
+	1, // 3: cc.arduino.cli.monitor.v1.MonitorConfig.line_ending:type_name -> cc.arduino.cli.monitor.v1.MonitorConfig.LineEnding
+	2, // 4: cc.arduino.cli.monitor.v1.MonitorService.StreamingOpen:input_type -> cc.arduino.cli.monitor.v1.StreamingOpenRequest
+	4, // 5: cc.arduino.cli.monitor.v1.MonitorService.StreamingOpen:output_type -> cc.arduino.cli.monitor.v1.StreamingOpenResponse
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_cc_arduino_cli_monitor_v1_monitor_proto_init() }
@@ -491,7 +665,7 @@ func file_cc_arduino_cli_monitor_v1_monitor_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cc_arduino_cli_monitor_v1_monitor_proto_rawDesc,
-			NumEnums:      1,
+			NumEnums:      2,
 			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   1,
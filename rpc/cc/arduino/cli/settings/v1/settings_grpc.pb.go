@@ -24,10 +24,27 @@ type SettingsServiceClient interface {
 	Merge(ctx context.Context, in *MergeRequest, opts ...grpc.CallOption) (*MergeResponse, error)
 	// Get the value of a specific setting.
 	GetValue(ctx context.Context, in *GetValueRequest, opts ...grpc.CallOption) (*GetValueResponse, error)
+	// Get the value of a specific setting preserving its type (bool, number,
+	// string, list or map).
+	GetTypedValue(ctx context.Context, in *GetTypedValueRequest, opts ...grpc.CallOption) (*GetTypedValueResponse, error)
 	// Set the value of a specific setting.
 	SetValue(ctx context.Context, in *SetValueRequest, opts ...grpc.CallOption) (*SetValueResponse, error)
 	// Writes to file settings currently stored in memory
 	Write(ctx context.Context, in *WriteRequest, opts ...grpc.CallOption) (*WriteResponse, error)
+	// Re-reads the settings from the configuration file in use.
+	Reload(ctx context.Context, in *ReloadRequest, opts ...grpc.CallOption) (*ReloadResponse, error)
+	// Streams an event each time a setting is changed by SetValue or Merge.
+	WatchSettings(ctx context.Context, in *WatchSettingsRequest, opts ...grpc.CallOption) (SettingsService_WatchSettingsClient, error)
+	// List the additional board manager URLs
+	// (`board_manager.additional_urls`).
+	ListBoardManagerURLs(ctx context.Context, in *ListBoardManagerURLsRequest, opts ...grpc.CallOption) (*ListBoardManagerURLsResponse, error)
+	// Add an URL to the additional board manager URLs, after checking that it
+	// points to a valid package index. The settings are not written to file,
+	// use Write to persist them.
+	AddBoardManagerURL(ctx context.Context, in *AddBoardManagerURLRequest, opts ...grpc.CallOption) (*AddBoardManagerURLResponse, error)
+	// Remove an URL from the additional board manager URLs. The settings are
+	// not written to file, use Write to persist them.
+	RemoveBoardManagerURL(ctx context.Context, in *RemoveBoardManagerURLRequest, opts ...grpc.CallOption) (*RemoveBoardManagerURLResponse, error)
 }
 
 type settingsServiceClient struct {
@@ -65,6 +82,15 @@ func (c *settingsServiceClient) GetValue(ctx context.Context, in *GetValueReques
 	return out, nil
 }
 
+func (c *settingsServiceClient) GetTypedValue(ctx context.Context, in *GetTypedValueRequest, opts ...grpc.CallOption) (*GetTypedValueResponse, error) {
+	out := new(GetTypedValueResponse)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.settings.v1.SettingsService/GetTypedValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *settingsServiceClient) SetValue(ctx context.Context, in *SetValueRequest, opts ...grpc.CallOption) (*SetValueResponse, error) {
 	out := new(SetValueResponse)
 	err := c.cc.Invoke(ctx, "/cc.arduino.cli.settings.v1.SettingsService/SetValue", in, out, opts...)
@@ -83,6 +109,74 @@ func (c *settingsServiceClient) Write(ctx context.Context, in *WriteRequest, opt
 	return out, nil
 }
 
+func (c *settingsServiceClient) Reload(ctx context.Context, in *ReloadRequest, opts ...grpc.CallOption) (*ReloadResponse, error) {
+	out := new(ReloadResponse)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.settings.v1.SettingsService/Reload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settingsServiceClient) WatchSettings(ctx context.Context, in *WatchSettingsRequest, opts ...grpc.CallOption) (SettingsService_WatchSettingsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SettingsService_ServiceDesc.Streams[0], "/cc.arduino.cli.settings.v1.SettingsService/WatchSettings", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &settingsServiceWatchSettingsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SettingsService_WatchSettingsClient interface {
+	Recv() (*WatchSettingsResponse, error)
+	grpc.ClientStream
+}
+
+type settingsServiceWatchSettingsClient struct {
+	grpc.ClientStream
+}
+
+func (x *settingsServiceWatchSettingsClient) Recv() (*WatchSettingsResponse, error) {
+	m := new(WatchSettingsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *settingsServiceClient) ListBoardManagerURLs(ctx context.Context, in *ListBoardManagerURLsRequest, opts ...grpc.CallOption) (*ListBoardManagerURLsResponse, error) {
+	out := new(ListBoardManagerURLsResponse)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.settings.v1.SettingsService/ListBoardManagerURLs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settingsServiceClient) AddBoardManagerURL(ctx context.Context, in *AddBoardManagerURLRequest, opts ...grpc.CallOption) (*AddBoardManagerURLResponse, error) {
+	out := new(AddBoardManagerURLResponse)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.settings.v1.SettingsService/AddBoardManagerURL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settingsServiceClient) RemoveBoardManagerURL(ctx context.Context, in *RemoveBoardManagerURLRequest, opts ...grpc.CallOption) (*RemoveBoardManagerURLResponse, error) {
+	out := new(RemoveBoardManagerURLResponse)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.settings.v1.SettingsService/RemoveBoardManagerURL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SettingsServiceServer is the server API for SettingsService service.
 // All implementations must embed UnimplementedSettingsServiceServer
 // for forward compatibility
@@ -93,10 +187,27 @@ type SettingsServiceServer interface {
 	Merge(context.Context, *MergeRequest) (*MergeResponse, error)
 	// Get the value of a specific setting.
 	GetValue(context.Context, *GetValueRequest) (*GetValueResponse, error)
+	// Get the value of a specific setting preserving its type (bool, number,
+	// string, list or map).
+	GetTypedValue(context.Context, *GetTypedValueRequest) (*GetTypedValueResponse, error)
 	// Set the value of a specific setting.
 	SetValue(context.Context, *SetValueRequest) (*SetValueResponse, error)
 	// Writes to file settings currently stored in memory
 	Write(context.Context, *WriteRequest) (*WriteResponse, error)
+	// Re-reads the settings from the configuration file in use.
+	Reload(context.Context, *ReloadRequest) (*ReloadResponse, error)
+	// Streams an event each time a setting is changed by SetValue or Merge.
+	WatchSettings(*WatchSettingsRequest, SettingsService_WatchSettingsServer) error
+	// List the additional board manager URLs
+	// (`board_manager.additional_urls`).
+	ListBoardManagerURLs(context.Context, *ListBoardManagerURLsRequest) (*ListBoardManagerURLsResponse, error)
+	// Add an URL to the additional board manager URLs, after checking that it
+	// points to a valid package index. The settings are not written to file,
+	// use Write to persist them.
+	AddBoardManagerURL(context.Context, *AddBoardManagerURLRequest) (*AddBoardManagerURLResponse, error)
+	// Remove an URL from the additional board manager URLs. The settings are
+	// not written to file, use Write to persist them.
+	RemoveBoardManagerURL(context.Context, *RemoveBoardManagerURLRequest) (*RemoveBoardManagerURLResponse, error)
 	mustEmbedUnimplementedSettingsServiceServer()
 }
 
@@ -113,12 +224,30 @@ func (UnimplementedSettingsServiceServer) Merge(context.Context, *MergeRequest)
 func (UnimplementedSettingsServiceServer) GetValue(context.Context, *GetValueRequest) (*GetValueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetValue not implemented")
 }
+func (UnimplementedSettingsServiceServer) GetTypedValue(context.Context, *GetTypedValueRequest) (*GetTypedValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTypedValue not implemented")
+}
 func (UnimplementedSettingsServiceServer) SetValue(context.Context, *SetValueRequest) (*SetValueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetValue not implemented")
 }
 func (UnimplementedSettingsServiceServer) Write(context.Context, *WriteRequest) (*WriteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Write not implemented")
 }
+func (UnimplementedSettingsServiceServer) Reload(context.Context, *ReloadRequest) (*ReloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reload not implemented")
+}
+func (UnimplementedSettingsServiceServer) WatchSettings(*WatchSettingsRequest, SettingsService_WatchSettingsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSettings not implemented")
+}
+func (UnimplementedSettingsServiceServer) ListBoardManagerURLs(context.Context, *ListBoardManagerURLsRequest) (*ListBoardManagerURLsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBoardManagerURLs not implemented")
+}
+func (UnimplementedSettingsServiceServer) AddBoardManagerURL(context.Context, *AddBoardManagerURLRequest) (*AddBoardManagerURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBoardManagerURL not implemented")
+}
+func (UnimplementedSettingsServiceServer) RemoveBoardManagerURL(context.Context, *RemoveBoardManagerURLRequest) (*RemoveBoardManagerURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBoardManagerURL not implemented")
+}
 func (UnimplementedSettingsServiceServer) mustEmbedUnimplementedSettingsServiceServer() {}
 
 // UnsafeSettingsServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -186,6 +315,24 @@ func _SettingsService_GetValue_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SettingsService_GetTypedValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTypedValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingsServiceServer).GetTypedValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.settings.v1.SettingsService/GetTypedValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingsServiceServer).GetTypedValue(ctx, req.(*GetTypedValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SettingsService_SetValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetValueRequest)
 	if err := dec(in); err != nil {
@@ -222,6 +369,99 @@ func _SettingsService_Write_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SettingsService_Reload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingsServiceServer).Reload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.settings.v1.SettingsService/Reload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingsServiceServer).Reload(ctx, req.(*ReloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettingsService_WatchSettings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSettingsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SettingsServiceServer).WatchSettings(m, &settingsServiceWatchSettingsServer{stream})
+}
+
+type SettingsService_WatchSettingsServer interface {
+	Send(*WatchSettingsResponse) error
+	grpc.ServerStream
+}
+
+type settingsServiceWatchSettingsServer struct {
+	grpc.ServerStream
+}
+
+func (x *settingsServiceWatchSettingsServer) Send(m *WatchSettingsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SettingsService_ListBoardManagerURLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBoardManagerURLsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingsServiceServer).ListBoardManagerURLs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.settings.v1.SettingsService/ListBoardManagerURLs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingsServiceServer).ListBoardManagerURLs(ctx, req.(*ListBoardManagerURLsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettingsService_AddBoardManagerURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBoardManagerURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingsServiceServer).AddBoardManagerURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.settings.v1.SettingsService/AddBoardManagerURL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingsServiceServer).AddBoardManagerURL(ctx, req.(*AddBoardManagerURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettingsService_RemoveBoardManagerURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveBoardManagerURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingsServiceServer).RemoveBoardManagerURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.settings.v1.SettingsService/RemoveBoardManagerURL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingsServiceServer).RemoveBoardManagerURL(ctx, req.(*RemoveBoardManagerURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SettingsService_ServiceDesc is the grpc.ServiceDesc for SettingsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -241,6 +481,10 @@ var SettingsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetValue",
 			Handler:    _SettingsService_GetValue_Handler,
 		},
+		{
+			MethodName: "GetTypedValue",
+			Handler:    _SettingsService_GetTypedValue_Handler,
+		},
 		{
 			MethodName: "SetValue",
 			Handler:    _SettingsService_SetValue_Handler,
@@ -249,7 +493,29 @@ var SettingsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Write",
 			Handler:    _SettingsService_Write_Handler,
 		},
+		{
+			MethodName: "Reload",
+			Handler:    _SettingsService_Reload_Handler,
+		},
+		{
+			MethodName: "ListBoardManagerURLs",
+			Handler:    _SettingsService_ListBoardManagerURLs_Handler,
+		},
+		{
+			MethodName: "AddBoardManagerURL",
+			Handler:    _SettingsService_AddBoardManagerURL_Handler,
+		},
+		{
+			MethodName: "RemoveBoardManagerURL",
+			Handler:    _SettingsService_RemoveBoardManagerURL_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchSettings",
+			Handler:       _SettingsService_WatchSettings_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "cc/arduino/cli/settings/v1/settings.proto",
 }
@@ -502,6 +502,25 @@ def test_board_details(run_command):
     assert result["debugging_supported"] is True
 
 
+def test_board_details_memory(run_command):
+    run_command(["core", "update-index"])
+    run_command(["core", "install", "arduino:avr@1.8.3"])
+
+    result = run_command(["board", "details", "-b", "arduino:avr:uno", "--format", "json"])
+    assert result.ok
+    memory = json.loads(result.stdout)["memory"]
+    assert memory["maximum_size"] == 32256
+    assert memory["maximum_data_size"] == 2048
+    assert {"name": "flash", "size": 32256, "property": "upload.maximum_size"} in memory["regions"]
+
+    # The selected configuration options are applied
+    result = run_command(["board", "details", "-b", "arduino:avr:nano:cpu=atmega168", "--format", "json"])
+    assert result.ok
+    memory = json.loads(result.stdout)["memory"]
+    assert memory["maximum_size"] == 14336
+    assert memory["maximum_data_size"] == 1024
+
+
 def test_board_details_no_flags(run_command):
     run_command(["core", "update-index"])
     # Download samd core pinned to 1.8.6
@@ -640,6 +659,32 @@ def test_board_attach_without_sketch_json(run_command, data_dir):
     assert run_command(["board", "attach", "-b", fqbn, sketch_path])
 
 
+def test_board_search_reports_platform_version(run_command):
+    assert run_command(["update"])
+    assert run_command(["core", "install", "arduino:avr@1.8.3"])
+
+    res = run_command(["board", "search", "--format", "json", "uno"])
+    assert res.ok
+    data = json.loads(res.stdout)
+    uno = [board for board in data if board.get("fqbn") == "arduino:avr:uno"]
+    assert len(uno) == 1
+    assert uno[0]["installed"]
+    assert uno[0]["platform_version"] == "1.8.3"
+
+    # The boards of the platforms not installed report the latest version
+    res = run_command(["board", "search", "--format", "json", "portenta"])
+    assert res.ok
+    data = json.loads(res.stdout)
+    assert len(data) > 0
+    for board in data:
+        assert "installed" not in board
+        assert board["platform_version"] == board["platform"]["latest"]
+
+    res = run_command(["board", "search", "portenta"])
+    assert res.ok
+    assert "(not installed)" in res.stdout
+
+
 def test_board_search_with_outdated_core(run_command):
     assert run_command(["update"])
 
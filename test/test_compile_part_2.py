@@ -19,6 +19,26 @@ from pathlib import Path
 import simplejson as json
 
 
+def test_compile_memory_usage(run_command, data_dir):
+    assert run_command(["update"])
+    assert run_command(["core", "install", "arduino:avr@1.8.3"])
+
+    sketch_path = Path(data_dir, "CompileMemoryUsage")
+    assert run_command(["sketch", "new", sketch_path])
+
+    result = run_command(["compile", "-b", "arduino:avr:uno", sketch_path, "--format", "json"])
+    assert result.ok
+    builder_result = json.loads(result.stdout)["builder_result"]
+    usage = builder_result["memory_usage"]
+    assert usage["flash_used"] > 0
+    assert usage["flash_total"] == 32256
+    assert usage["ram_used"] > 0
+    assert usage["ram_total"] == 2048
+    sections = {s["name"]: s for s in builder_result["executable_sections_size"]}
+    assert usage["flash_used"] == sections["text"]["size"]
+    assert usage["ram_used"] == sections["data"]["size"]
+
+
 def test_compile_with_output_dir_flag(run_command, data_dir):
     # Init the environment explicitly
     run_command(["core", "update-index"])
@@ -286,3 +306,145 @@ def test_compile_with_precompiled_library(run_command, data_dir):
     result = run_command(["compile", "-b", fqbn, sketch_folder, "-v"])
     assert result.ok
     assert "Skipping dependencies detection for precompiled library BSEC Software Library" not in result.stdout
+
+
+def test_compile_with_invalid_board_option(run_command, data_dir):
+    assert run_command(["update"])
+    assert run_command(["core", "install", "arduino:avr@1.8.3"])
+
+    sketch_path = Path(data_dir, "CompileInvalidBoardOption")
+    assert run_command(["sketch", "new", sketch_path])
+
+    # The value of the option is not valid
+    res = run_command(["compile", "-b", "arduino:avr:nano:cpu=atmega1234", sketch_path])
+    assert res.failed
+    assert (
+        "Invalid value 'atmega1234' for board option 'cpu', valid values are: atmega328, atmega328old, atmega168"
+        in res.stderr
+    )
+
+    # The option is not declared by the board
+    res = run_command(["compile", "-b", "arduino:avr:nano:foo=bar", sketch_path])
+    assert res.failed
+    assert "Invalid board option 'foo', valid options are: cpu" in res.stderr
+
+    # The board has no custom options
+    res = run_command(["compile", "-b", "arduino:avr:uno:cpu=atmega328", sketch_path])
+    assert res.failed
+    assert "Invalid board option 'cpu', the board has no custom options" in res.stderr
+
+
+def test_compile_show_properties(run_command, data_dir):
+    assert run_command(["update"])
+    assert run_command(["core", "install", "arduino:avr@1.8.3"])
+
+    sketch_path = Path(data_dir, "CompileShowProperties")
+    assert run_command(["sketch", "new", sketch_path])
+
+    res = run_command(["compile", "-b", "arduino:avr:nano:cpu=atmega328old", sketch_path, "--show-properties"])
+    assert res.ok
+    lines = res.stdout.splitlines()
+    assert lines == sorted(lines)
+    assert "build.mcu=atmega328p" in lines
+    assert "upload.speed=57600" in lines
+    # References to other properties are expanded
+    assert not any(line.startswith("compiler.path=") and "{" in line for line in lines)
+
+    res = run_command(
+        ["compile", "-b", "arduino:avr:nano:cpu=atmega328old", sketch_path, "--show-properties", "--format", "json"]
+    )
+    assert res.ok
+    build_properties = json.loads(res.stdout)["builder_result"]["build_properties"]
+    assert "upload.speed=57600" in build_properties
+
+
+def test_compile_with_build_property_overrides(run_command, data_dir):
+    assert run_command(["update"])
+    assert run_command(["core", "install", "arduino:avr@1.8.3"])
+
+    sketch_path = Path(data_dir, "CompileBuildPropertyOverrides")
+    assert run_command(["sketch", "new", sketch_path])
+
+    # The flags override the properties of the platform
+    res = run_command(
+        [
+            "compile",
+            "-b",
+            "arduino:avr:uno",
+            sketch_path,
+            "--build-property",
+            "build.f_cpu=12000000L",
+            "--show-properties",
+        ]
+    )
+    assert res.ok
+    assert "build.f_cpu=12000000L" in res.stdout.splitlines()
+
+    # The properties not defined by the platform are added, with a warning in verbose mode
+    res = run_command(["compile", "-b", "arduino:avr:uno", sketch_path, "--build-property", "my.new.property=1", "-v"])
+    assert res.ok
+    assert "Warning: build property 'my.new.property' is not defined by the platform, adding it" in res.stderr
+
+
+def test_compile_reuses_object_files(run_command, data_dir):
+    assert run_command(["update"])
+    assert run_command(["core", "install", "arduino:avr@1.8.3"])
+
+    sketch_path = Path(data_dir, "CompileReusesObjectFiles")
+    assert run_command(["sketch", "new", sketch_path])
+    Path(sketch_path, "other.cpp").write_text("int other() { return 1; }\n")
+    build_path = Path(data_dir, "build")
+    compile_cmd = ["compile", "-b", "arduino:avr:uno", sketch_path, "--build-path", build_path, "--format", "json"]
+
+    res = run_command(compile_cmd)
+    assert res.ok
+    stats = json.loads(res.stdout)["builder_result"]["compile_stats"]
+    assert stats["compiled_files"] > 0
+
+    # Nothing changed, all the object files are reused
+    res = run_command(compile_cmd)
+    assert res.ok
+    stats = json.loads(res.stdout)["builder_result"]["compile_stats"]
+    assert "compiled_files" not in stats
+    assert stats["reused_files"] > 0
+
+    # Only the edited file is compiled again
+    Path(sketch_path, "other.cpp").write_text("int other() { return 2; }\n")
+    res = run_command(compile_cmd)
+    assert res.ok
+    assert json.loads(res.stdout)["builder_result"]["compile_stats"]["compiled_files"] == 1
+
+    # Everything is compiled again with --clean
+    res = run_command(compile_cmd + ["--clean"])
+    assert res.ok
+    assert "reused_files" not in json.loads(res.stdout)["builder_result"]["compile_stats"]
+
+
+def test_compile_with_jobs(run_command, data_dir):
+    assert run_command(["update"])
+    assert run_command(["core", "install", "arduino:avr@1.8.3"])
+
+    sketch_path = Path(data_dir, "CompileWithJobs")
+    assert run_command(["sketch", "new", sketch_path])
+    Path(sketch_path, "a.cpp").write_text("int a() { return x; }\n")
+    Path(sketch_path, "b.cpp").write_text("int b() { return y; }\n")
+
+    # The error reported is always the one of the first file, regardless of the scheduling
+    for jobs in ["1", "4"]:
+        res = run_command(["compile", "-b", "arduino:avr:uno", sketch_path, "--jobs", jobs])
+        assert res.failed
+        assert "a.cpp" in res.stderr
+
+    res = run_command(["compile", "-b", "arduino:avr:uno", sketch_path, "--jobs", "-1"])
+    assert res.failed
+    assert "The number of parallel jobs must not be negative" in res.stderr
+
+
+def test_compile_watch_with_incompatible_flags(run_command, data_dir):
+    sketch_path = Path(data_dir, "CompileWatch")
+    assert run_command(["sketch", "new", sketch_path])
+
+    for flag in ["--upload", "--show-properties", "--preprocess"]:
+        res = run_command(["compile", "-b", "arduino:avr:uno", sketch_path, "--watch", flag])
+        assert res.failed
+        assert "Can't use --watch and " + flag + " flags at the same time." in res.stderr
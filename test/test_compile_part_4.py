@@ -14,6 +14,7 @@
 # a commercial license, send an email to license@arduino.cc.
 
 import tempfile
+import json
 import hashlib
 import shutil
 from git import Repo
@@ -499,3 +500,97 @@ def test_compile_with_fake_secure_boot_core(run_command, data_dir):
     assert res.ok
     assert "my-sign-key.pem" in res.stdout
     assert "my-encrypt-key.pem" in res.stdout
+
+
+def test_compile_with_defines_file(run_command, data_dir):
+    assert run_command(["update"])
+    assert run_command(["core", "install", "arduino:avr@1.8.3"])
+
+    sketch_path = Path(data_dir, "CompileWithDefinesFile")
+    sketch_path.mkdir()
+    Path(sketch_path, "CompileWithDefinesFile.ino").write_text(
+        "#ifndef WIFI_SSID\n"
+        + '#error "WIFI_SSID not defined"\n'
+        + "#endif\n"
+        + "const char *ssid = WIFI_SSID;\n"
+        + "void setup() {}\n"
+        + "void loop() {}\n"
+    )
+    defines_file = Path(data_dir, "secrets.txt")
+    defines_file.write_text('# WiFi credentials\nWIFI_SSID="My WiFi"\nWIFI_CHANNEL=6\n')
+
+    res = run_command(["compile", "-b", "arduino:avr:uno", sketch_path])
+    assert res.failed
+    assert "WIFI_SSID not defined" in res.stderr
+
+    # The values of the defines are masked in the printed commands
+    res = run_command(["compile", "-b", "arduino:avr:uno", "--defines-file", defines_file, sketch_path, "-v"])
+    assert res.ok
+    assert "-DWIFI_SSID=*** -DWIFI_CHANNEL=***" in res.stdout
+    assert "My WiFi" not in res.stdout
+    res = run_command(
+        ["compile", "-b", "arduino:avr:uno", "--defines-file", defines_file, sketch_path, "--dry-run", "--clean"]
+    )
+    assert res.ok
+    assert "-DWIFI_SSID=***" in res.stdout
+    assert "My WiFi" not in res.stdout
+    # The defines are not written to the sketch, the build properties or the compilation database
+    assert "My WiFi" not in Path(sketch_path, "CompileWithDefinesFile.ino").read_text()
+    res = run_command(
+        ["compile", "-b", "arduino:avr:uno", "--defines-file", defines_file, sketch_path, "--format", "json"]
+    )
+    assert res.ok
+    build_path = json.loads(res.stdout)["builder_result"]["build_path"]
+    for build_file in Path(build_path).glob("*.json"):
+        assert "My WiFi" not in build_file.read_text()
+    res = run_command(
+        ["compile", "-b", "arduino:avr:uno", "--defines-file", defines_file, sketch_path, "--show-properties"]
+    )
+    assert res.ok
+    assert "My WiFi" not in res.stdout
+
+    defines_file.write_text("NOT-VALID=1\n")
+    res = run_command(["compile", "-b", "arduino:avr:uno", "--defines-file", defines_file, sketch_path])
+    assert res.failed
+    assert "Invalid macro name 'NOT-VALID'" in res.stderr
+
+
+def test_compile_with_expected_sha256(run_command, data_dir):
+    assert run_command(["update"])
+    assert run_command(["core", "install", "arduino:avr@1.8.3"])
+
+    sketch_name = "CompileWithExpectedSha256"
+    sketch_path = Path(data_dir, sketch_name)
+    assert run_command(["sketch", "new", sketch_path])
+
+    res = run_command(["compile", "-b", "arduino:avr:uno", sketch_path, "--format", "json"])
+    assert res.ok
+    hashes = json.loads(res.stdout)["builder_result"]["build_hashes"]
+    artifacts = {a["name"]: a["sha256"] for a in hashes["artifacts"]}
+    hex_name = f"{sketch_name}.ino.hex"
+    assert hex_name in artifacts
+    assert f"{sketch_name}.ino.elf" in artifacts
+    hex_path = Path(json.loads(res.stdout)["builder_result"]["build_path"], hex_name)
+    assert artifacts[hex_name] == hashlib.sha256(hex_path.read_bytes()).hexdigest()
+
+    # An incremental build runs the same commands
+    res = run_command(["compile", "-b", "arduino:avr:uno", sketch_path, "--format", "json"])
+    assert res.ok
+    assert json.loads(res.stdout)["builder_result"]["build_hashes"] == hashes
+
+    res = run_command(
+        ["compile", "-b", "arduino:avr:uno", sketch_path, "--expect-sha256", f"{hex_name}={artifacts[hex_name]}"]
+    )
+    assert res.ok
+
+    res = run_command(["compile", "-b", "arduino:avr:uno", sketch_path, "--expect-sha256", f"{hex_name}={'0' * 64}"])
+    assert res.failed
+    assert f"SHA-256 of build artifact {hex_name} is {artifacts[hex_name]}" in res.stderr
+
+    res = run_command(["compile", "-b", "arduino:avr:uno", sketch_path, "--expect-sha256", f"missing.hex={'0' * 64}"])
+    assert res.failed
+    assert "Build artifact missing.hex not found" in res.stderr
+
+    res = run_command(["compile", "-b", "arduino:avr:uno", sketch_path, "--expect-sha256", hex_name])
+    assert res.failed
+    assert "it must be in the form NAME=HASH" in res.stderr
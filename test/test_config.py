@@ -555,6 +555,39 @@ def test_set_bool_with_multiple_arguments(run_command):
     assert "Can't set multiple values in key library.enable_unsafe_install" in res.stderr
 
 
+def test_set_bool_with_invalid_value(run_command):
+    # Create a config file
+    assert run_command(["config", "init", "--dest-dir", "."])
+
+    res = run_command(["config", "set", "library.enable_unsafe_install", "maybe"])
+    assert res.failed
+    assert "expected a boolean (true or false)" in res.stderr
+
+    # Verifies value is not changed
+    result = run_command(["config", "dump", "--format", "json"])
+    assert result.ok
+    settings_json = json.loads(result.stdout)
+    assert not settings_json["library"]["enable_unsafe_install"]
+
+
+def test_set_int(run_command):
+    # Create a config file
+    assert run_command(["config", "init", "--dest-dir", "."])
+
+    # Changes value
+    assert run_command(["config", "set", "network.retries", "5"])
+
+    # Verifies value is changed and stored as an integer
+    result = run_command(["config", "dump", "--format", "json"])
+    assert result.ok
+    settings_json = json.loads(result.stdout)
+    assert 5 == settings_json["network"]["retries"]
+
+    res = run_command(["config", "set", "network.retries", "five"])
+    assert res.failed
+    assert "Invalid value 'five' for key network.retries: expected an integer" in res.stderr
+
+
 def test_delete(run_command, working_dir):
     # Create a config file
     assert run_command(["config", "init", "--dest-dir", "."])
@@ -591,3 +624,65 @@ def test_delete(run_command, working_dir):
     config_lines = config_file.open().readlines()
     assert "additional_urls" not in config_lines
     assert "board_manager" not in config_lines
+
+
+def test_describe(run_command):
+    result = run_command(["config", "describe", "--format", "json"])
+    assert result.ok
+    settings = {s["key"]: s for s in json.loads(result.stdout)["settings"]}
+    assert "array" == settings["board_manager.additional_urls"]["type"]
+    assert [] == settings["board_manager.additional_urls"]["default"]
+    assert "boolean" == settings["metrics.enabled"]["type"]
+    assert settings["metrics.enabled"]["default"]
+    assert "integer" == settings["network.retries"]["type"]
+    assert 3 == settings["network.retries"]["default"]
+    assert "ARDUINO_DAEMON_PORT" == settings["daemon.port"]["env_var"]
+    for setting in settings.values():
+        assert "" != setting["description"]
+
+
+def test_validate(run_command, working_dir):
+    # Create a config file
+    assert run_command(["config", "init", "--dest-dir", "."])
+    config_file = Path(working_dir, "arduino-cli.yaml")
+    Path(working_dir, "Arduino").mkdir()
+    assert run_command(["config", "set", "directories.user", str(Path(working_dir, "Arduino"))])
+
+    result = run_command(["config", "validate", config_file])
+    assert result.ok
+    assert "is valid" in result.stdout
+
+    # Adds an unknown key, a value with a wrong type and a missing directory
+    with open(config_file, "a") as f:
+        f.write("unknown_section:\n  foo: bar\n")
+    assert run_command(["config", "set", "directories.data", str(Path(working_dir, "missing"))])
+    assert run_command(["config", "set", "network.retries", "3"])
+    config = config_file.read_text().replace("retries: 3", "retries: three")
+    config_file.write_text(config)
+
+    result = run_command(["config", "validate", config_file, "--format", "json"])
+    assert result.failed
+    errors = {e["key"]: e["message"] for e in json.loads(result.stdout)["errors"]}
+    assert "unknown key" == errors["unknown_section.foo"]
+    assert "expected type integer" in errors["network.retries"]
+    assert "does not exist" in errors["directories.data"]
+
+
+def test_dump_with_multiple_config_files(run_command, working_dir):
+    team_config = Path(working_dir, "team.yaml")
+    team_config.write_text("network:\n  retries: 5\nlogging:\n  level: warn\n")
+    user_config = Path(working_dir, "personal.yaml")
+    user_config.write_text("logging:\n  level: debug\n")
+
+    result = run_command(
+        ["config", "dump", "--config-file", team_config, "--config-file", user_config, "--format", "json"]
+    )
+    assert result.ok
+    settings_json = json.loads(result.stdout)
+    assert 5 == settings_json["network"]["retries"]
+    assert "debug" == settings_json["logging"]["level"]
+
+    result = run_command(["config", "dump", "--config-file", team_config, "--config-file", user_config])
+    assert result.ok
+    assert f"retries: 5 # from {team_config}" in result.stdout
+    assert f"level: debug # from {user_config}" in result.stdout
@@ -225,6 +225,40 @@ def test_core_install_esp32(run_command, data_dir):
     assert (build_dir / f"{sketch_name}.ino.partitions.bin").exists()
 
 
+def test_core_download_jsonmini_output(run_command, downloads_dir):
+    assert run_command(["core", "update-index"])
+
+    # The progress bars must not be mixed with the compact JSON output
+    result = run_command(["core", "download", "arduino:avr@1.6.16", "--format", "jsonmini"])
+    assert result.ok
+    assert "Downloading" not in result.stdout
+
+
+def test_core_download_quiet(run_command, downloads_dir):
+    assert run_command(["core", "update-index"])
+
+    result = run_command(["core", "download", "arduino:avr@1.6.16", "--quiet"])
+    assert result.ok
+    assert "" == result.stdout.strip()
+    assert os.path.exists(os.path.join(downloads_dir, "packages", "avr-1.6.16.tar.bz2"))
+
+
+def test_core_download_progress_format_ndjson(run_command, downloads_dir):
+    assert run_command(["core", "update-index"])
+
+    result = run_command(["core", "download", "arduino:avr@1.6.16", "--progress-format", "ndjson"])
+    assert result.ok
+    events = [json.loads(line) for line in result.stdout.splitlines() if line.startswith("{")]
+    assert len(events) > 0
+    assert all(e["type"] == "download" for e in events)
+    assert any(e.get("completed") and e.get("file") == "arduino:avr@1.6.16" for e in events)
+
+    # Invalid progress format
+    result = run_command(["core", "download", "arduino:avr@1.6.16", "--progress-format", "banana"])
+    assert result.failed
+    assert "Invalid progress format: banana" in result.stderr
+
+
 def test_core_download(run_command, downloads_dir):
     assert run_command(["core", "update-index"])
 
@@ -839,3 +873,56 @@ def test_core_index_without_checksum(run_command):
     assert run_command(["core", "update-index"])
     result = run_command(["core", "list", "--all"])
     assert result.ok  # this should not make the cli crash
+
+
+def test_core_upgrade_exclude_and_pinned(run_command, data_dir):
+    assert run_command(["update"])
+
+    assert run_command(["core", "install", "arduino:avr@1.8.2"])
+
+    # Excluded platforms are skipped
+    res = run_command(["core", "upgrade", "--exclude", "arduino:avr"])
+    assert res.ok
+    assert "Platform arduino:avr skipped: excluded with --exclude" in res.stdout
+    assert "1.8.2" == json.loads(run_command(["core", "list", "--format", "json"]).stdout)[0]["installed"]
+
+    # Pinned platforms are upgraded up to the pinned version only
+    config_file = Path(data_dir, "arduino-cli.yaml")
+    assert run_command(["config", "init", "--dest-file", config_file])
+    assert run_command(["config", "add", "board_manager.pinned_platforms", "arduino:avr@1.8.3", "--config-file", config_file])
+    assert run_command(["core", "upgrade", "arduino:avr", "--config-file", config_file])
+    assert "1.8.3" == json.loads(run_command(["core", "list", "--format", "json"]).stdout)[0]["installed"]
+
+    res = run_command(["core", "upgrade", "--config-file", config_file])
+    assert res.ok
+    assert "Platform 'arduino:avr' is pinned to version 1.8.3 in board_manager.pinned_platforms" in res.stdout
+    assert "1.8.3" == json.loads(run_command(["core", "list", "--format", "json"]).stdout)[0]["installed"]
+
+
+def test_core_rollback(run_command, data_dir):
+    assert run_command(["update"])
+
+    # Nothing to roll back to before an upgrade
+    assert run_command(["core", "install", "arduino:avr@1.8.2"])
+    res = run_command(["core", "rollback", "arduino:avr"])
+    assert res.failed
+    assert "No previous version of platform arduino:avr to roll back to" in res.stderr
+
+    # The upgrade keeps the previous release aside
+    assert run_command(["core", "upgrade", "arduino:avr"])
+    assert not Path(data_dir, "packages", "arduino", "hardware", "avr", "1.8.2").exists()
+    assert Path(data_dir, "packages", "arduino", ".rollback", "avr", "1.8.2").exists()
+    latest = json.loads(run_command(["core", "list", "--format", "json"]).stdout)[0]["installed"]
+
+    assert run_command(["core", "rollback", "arduino:avr"])
+    assert "1.8.2" == json.loads(run_command(["core", "list", "--format", "json"]).stdout)[0]["installed"]
+    # Tools removed by the upgrade are installed again
+    assert Path(data_dir, "packages", "arduino", "tools", "avr-gcc", "7.3.0-atmel3.6.1-arduino5").exists()
+
+    # Rolling back again restores the upgraded release
+    assert run_command(["core", "rollback", "arduino:avr"])
+    assert latest == json.loads(run_command(["core", "list", "--format", "json"]).stdout)[0]["installed"]
+
+    # The kept release is removed with the platform
+    assert run_command(["core", "uninstall", "arduino:avr"])
+    assert not Path(data_dir, "packages", "arduino", ".rollback", "avr").exists()
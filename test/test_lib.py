@@ -496,6 +496,31 @@ def test_search(run_command):
     run_search("json", ["ArduinoJson", "Arduino_JSON"])
 
 
+def test_search_fuzzy_and_ranking(run_command):
+    assert run_command(["lib", "update-index"])
+
+    res = run_command(["lib", "search", "servo", "--format", "json"])
+    assert res.ok
+    libraries = json.loads(res.stdout)["libraries"]
+    # The exact name match comes first and the results are sorted by score
+    assert libraries[0]["name"] == "Servo"
+    scores = [lib["score"] for lib in libraries]
+    assert scores == sorted(scores, reverse=True)
+
+    # Near misses are found
+    res = run_command(["lib", "search", "sevro", "--format", "json"])
+    assert res.ok
+    assert "Servo" in [lib["name"] for lib in json.loads(res.stdout)["libraries"]]
+
+    res = run_command(["lib", "search", "servo", "--sort", "name", "--format", "json"])
+    assert res.ok
+    names = [lib["name"] for lib in json.loads(res.stdout)["libraries"]]
+    assert names == sorted(names)
+
+    res = run_command(["lib", "search", "servo", "--sort", "stars"])
+    assert res.failed
+
+
 def test_search_paragraph(run_command):
     """
     Search for a string that's only present in the `paragraph` field
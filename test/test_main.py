@@ -41,6 +41,106 @@ def test_version(run_command):
     assert semver.VersionInfo.isvalid(version=version) or "git-snapshot" in version or "nightly" in version
     assert isinstance(parsed_out.get("Commit", False), str)
 
+    result = run_command(["version", "--format", "jsonmini"])
+    assert result.ok
+    assert 1 == len(result.stdout.strip().splitlines())
+    assert parsed_out == json.loads(result.stdout)
+
+
+def test_errorcodes(run_command):
+    result = run_command(["errorcodes", "--format", "json"])
+    assert result.ok
+    error_codes = json.loads(result.stdout)["error_codes"]
+    codes = {e["name"]: e["code"] for e in error_codes}
+    assert codes["ErrGeneric"] == 1
+    assert codes["ErrBadCall"] == 4
+    assert all(e["meaning"] != "" for e in error_codes)
+
+    # The command is hidden
+    result = run_command(["help"])
+    assert result.ok
+    assert "errorcodes" not in result.stdout
+
+
+def test_doctor(run_command):
+    result = run_command(["doctor", "--offline", "--format", "json"])
+    checks = json.loads(result.stdout)["checks"]
+    statuses = {c["name"]: c["status"] for c in checks}
+    assert statuses["directories.data"] in ["ok", "warning"]
+    assert statuses["directories.downloads"] in ["ok", "warning"]
+    # Network checks are skipped when offline
+    network = [c for c in checks if c["name"].startswith("http")]
+    assert len(network) > 0
+    assert all(c["status"] == "skipped" for c in network)
+
+
+def test_doctor_text_output(run_command):
+    assert run_command(["update"])
+    result = run_command(["doctor"])
+    assert result.ok
+    assert "Arduino CLI" in result.stdout
+    assert "directories.data" in result.stdout
+    assert "panic" not in result.stderr
+
+
+def test_output_color(run_command, working_dir, data_dir, downloads_dir):
+    env = {
+        "ARDUINO_DATA_DIR": data_dir,
+        "ARDUINO_DOWNLOADS_DIR": downloads_dir,
+        "ARDUINO_SKETCHBOOK_DIR": data_dir,
+    }
+
+    # Colors are stripped when the output is not a terminal
+    sketch_path = os.path.join(working_dir, "SketchAuto")
+    result = run_command(["sketch", "new", sketch_path])
+    assert result.ok
+    assert "\x1b[" not in result.stdout
+
+    sketch_path = os.path.join(working_dir, "SketchAlways")
+    result = run_command(["sketch", "new", sketch_path], custom_env={**env, "ARDUINO_OUTPUT_COLOR": "always"})
+    assert result.ok
+    assert "\x1b[32m" in result.stdout
+
+    # NO_COLOR and --no-color have the precedence over output.color
+    sketch_path = os.path.join(working_dir, "SketchNoColorEnv")
+    result = run_command(
+        ["sketch", "new", sketch_path], custom_env={**env, "ARDUINO_OUTPUT_COLOR": "always", "NO_COLOR": "1"}
+    )
+    assert result.ok
+    assert "\x1b[" not in result.stdout
+
+    sketch_path = os.path.join(working_dir, "SketchNoColorFlag")
+    result = run_command(
+        ["sketch", "new", sketch_path, "--no-color"], custom_env={**env, "ARDUINO_OUTPUT_COLOR": "always"}
+    )
+    assert result.ok
+    assert "\x1b[" not in result.stdout
+
+    result = run_command(["version"], custom_env={**env, "ARDUINO_OUTPUT_COLOR": "rainbow"})
+    assert result.failed
+    assert "Invalid value for output.color: rainbow" in result.stderr
+
+
+def test_i18n_list(run_command, data_dir, downloads_dir):
+    env = {
+        "ARDUINO_DATA_DIR": data_dir,
+        "ARDUINO_DOWNLOADS_DIR": downloads_dir,
+        "ARDUINO_SKETCHBOOK_DIR": data_dir,
+        "LANG": "en",
+    }
+    result = run_command(["i18n", "list", "--format", "json"], custom_env=env)
+    assert result.ok
+    locales = {loc["locale"]: loc["selected"] for loc in json.loads(result.stdout)["locales"]}
+    assert "en" in locales
+    assert "it_IT" in locales
+    assert [loc for loc, selected in locales.items() if selected] == ["en"]
+
+    # The locale setting has the precedence over the environment
+    result = run_command(["i18n", "list", "--format", "json"], custom_env={**env, "ARDUINO_LOCALE": "it"})
+    assert result.ok
+    locales = {loc["locale"]: loc["selected"] for loc in json.loads(result.stdout)["locales"]}
+    assert [loc for loc, selected in locales.items() if selected] == ["it_IT"]
+
 
 def test_log_options(run_command, data_dir):
     """
@@ -12,6 +12,7 @@
 # otherwise use the software for commercial activities involving the Arduino
 # software without disclosing the source code of your own applications. To purchase
 # a commercial license, send an email to license@arduino.cc.
+import json
 import zipfile
 from pathlib import Path
 
@@ -897,3 +898,19 @@ def test_sketch_archive_case_mismatch_fails(run_command, data_dir):
     res = run_command(["sketch", "archive", sketch_path])
     assert res.failed
     assert "Error archiving: Can't open sketch: no valid sketch found" in res.stderr
+
+
+def test_sketch_extract_rejects_library_folder_outside_archive(run_command, working_dir):
+    archive_path = Path(working_dir, "Evil.zip")
+    manifest = json.dumps({"libraries": [{"name": "Evil", "version": "1.0.0", "folder": "../.."}]})
+    with zipfile.ZipFile(archive_path, "w") as archive:
+        archive.writestr("Evil/Evil.ino", "void setup() {}\nvoid loop() {}\n")
+        archive.writestr("Evil/libraries/manifest.json", manifest)
+
+    dest_dir = Path(working_dir, "extracted")
+    dest_dir.mkdir()
+    res = run_command(["sketch", "extract", archive_path, dest_dir])
+    assert res.failed
+    assert "Invalid library folder in the libraries manifest" in res.stderr
+    # The partially extracted sketch is removed
+    assert not Path(dest_dir, "Evil").exists()
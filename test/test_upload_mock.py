@@ -1238,3 +1238,32 @@ def test_upload_sketch(
     ).replace("\\", "/")
 
     expected_output in res.stdout.replace("\\", "/").replace("\r", "")
+
+
+def test_upload_prebuilt_binary(run_command, data_dir, downloads_dir, working_dir):
+    env = {
+        "ARDUINO_DATA_DIR": data_dir,
+        "ARDUINO_DOWNLOADS_DIR": downloads_dir,
+        "ARDUINO_SKETCHBOOK_DIR": data_dir,
+    }
+    assert run_command(["update"], custom_env=env)
+    assert run_command(["core", "install", "arduino:avr@1.8.3"], custom_env=env)
+
+    # The binary is uploaded without a sketch, compilation is skipped entirely
+    binary = Path(working_dir, "build", "Firmware.ino.hex")
+    binary.parent.mkdir()
+    binary.touch()
+    res = run_command(
+        ["upload", "-b", "arduino:avr:uno", "-p", "/dev/ttyACM0", "-i", binary, "--dry-run", "-v"], custom_env=env
+    )
+    assert res.ok
+    assert "Firmware.ino.hex" in res.stdout
+
+    # The extension must match the one expected by the upload recipe
+    binary = Path(working_dir, "build", "Firmware.ino.bin")
+    binary.touch()
+    res = run_command(
+        ["upload", "-b", "arduino:avr:uno", "-p", "/dev/ttyACM0", "-i", binary, "--dry-run", "-v"], custom_env=env
+    )
+    assert res.failed
+    assert "expected file with extension: .hex" in res.stderr